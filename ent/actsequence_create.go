@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jkh/ent/actsequence"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ActSequenceCreate is the builder for creating a ActSequence entity.
+type ActSequenceCreate struct {
+	config
+	mutation *ActSequenceMutation
+	hooks    []Hook
+}
+
+// SetYear sets the "year" field.
+func (_c *ActSequenceCreate) SetYear(v int) *ActSequenceCreate {
+	_c.mutation.SetYear(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *ActSequenceCreate) SetValue(v int) *ActSequenceCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *ActSequenceCreate) SetNillableValue(v *int) *ActSequenceCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ActSequenceMutation object of the builder.
+func (_c *ActSequenceCreate) Mutation() *ActSequenceMutation {
+	return _c.mutation
+}
+
+// Save creates the ActSequence in the database.
+func (_c *ActSequenceCreate) Save(ctx context.Context) (*ActSequence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ActSequenceCreate) SaveX(ctx context.Context) *ActSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActSequenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActSequenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ActSequenceCreate) defaults() {
+	if _, ok := _c.mutation.Value(); !ok {
+		v := actsequence.DefaultValue
+		_c.mutation.SetValue(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ActSequenceCreate) check() error {
+	if _, ok := _c.mutation.Year(); !ok {
+		return &ValidationError{Name: "year", err: errors.New(`ent: missing required field "ActSequence.year"`)}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ActSequence.value"`)}
+	}
+	return nil
+}
+
+func (_c *ActSequenceCreate) sqlSave(ctx context.Context) (*ActSequence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ActSequenceCreate) createSpec() (*ActSequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ActSequence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(actsequence.Table, sqlgraph.NewFieldSpec(actsequence.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Year(); ok {
+		_spec.SetField(actsequence.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(actsequence.FieldValue, field.TypeInt, value)
+		_node.Value = value
+	}
+	return _node, _spec
+}
+
+// ActSequenceCreateBulk is the builder for creating many ActSequence entities in bulk.
+type ActSequenceCreateBulk struct {
+	config
+	err      error
+	builders []*ActSequenceCreate
+}
+
+// Save creates the ActSequence entities in the database.
+func (_c *ActSequenceCreateBulk) Save(ctx context.Context) ([]*ActSequence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ActSequence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActSequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ActSequenceCreateBulk) SaveX(ctx context.Context) []*ActSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActSequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActSequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jkh/ent/actsequence"
+	"jkh/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ActSequenceUpdate is the builder for updating ActSequence entities.
+type ActSequenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ActSequenceMutation
+}
+
+// Where appends a list predicates to the ActSequenceUpdate builder.
+func (_u *ActSequenceUpdate) Where(ps ...predicate.ActSequence) *ActSequenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *ActSequenceUpdate) SetValue(v int) *ActSequenceUpdate {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *ActSequenceUpdate) SetNillableValue(v *int) *ActSequenceUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *ActSequenceUpdate) AddValue(v int) *ActSequenceUpdate {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// Mutation returns the ActSequenceMutation object of the builder.
+func (_u *ActSequenceUpdate) Mutation() *ActSequenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ActSequenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActSequenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ActSequenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActSequenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ActSequenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(actsequence.Table, actsequence.Columns, sqlgraph.NewFieldSpec(actsequence.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(actsequence.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(actsequence.FieldValue, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actsequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ActSequenceUpdateOne is the builder for updating a single ActSequence entity.
+type ActSequenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ActSequenceMutation
+}
+
+// SetValue sets the "value" field.
+func (_u *ActSequenceUpdateOne) SetValue(v int) *ActSequenceUpdateOne {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *ActSequenceUpdateOne) SetNillableValue(v *int) *ActSequenceUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *ActSequenceUpdateOne) AddValue(v int) *ActSequenceUpdateOne {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// Mutation returns the ActSequenceMutation object of the builder.
+func (_u *ActSequenceUpdateOne) Mutation() *ActSequenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ActSequenceUpdate builder.
+func (_u *ActSequenceUpdateOne) Where(ps ...predicate.ActSequence) *ActSequenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ActSequenceUpdateOne) Select(field string, fields ...string) *ActSequenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ActSequence entity.
+func (_u *ActSequenceUpdateOne) Save(ctx context.Context) (*ActSequence, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActSequenceUpdateOne) SaveX(ctx context.Context) *ActSequence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ActSequenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActSequenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ActSequenceUpdateOne) sqlSave(ctx context.Context) (_node *ActSequence, err error) {
+	_spec := sqlgraph.NewUpdateSpec(actsequence.Table, actsequence.Columns, sqlgraph.NewFieldSpec(actsequence.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ActSequence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, actsequence.FieldID)
+		for _, f := range fields {
+			if !actsequence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != actsequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(actsequence.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(actsequence.FieldValue, field.TypeInt, value)
+	}
+	_node = &ActSequence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actsequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -33,6 +33,16 @@ type Building struct {
 	Description string `json:"description,omitempty"`
 	// Photo holds the value of the "photo" field.
 	Photo string `json:"photo,omitempty"`
+	// ContactName holds the value of the "contact_name" field.
+	ContactName string `json:"contact_name,omitempty"`
+	// ContactPhone holds the value of the "contact_phone" field.
+	ContactPhone string `json:"contact_phone,omitempty"`
+	// Floors holds the value of the "floors" field.
+	Floors *int `json:"floors,omitempty"`
+	// Entrances holds the value of the "entrances" field.
+	Entrances *int `json:"entrances,omitempty"`
+	// Apartments holds the value of the "apartments" field.
+	Apartments *int `json:"apartments,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BuildingQuery when eager-loading is set.
 	Edges        BuildingEdges `json:"edges"`
@@ -101,9 +111,9 @@ func (*Building) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case building.FieldID, building.FieldDistrictID, building.FieldJkhUnitID, building.FieldInspectorID, building.FieldConstructionYear:
+		case building.FieldID, building.FieldDistrictID, building.FieldJkhUnitID, building.FieldInspectorID, building.FieldConstructionYear, building.FieldFloors, building.FieldEntrances, building.FieldApartments:
 			values[i] = new(sql.NullInt64)
-		case building.FieldAddress, building.FieldDescription, building.FieldPhoto:
+		case building.FieldAddress, building.FieldDescription, building.FieldPhoto, building.FieldContactName, building.FieldContactPhone:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -168,6 +178,39 @@ func (_m *Building) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Photo = value.String
 			}
+		case building.FieldContactName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contact_name", values[i])
+			} else if value.Valid {
+				_m.ContactName = value.String
+			}
+		case building.FieldContactPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field contact_phone", values[i])
+			} else if value.Valid {
+				_m.ContactPhone = value.String
+			}
+		case building.FieldFloors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field floors", values[i])
+			} else if value.Valid {
+				_m.Floors = new(int)
+				*_m.Floors = int(value.Int64)
+			}
+		case building.FieldEntrances:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entrances", values[i])
+			} else if value.Valid {
+				_m.Entrances = new(int)
+				*_m.Entrances = int(value.Int64)
+			}
+		case building.FieldApartments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field apartments", values[i])
+			} else if value.Valid {
+				_m.Apartments = new(int)
+				*_m.Apartments = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -244,6 +287,27 @@ func (_m *Building) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("photo=")
 	builder.WriteString(_m.Photo)
+	builder.WriteString(", ")
+	builder.WriteString("contact_name=")
+	builder.WriteString(_m.ContactName)
+	builder.WriteString(", ")
+	builder.WriteString("contact_phone=")
+	builder.WriteString(_m.ContactPhone)
+	builder.WriteString(", ")
+	if v := _m.Floors; v != nil {
+		builder.WriteString("floors=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Entrances; v != nil {
+		builder.WriteString("entrances=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Apartments; v != nil {
+		builder.WriteString("apartments=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,6 +26,16 @@ const (
 	FieldDescription = "description"
 	// FieldPhoto holds the string denoting the photo field in the database.
 	FieldPhoto = "photo"
+	// FieldContactName holds the string denoting the contact_name field in the database.
+	FieldContactName = "contact_name"
+	// FieldContactPhone holds the string denoting the contact_phone field in the database.
+	FieldContactPhone = "contact_phone"
+	// FieldFloors holds the string denoting the floors field in the database.
+	FieldFloors = "floors"
+	// FieldEntrances holds the string denoting the entrances field in the database.
+	FieldEntrances = "entrances"
+	// FieldApartments holds the string denoting the apartments field in the database.
+	FieldApartments = "apartments"
 	// EdgeJkhUnit holds the string denoting the jkh_unit edge name in mutations.
 	EdgeJkhUnit = "jkh_unit"
 	// EdgeDistrict holds the string denoting the district edge name in mutations.
@@ -76,6 +86,11 @@ var Columns = []string{
 	FieldConstructionYear,
 	FieldDescription,
 	FieldPhoto,
+	FieldContactName,
+	FieldContactPhone,
+	FieldFloors,
+	FieldEntrances,
+	FieldApartments,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -91,6 +106,10 @@ func ValidColumn(column string) bool {
 var (
 	// PhotoValidator is a validator for the "photo" field. It is called by the builders before save.
 	PhotoValidator func(string) error
+	// ContactNameValidator is a validator for the "contact_name" field. It is called by the builders before save.
+	ContactNameValidator func(string) error
+	// ContactPhoneValidator is a validator for the "contact_phone" field. It is called by the builders before save.
+	ContactPhoneValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the Building queries.
@@ -136,6 +155,31 @@ func ByPhoto(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPhoto, opts...).ToFunc()
 }
 
+// ByContactName orders the results by the contact_name field.
+func ByContactName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContactName, opts...).ToFunc()
+}
+
+// ByContactPhone orders the results by the contact_phone field.
+func ByContactPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContactPhone, opts...).ToFunc()
+}
+
+// ByFloors orders the results by the floors field.
+func ByFloors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFloors, opts...).ToFunc()
+}
+
+// ByEntrances orders the results by the entrances field.
+func ByEntrances(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntrances, opts...).ToFunc()
+}
+
+// ByApartments orders the results by the apartments field.
+func ByApartments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApartments, opts...).ToFunc()
+}
+
 // ByJkhUnitField orders the results by jkh_unit field.
 func ByJkhUnitField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
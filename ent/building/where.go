@@ -89,6 +89,31 @@ func Photo(v string) predicate.Building {
 	return predicate.Building(sql.FieldEQ(FieldPhoto, v))
 }
 
+// ContactName applies equality check predicate on the "contact_name" field. It's identical to ContactNameEQ.
+func ContactName(v string) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldContactName, v))
+}
+
+// ContactPhone applies equality check predicate on the "contact_phone" field. It's identical to ContactPhoneEQ.
+func ContactPhone(v string) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldContactPhone, v))
+}
+
+// Floors applies equality check predicate on the "floors" field. It's identical to FloorsEQ.
+func Floors(v int) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldFloors, v))
+}
+
+// Entrances applies equality check predicate on the "entrances" field. It's identical to EntrancesEQ.
+func Entrances(v int) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldEntrances, v))
+}
+
+// Apartments applies equality check predicate on the "apartments" field. It's identical to ApartmentsEQ.
+func Apartments(v int) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldApartments, v))
+}
+
 // DistrictIDEQ applies the EQ predicate on the "district_id" field.
 func DistrictIDEQ(v int) predicate.Building {
 	return predicate.Building(sql.FieldEQ(FieldDistrictID, v))
@@ -424,6 +449,306 @@ func PhotoContainsFold(v string) predicate.Building {
 	return predicate.Building(sql.FieldContainsFold(FieldPhoto, v))
 }
 
+// ContactNameEQ applies the EQ predicate on the "contact_name" field.
+func ContactNameEQ(v string) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldContactName, v))
+}
+
+// ContactNameNEQ applies the NEQ predicate on the "contact_name" field.
+func ContactNameNEQ(v string) predicate.Building {
+	return predicate.Building(sql.FieldNEQ(FieldContactName, v))
+}
+
+// ContactNameIn applies the In predicate on the "contact_name" field.
+func ContactNameIn(vs ...string) predicate.Building {
+	return predicate.Building(sql.FieldIn(FieldContactName, vs...))
+}
+
+// ContactNameNotIn applies the NotIn predicate on the "contact_name" field.
+func ContactNameNotIn(vs ...string) predicate.Building {
+	return predicate.Building(sql.FieldNotIn(FieldContactName, vs...))
+}
+
+// ContactNameGT applies the GT predicate on the "contact_name" field.
+func ContactNameGT(v string) predicate.Building {
+	return predicate.Building(sql.FieldGT(FieldContactName, v))
+}
+
+// ContactNameGTE applies the GTE predicate on the "contact_name" field.
+func ContactNameGTE(v string) predicate.Building {
+	return predicate.Building(sql.FieldGTE(FieldContactName, v))
+}
+
+// ContactNameLT applies the LT predicate on the "contact_name" field.
+func ContactNameLT(v string) predicate.Building {
+	return predicate.Building(sql.FieldLT(FieldContactName, v))
+}
+
+// ContactNameLTE applies the LTE predicate on the "contact_name" field.
+func ContactNameLTE(v string) predicate.Building {
+	return predicate.Building(sql.FieldLTE(FieldContactName, v))
+}
+
+// ContactNameContains applies the Contains predicate on the "contact_name" field.
+func ContactNameContains(v string) predicate.Building {
+	return predicate.Building(sql.FieldContains(FieldContactName, v))
+}
+
+// ContactNameHasPrefix applies the HasPrefix predicate on the "contact_name" field.
+func ContactNameHasPrefix(v string) predicate.Building {
+	return predicate.Building(sql.FieldHasPrefix(FieldContactName, v))
+}
+
+// ContactNameHasSuffix applies the HasSuffix predicate on the "contact_name" field.
+func ContactNameHasSuffix(v string) predicate.Building {
+	return predicate.Building(sql.FieldHasSuffix(FieldContactName, v))
+}
+
+// ContactNameIsNil applies the IsNil predicate on the "contact_name" field.
+func ContactNameIsNil() predicate.Building {
+	return predicate.Building(sql.FieldIsNull(FieldContactName))
+}
+
+// ContactNameNotNil applies the NotNil predicate on the "contact_name" field.
+func ContactNameNotNil() predicate.Building {
+	return predicate.Building(sql.FieldNotNull(FieldContactName))
+}
+
+// ContactNameEqualFold applies the EqualFold predicate on the "contact_name" field.
+func ContactNameEqualFold(v string) predicate.Building {
+	return predicate.Building(sql.FieldEqualFold(FieldContactName, v))
+}
+
+// ContactNameContainsFold applies the ContainsFold predicate on the "contact_name" field.
+func ContactNameContainsFold(v string) predicate.Building {
+	return predicate.Building(sql.FieldContainsFold(FieldContactName, v))
+}
+
+// ContactPhoneEQ applies the EQ predicate on the "contact_phone" field.
+func ContactPhoneEQ(v string) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldContactPhone, v))
+}
+
+// ContactPhoneNEQ applies the NEQ predicate on the "contact_phone" field.
+func ContactPhoneNEQ(v string) predicate.Building {
+	return predicate.Building(sql.FieldNEQ(FieldContactPhone, v))
+}
+
+// ContactPhoneIn applies the In predicate on the "contact_phone" field.
+func ContactPhoneIn(vs ...string) predicate.Building {
+	return predicate.Building(sql.FieldIn(FieldContactPhone, vs...))
+}
+
+// ContactPhoneNotIn applies the NotIn predicate on the "contact_phone" field.
+func ContactPhoneNotIn(vs ...string) predicate.Building {
+	return predicate.Building(sql.FieldNotIn(FieldContactPhone, vs...))
+}
+
+// ContactPhoneGT applies the GT predicate on the "contact_phone" field.
+func ContactPhoneGT(v string) predicate.Building {
+	return predicate.Building(sql.FieldGT(FieldContactPhone, v))
+}
+
+// ContactPhoneGTE applies the GTE predicate on the "contact_phone" field.
+func ContactPhoneGTE(v string) predicate.Building {
+	return predicate.Building(sql.FieldGTE(FieldContactPhone, v))
+}
+
+// ContactPhoneLT applies the LT predicate on the "contact_phone" field.
+func ContactPhoneLT(v string) predicate.Building {
+	return predicate.Building(sql.FieldLT(FieldContactPhone, v))
+}
+
+// ContactPhoneLTE applies the LTE predicate on the "contact_phone" field.
+func ContactPhoneLTE(v string) predicate.Building {
+	return predicate.Building(sql.FieldLTE(FieldContactPhone, v))
+}
+
+// ContactPhoneContains applies the Contains predicate on the "contact_phone" field.
+func ContactPhoneContains(v string) predicate.Building {
+	return predicate.Building(sql.FieldContains(FieldContactPhone, v))
+}
+
+// ContactPhoneHasPrefix applies the HasPrefix predicate on the "contact_phone" field.
+func ContactPhoneHasPrefix(v string) predicate.Building {
+	return predicate.Building(sql.FieldHasPrefix(FieldContactPhone, v))
+}
+
+// ContactPhoneHasSuffix applies the HasSuffix predicate on the "contact_phone" field.
+func ContactPhoneHasSuffix(v string) predicate.Building {
+	return predicate.Building(sql.FieldHasSuffix(FieldContactPhone, v))
+}
+
+// ContactPhoneIsNil applies the IsNil predicate on the "contact_phone" field.
+func ContactPhoneIsNil() predicate.Building {
+	return predicate.Building(sql.FieldIsNull(FieldContactPhone))
+}
+
+// ContactPhoneNotNil applies the NotNil predicate on the "contact_phone" field.
+func ContactPhoneNotNil() predicate.Building {
+	return predicate.Building(sql.FieldNotNull(FieldContactPhone))
+}
+
+// ContactPhoneEqualFold applies the EqualFold predicate on the "contact_phone" field.
+func ContactPhoneEqualFold(v string) predicate.Building {
+	return predicate.Building(sql.FieldEqualFold(FieldContactPhone, v))
+}
+
+// ContactPhoneContainsFold applies the ContainsFold predicate on the "contact_phone" field.
+func ContactPhoneContainsFold(v string) predicate.Building {
+	return predicate.Building(sql.FieldContainsFold(FieldContactPhone, v))
+}
+
+// FloorsEQ applies the EQ predicate on the "floors" field.
+func FloorsEQ(v int) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldFloors, v))
+}
+
+// FloorsNEQ applies the NEQ predicate on the "floors" field.
+func FloorsNEQ(v int) predicate.Building {
+	return predicate.Building(sql.FieldNEQ(FieldFloors, v))
+}
+
+// FloorsIn applies the In predicate on the "floors" field.
+func FloorsIn(vs ...int) predicate.Building {
+	return predicate.Building(sql.FieldIn(FieldFloors, vs...))
+}
+
+// FloorsNotIn applies the NotIn predicate on the "floors" field.
+func FloorsNotIn(vs ...int) predicate.Building {
+	return predicate.Building(sql.FieldNotIn(FieldFloors, vs...))
+}
+
+// FloorsGT applies the GT predicate on the "floors" field.
+func FloorsGT(v int) predicate.Building {
+	return predicate.Building(sql.FieldGT(FieldFloors, v))
+}
+
+// FloorsGTE applies the GTE predicate on the "floors" field.
+func FloorsGTE(v int) predicate.Building {
+	return predicate.Building(sql.FieldGTE(FieldFloors, v))
+}
+
+// FloorsLT applies the LT predicate on the "floors" field.
+func FloorsLT(v int) predicate.Building {
+	return predicate.Building(sql.FieldLT(FieldFloors, v))
+}
+
+// FloorsLTE applies the LTE predicate on the "floors" field.
+func FloorsLTE(v int) predicate.Building {
+	return predicate.Building(sql.FieldLTE(FieldFloors, v))
+}
+
+// FloorsIsNil applies the IsNil predicate on the "floors" field.
+func FloorsIsNil() predicate.Building {
+	return predicate.Building(sql.FieldIsNull(FieldFloors))
+}
+
+// FloorsNotNil applies the NotNil predicate on the "floors" field.
+func FloorsNotNil() predicate.Building {
+	return predicate.Building(sql.FieldNotNull(FieldFloors))
+}
+
+// EntrancesEQ applies the EQ predicate on the "entrances" field.
+func EntrancesEQ(v int) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldEntrances, v))
+}
+
+// EntrancesNEQ applies the NEQ predicate on the "entrances" field.
+func EntrancesNEQ(v int) predicate.Building {
+	return predicate.Building(sql.FieldNEQ(FieldEntrances, v))
+}
+
+// EntrancesIn applies the In predicate on the "entrances" field.
+func EntrancesIn(vs ...int) predicate.Building {
+	return predicate.Building(sql.FieldIn(FieldEntrances, vs...))
+}
+
+// EntrancesNotIn applies the NotIn predicate on the "entrances" field.
+func EntrancesNotIn(vs ...int) predicate.Building {
+	return predicate.Building(sql.FieldNotIn(FieldEntrances, vs...))
+}
+
+// EntrancesGT applies the GT predicate on the "entrances" field.
+func EntrancesGT(v int) predicate.Building {
+	return predicate.Building(sql.FieldGT(FieldEntrances, v))
+}
+
+// EntrancesGTE applies the GTE predicate on the "entrances" field.
+func EntrancesGTE(v int) predicate.Building {
+	return predicate.Building(sql.FieldGTE(FieldEntrances, v))
+}
+
+// EntrancesLT applies the LT predicate on the "entrances" field.
+func EntrancesLT(v int) predicate.Building {
+	return predicate.Building(sql.FieldLT(FieldEntrances, v))
+}
+
+// EntrancesLTE applies the LTE predicate on the "entrances" field.
+func EntrancesLTE(v int) predicate.Building {
+	return predicate.Building(sql.FieldLTE(FieldEntrances, v))
+}
+
+// EntrancesIsNil applies the IsNil predicate on the "entrances" field.
+func EntrancesIsNil() predicate.Building {
+	return predicate.Building(sql.FieldIsNull(FieldEntrances))
+}
+
+// EntrancesNotNil applies the NotNil predicate on the "entrances" field.
+func EntrancesNotNil() predicate.Building {
+	return predicate.Building(sql.FieldNotNull(FieldEntrances))
+}
+
+// ApartmentsEQ applies the EQ predicate on the "apartments" field.
+func ApartmentsEQ(v int) predicate.Building {
+	return predicate.Building(sql.FieldEQ(FieldApartments, v))
+}
+
+// ApartmentsNEQ applies the NEQ predicate on the "apartments" field.
+func ApartmentsNEQ(v int) predicate.Building {
+	return predicate.Building(sql.FieldNEQ(FieldApartments, v))
+}
+
+// ApartmentsIn applies the In predicate on the "apartments" field.
+func ApartmentsIn(vs ...int) predicate.Building {
+	return predicate.Building(sql.FieldIn(FieldApartments, vs...))
+}
+
+// ApartmentsNotIn applies the NotIn predicate on the "apartments" field.
+func ApartmentsNotIn(vs ...int) predicate.Building {
+	return predicate.Building(sql.FieldNotIn(FieldApartments, vs...))
+}
+
+// ApartmentsGT applies the GT predicate on the "apartments" field.
+func ApartmentsGT(v int) predicate.Building {
+	return predicate.Building(sql.FieldGT(FieldApartments, v))
+}
+
+// ApartmentsGTE applies the GTE predicate on the "apartments" field.
+func ApartmentsGTE(v int) predicate.Building {
+	return predicate.Building(sql.FieldGTE(FieldApartments, v))
+}
+
+// ApartmentsLT applies the LT predicate on the "apartments" field.
+func ApartmentsLT(v int) predicate.Building {
+	return predicate.Building(sql.FieldLT(FieldApartments, v))
+}
+
+// ApartmentsLTE applies the LTE predicate on the "apartments" field.
+func ApartmentsLTE(v int) predicate.Building {
+	return predicate.Building(sql.FieldLTE(FieldApartments, v))
+}
+
+// ApartmentsIsNil applies the IsNil predicate on the "apartments" field.
+func ApartmentsIsNil() predicate.Building {
+	return predicate.Building(sql.FieldIsNull(FieldApartments))
+}
+
+// ApartmentsNotNil applies the NotNil predicate on the "apartments" field.
+func ApartmentsNotNil() predicate.Building {
+	return predicate.Building(sql.FieldNotNull(FieldApartments))
+}
+
 // HasJkhUnit applies the HasEdge predicate on the "jkh_unit" edge.
 func HasJkhUnit() predicate.Building {
 	return predicate.Building(func(s *sql.Selector) {
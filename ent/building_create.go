@@ -97,6 +97,76 @@ func (_c *BuildingCreate) SetNillablePhoto(v *string) *BuildingCreate {
 	return _c
 }
 
+// SetContactName sets the "contact_name" field.
+func (_c *BuildingCreate) SetContactName(v string) *BuildingCreate {
+	_c.mutation.SetContactName(v)
+	return _c
+}
+
+// SetNillableContactName sets the "contact_name" field if the given value is not nil.
+func (_c *BuildingCreate) SetNillableContactName(v *string) *BuildingCreate {
+	if v != nil {
+		_c.SetContactName(*v)
+	}
+	return _c
+}
+
+// SetContactPhone sets the "contact_phone" field.
+func (_c *BuildingCreate) SetContactPhone(v string) *BuildingCreate {
+	_c.mutation.SetContactPhone(v)
+	return _c
+}
+
+// SetNillableContactPhone sets the "contact_phone" field if the given value is not nil.
+func (_c *BuildingCreate) SetNillableContactPhone(v *string) *BuildingCreate {
+	if v != nil {
+		_c.SetContactPhone(*v)
+	}
+	return _c
+}
+
+// SetFloors sets the "floors" field.
+func (_c *BuildingCreate) SetFloors(v int) *BuildingCreate {
+	_c.mutation.SetFloors(v)
+	return _c
+}
+
+// SetNillableFloors sets the "floors" field if the given value is not nil.
+func (_c *BuildingCreate) SetNillableFloors(v *int) *BuildingCreate {
+	if v != nil {
+		_c.SetFloors(*v)
+	}
+	return _c
+}
+
+// SetEntrances sets the "entrances" field.
+func (_c *BuildingCreate) SetEntrances(v int) *BuildingCreate {
+	_c.mutation.SetEntrances(v)
+	return _c
+}
+
+// SetNillableEntrances sets the "entrances" field if the given value is not nil.
+func (_c *BuildingCreate) SetNillableEntrances(v *int) *BuildingCreate {
+	if v != nil {
+		_c.SetEntrances(*v)
+	}
+	return _c
+}
+
+// SetApartments sets the "apartments" field.
+func (_c *BuildingCreate) SetApartments(v int) *BuildingCreate {
+	_c.mutation.SetApartments(v)
+	return _c
+}
+
+// SetNillableApartments sets the "apartments" field if the given value is not nil.
+func (_c *BuildingCreate) SetNillableApartments(v *int) *BuildingCreate {
+	if v != nil {
+		_c.SetApartments(*v)
+	}
+	return _c
+}
+
 // SetJkhUnit sets the "jkh_unit" edge to the JkhUnit entity.
 func (_c *BuildingCreate) SetJkhUnit(v *JkhUnit) *BuildingCreate {
 	return _c.SetJkhUnitID(v.ID)
@@ -175,6 +245,16 @@ func (_c *BuildingCreate) check() error {
 			return &ValidationError{Name: "photo", err: fmt.Errorf(`ent: validator failed for field "Building.photo": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ContactName(); ok {
+		if err := building.ContactNameValidator(v); err != nil {
+			return &ValidationError{Name: "contact_name", err: fmt.Errorf(`ent: validator failed for field "Building.contact_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ContactPhone(); ok {
+		if err := building.ContactPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "contact_phone", err: fmt.Errorf(`ent: validator failed for field "Building.contact_phone": %w`, err)}
+		}
+	}
 	if len(_c.mutation.JkhUnitIDs()) == 0 {
 		return &ValidationError{Name: "jkh_unit", err: errors.New(`ent: missing required edge "Building.jkh_unit"`)}
 	}
@@ -223,6 +303,26 @@ func (_c *BuildingCreate) createSpec() (*Building, *sqlgraph.CreateSpec) {
 		_spec.SetField(building.FieldPhoto, field.TypeString, value)
 		_node.Photo = value
 	}
+	if value, ok := _c.mutation.ContactName(); ok {
+		_spec.SetField(building.FieldContactName, field.TypeString, value)
+		_node.ContactName = value
+	}
+	if value, ok := _c.mutation.ContactPhone(); ok {
+		_spec.SetField(building.FieldContactPhone, field.TypeString, value)
+		_node.ContactPhone = value
+	}
+	if value, ok := _c.mutation.Floors(); ok {
+		_spec.SetField(building.FieldFloors, field.TypeInt, value)
+		_node.Floors = &value
+	}
+	if value, ok := _c.mutation.Entrances(); ok {
+		_spec.SetField(building.FieldEntrances, field.TypeInt, value)
+		_node.Entrances = &value
+	}
+	if value, ok := _c.mutation.Apartments(); ok {
+		_spec.SetField(building.FieldApartments, field.TypeInt, value)
+		_node.Apartments = &value
+	}
 	if nodes := _c.mutation.JkhUnitIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -160,6 +160,127 @@ func (_u *BuildingUpdate) ClearPhoto() *BuildingUpdate {
 	return _u
 }
 
+// SetContactName sets the "contact_name" field.
+func (_u *BuildingUpdate) SetContactName(v string) *BuildingUpdate {
+	_u.mutation.SetContactName(v)
+	return _u
+}
+
+// SetNillableContactName sets the "contact_name" field if the given value is not nil.
+func (_u *BuildingUpdate) SetNillableContactName(v *string) *BuildingUpdate {
+	if v != nil {
+		_u.SetContactName(*v)
+	}
+	return _u
+}
+
+// ClearContactName clears the value of the "contact_name" field.
+func (_u *BuildingUpdate) ClearContactName() *BuildingUpdate {
+	_u.mutation.ClearContactName()
+	return _u
+}
+
+// SetContactPhone sets the "contact_phone" field.
+func (_u *BuildingUpdate) SetContactPhone(v string) *BuildingUpdate {
+	_u.mutation.SetContactPhone(v)
+	return _u
+}
+
+// SetNillableContactPhone sets the "contact_phone" field if the given value is not nil.
+func (_u *BuildingUpdate) SetNillableContactPhone(v *string) *BuildingUpdate {
+	if v != nil {
+		_u.SetContactPhone(*v)
+	}
+	return _u
+}
+
+// ClearContactPhone clears the value of the "contact_phone" field.
+func (_u *BuildingUpdate) ClearContactPhone() *BuildingUpdate {
+	_u.mutation.ClearContactPhone()
+	return _u
+}
+
+// SetFloors sets the "floors" field.
+func (_u *BuildingUpdate) SetFloors(v int) *BuildingUpdate {
+	_u.mutation.ResetFloors()
+	_u.mutation.SetFloors(v)
+	return _u
+}
+
+// SetNillableFloors sets the "floors" field if the given value is not nil.
+func (_u *BuildingUpdate) SetNillableFloors(v *int) *BuildingUpdate {
+	if v != nil {
+		_u.SetFloors(*v)
+	}
+	return _u
+}
+
+// AddFloors adds value to the "floors" field.
+func (_u *BuildingUpdate) AddFloors(v int) *BuildingUpdate {
+	_u.mutation.AddFloors(v)
+	return _u
+}
+
+// ClearFloors clears the value of the "floors" field.
+func (_u *BuildingUpdate) ClearFloors() *BuildingUpdate {
+	_u.mutation.ClearFloors()
+	return _u
+}
+
+// SetEntrances sets the "entrances" field.
+func (_u *BuildingUpdate) SetEntrances(v int) *BuildingUpdate {
+	_u.mutation.ResetEntrances()
+	_u.mutation.SetEntrances(v)
+	return _u
+}
+
+// SetNillableEntrances sets the "entrances" field if the given value is not nil.
+func (_u *BuildingUpdate) SetNillableEntrances(v *int) *BuildingUpdate {
+	if v != nil {
+		_u.SetEntrances(*v)
+	}
+	return _u
+}
+
+// AddEntrances adds value to the "entrances" field.
+func (_u *BuildingUpdate) AddEntrances(v int) *BuildingUpdate {
+	_u.mutation.AddEntrances(v)
+	return _u
+}
+
+// ClearEntrances clears the value of the "entrances" field.
+func (_u *BuildingUpdate) ClearEntrances() *BuildingUpdate {
+	_u.mutation.ClearEntrances()
+	return _u
+}
+
+// SetApartments sets the "apartments" field.
+func (_u *BuildingUpdate) SetApartments(v int) *BuildingUpdate {
+	_u.mutation.ResetApartments()
+	_u.mutation.SetApartments(v)
+	return _u
+}
+
+// SetNillableApartments sets the "apartments" field if the given value is not nil.
+func (_u *BuildingUpdate) SetNillableApartments(v *int) *BuildingUpdate {
+	if v != nil {
+		_u.SetApartments(*v)
+	}
+	return _u
+}
+
+// AddApartments adds value to the "apartments" field.
+func (_u *BuildingUpdate) AddApartments(v int) *BuildingUpdate {
+	_u.mutation.AddApartments(v)
+	return _u
+}
+
+// ClearApartments clears the value of the "apartments" field.
+func (_u *BuildingUpdate) ClearApartments() *BuildingUpdate {
+	_u.mutation.ClearApartments()
+	return _u
+}
+
 // SetJkhUnit sets the "jkh_unit" edge to the JkhUnit entity.
 func (_u *BuildingUpdate) SetJkhUnit(v *JkhUnit) *BuildingUpdate {
 	return _u.SetJkhUnitID(v.ID)
@@ -268,6 +389,16 @@ func (_u *BuildingUpdate) check() error {
 			return &ValidationError{Name: "photo", err: fmt.Errorf(`ent: validator failed for field "Building.photo": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ContactName(); ok {
+		if err := building.ContactNameValidator(v); err != nil {
+			return &ValidationError{Name: "contact_name", err: fmt.Errorf(`ent: validator failed for field "Building.contact_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContactPhone(); ok {
+		if err := building.ContactPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "contact_phone", err: fmt.Errorf(`ent: validator failed for field "Building.contact_phone": %w`, err)}
+		}
+	}
 	if _u.mutation.JkhUnitCleared() && len(_u.mutation.JkhUnitIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Building.jkh_unit"`)
 	}
@@ -313,6 +444,45 @@ func (_u *BuildingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PhotoCleared() {
 		_spec.ClearField(building.FieldPhoto, field.TypeString)
 	}
+	if value, ok := _u.mutation.ContactName(); ok {
+		_spec.SetField(building.FieldContactName, field.TypeString, value)
+	}
+	if _u.mutation.ContactNameCleared() {
+		_spec.ClearField(building.FieldContactName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContactPhone(); ok {
+		_spec.SetField(building.FieldContactPhone, field.TypeString, value)
+	}
+	if _u.mutation.ContactPhoneCleared() {
+		_spec.ClearField(building.FieldContactPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Floors(); ok {
+		_spec.SetField(building.FieldFloors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFloors(); ok {
+		_spec.AddField(building.FieldFloors, field.TypeInt, value)
+	}
+	if _u.mutation.FloorsCleared() {
+		_spec.ClearField(building.FieldFloors, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Entrances(); ok {
+		_spec.SetField(building.FieldEntrances, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntrances(); ok {
+		_spec.AddField(building.FieldEntrances, field.TypeInt, value)
+	}
+	if _u.mutation.EntrancesCleared() {
+		_spec.ClearField(building.FieldEntrances, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Apartments(); ok {
+		_spec.SetField(building.FieldApartments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApartments(); ok {
+		_spec.AddField(building.FieldApartments, field.TypeInt, value)
+	}
+	if _u.mutation.ApartmentsCleared() {
+		_spec.ClearField(building.FieldApartments, field.TypeInt)
+	}
 	if _u.mutation.JkhUnitCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -594,6 +764,127 @@ func (_u *BuildingUpdateOne) ClearPhoto() *BuildingUpdateOne {
 	return _u
 }
 
+// SetContactName sets the "contact_name" field.
+func (_u *BuildingUpdateOne) SetContactName(v string) *BuildingUpdateOne {
+	_u.mutation.SetContactName(v)
+	return _u
+}
+
+// SetNillableContactName sets the "contact_name" field if the given value is not nil.
+func (_u *BuildingUpdateOne) SetNillableContactName(v *string) *BuildingUpdateOne {
+	if v != nil {
+		_u.SetContactName(*v)
+	}
+	return _u
+}
+
+// ClearContactName clears the value of the "contact_name" field.
+func (_u *BuildingUpdateOne) ClearContactName() *BuildingUpdateOne {
+	_u.mutation.ClearContactName()
+	return _u
+}
+
+// SetContactPhone sets the "contact_phone" field.
+func (_u *BuildingUpdateOne) SetContactPhone(v string) *BuildingUpdateOne {
+	_u.mutation.SetContactPhone(v)
+	return _u
+}
+
+// SetNillableContactPhone sets the "contact_phone" field if the given value is not nil.
+func (_u *BuildingUpdateOne) SetNillableContactPhone(v *string) *BuildingUpdateOne {
+	if v != nil {
+		_u.SetContactPhone(*v)
+	}
+	return _u
+}
+
+// ClearContactPhone clears the value of the "contact_phone" field.
+func (_u *BuildingUpdateOne) ClearContactPhone() *BuildingUpdateOne {
+	_u.mutation.ClearContactPhone()
+	return _u
+}
+
+// SetFloors sets the "floors" field.
+func (_u *BuildingUpdateOne) SetFloors(v int) *BuildingUpdateOne {
+	_u.mutation.ResetFloors()
+	_u.mutation.SetFloors(v)
+	return _u
+}
+
+// SetNillableFloors sets the "floors" field if the given value is not nil.
+func (_u *BuildingUpdateOne) SetNillableFloors(v *int) *BuildingUpdateOne {
+	if v != nil {
+		_u.SetFloors(*v)
+	}
+	return _u
+}
+
+// AddFloors adds value to the "floors" field.
+func (_u *BuildingUpdateOne) AddFloors(v int) *BuildingUpdateOne {
+	_u.mutation.AddFloors(v)
+	return _u
+}
+
+// ClearFloors clears the value of the "floors" field.
+func (_u *BuildingUpdateOne) ClearFloors() *BuildingUpdateOne {
+	_u.mutation.ClearFloors()
+	return _u
+}
+
+// SetEntrances sets the "entrances" field.
+func (_u *BuildingUpdateOne) SetEntrances(v int) *BuildingUpdateOne {
+	_u.mutation.ResetEntrances()
+	_u.mutation.SetEntrances(v)
+	return _u
+}
+
+// SetNillableEntrances sets the "entrances" field if the given value is not nil.
+func (_u *BuildingUpdateOne) SetNillableEntrances(v *int) *BuildingUpdateOne {
+	if v != nil {
+		_u.SetEntrances(*v)
+	}
+	return _u
+}
+
+// AddEntrances adds value to the "entrances" field.
+func (_u *BuildingUpdateOne) AddEntrances(v int) *BuildingUpdateOne {
+	_u.mutation.AddEntrances(v)
+	return _u
+}
+
+// ClearEntrances clears the value of the "entrances" field.
+func (_u *BuildingUpdateOne) ClearEntrances() *BuildingUpdateOne {
+	_u.mutation.ClearEntrances()
+	return _u
+}
+
+// SetApartments sets the "apartments" field.
+func (_u *BuildingUpdateOne) SetApartments(v int) *BuildingUpdateOne {
+	_u.mutation.ResetApartments()
+	_u.mutation.SetApartments(v)
+	return _u
+}
+
+// SetNillableApartments sets the "apartments" field if the given value is not nil.
+func (_u *BuildingUpdateOne) SetNillableApartments(v *int) *BuildingUpdateOne {
+	if v != nil {
+		_u.SetApartments(*v)
+	}
+	return _u
+}
+
+// AddApartments adds value to the "apartments" field.
+func (_u *BuildingUpdateOne) AddApartments(v int) *BuildingUpdateOne {
+	_u.mutation.AddApartments(v)
+	return _u
+}
+
+// ClearApartments clears the value of the "apartments" field.
+func (_u *BuildingUpdateOne) ClearApartments() *BuildingUpdateOne {
+	_u.mutation.ClearApartments()
+	return _u
+}
+
 // SetJkhUnit sets the "jkh_unit" edge to the JkhUnit entity.
 func (_u *BuildingUpdateOne) SetJkhUnit(v *JkhUnit) *BuildingUpdateOne {
 	return _u.SetJkhUnitID(v.ID)
@@ -715,6 +1006,16 @@ func (_u *BuildingUpdateOne) check() error {
 			return &ValidationError{Name: "photo", err: fmt.Errorf(`ent: validator failed for field "Building.photo": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ContactName(); ok {
+		if err := building.ContactNameValidator(v); err != nil {
+			return &ValidationError{Name: "contact_name", err: fmt.Errorf(`ent: validator failed for field "Building.contact_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContactPhone(); ok {
+		if err := building.ContactPhoneValidator(v); err != nil {
+			return &ValidationError{Name: "contact_phone", err: fmt.Errorf(`ent: validator failed for field "Building.contact_phone": %w`, err)}
+		}
+	}
 	if _u.mutation.JkhUnitCleared() && len(_u.mutation.JkhUnitIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Building.jkh_unit"`)
 	}
@@ -777,6 +1078,45 @@ func (_u *BuildingUpdateOne) sqlSave(ctx context.Context) (_node *Building, err
 	if _u.mutation.PhotoCleared() {
 		_spec.ClearField(building.FieldPhoto, field.TypeString)
 	}
+	if value, ok := _u.mutation.ContactName(); ok {
+		_spec.SetField(building.FieldContactName, field.TypeString, value)
+	}
+	if _u.mutation.ContactNameCleared() {
+		_spec.ClearField(building.FieldContactName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContactPhone(); ok {
+		_spec.SetField(building.FieldContactPhone, field.TypeString, value)
+	}
+	if _u.mutation.ContactPhoneCleared() {
+		_spec.ClearField(building.FieldContactPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Floors(); ok {
+		_spec.SetField(building.FieldFloors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFloors(); ok {
+		_spec.AddField(building.FieldFloors, field.TypeInt, value)
+	}
+	if _u.mutation.FloorsCleared() {
+		_spec.ClearField(building.FieldFloors, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Entrances(); ok {
+		_spec.SetField(building.FieldEntrances, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntrances(); ok {
+		_spec.AddField(building.FieldEntrances, field.TypeInt, value)
+	}
+	if _u.mutation.EntrancesCleared() {
+		_spec.ClearField(building.FieldEntrances, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Apartments(); ok {
+		_spec.SetField(building.FieldApartments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApartments(); ok {
+		_spec.AddField(building.FieldApartments, field.TypeInt, value)
+	}
+	if _u.mutation.ApartmentsCleared() {
+		_spec.ClearField(building.FieldApartments, field.TypeInt)
+	}
 	if _u.mutation.JkhUnitCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -37,9 +37,11 @@ type ChecklistEdges struct {
 	Elements []*ChecklistElement `json:"elements,omitempty"`
 	// Tasks holds the value of the tasks edge.
 	Tasks []*Task `json:"tasks,omitempty"`
+	// TaskLinks holds the value of the task_links edge.
+	TaskLinks []*TaskChecklist `json:"task_links,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ElementsOrErr returns the Elements value or an error if the edge
@@ -60,6 +62,15 @@ func (e ChecklistEdges) TasksOrErr() ([]*Task, error) {
 	return nil, &NotLoadedError{edge: "tasks"}
 }
 
+// TaskLinksOrErr returns the TaskLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChecklistEdges) TaskLinksOrErr() ([]*TaskChecklist, error) {
+	if e.loadedTypes[2] {
+		return e.TaskLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "task_links"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Checklist) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -139,6 +150,11 @@ func (_m *Checklist) QueryTasks() *TaskQuery {
 	return NewChecklistClient(_m.config).QueryTasks(_m)
 }
 
+// QueryTaskLinks queries the "task_links" edge of the Checklist entity.
+func (_m *Checklist) QueryTaskLinks() *TaskChecklistQuery {
+	return NewChecklistClient(_m.config).QueryTaskLinks(_m)
+}
+
 // Update returns a builder for updating this Checklist.
 // Note that you need to call Checklist.Unwrap() before calling this method if this Checklist
 // was returned from a transaction, and the transaction was committed or rolled back.
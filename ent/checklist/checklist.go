@@ -27,6 +27,8 @@ const (
 	EdgeElements = "elements"
 	// EdgeTasks holds the string denoting the tasks edge name in mutations.
 	EdgeTasks = "tasks"
+	// EdgeTaskLinks holds the string denoting the task_links edge name in mutations.
+	EdgeTaskLinks = "task_links"
 	// Table holds the table name of the checklist in the database.
 	Table = "checklists"
 	// ElementsTable is the table that holds the elements relation/edge.
@@ -43,6 +45,13 @@ const (
 	TasksInverseTable = "tasks"
 	// TasksColumn is the table column denoting the tasks relation/edge.
 	TasksColumn = "checklist_id"
+	// TaskLinksTable is the table that holds the task_links relation/edge.
+	TaskLinksTable = "task_checklists"
+	// TaskLinksInverseTable is the table name for the TaskChecklist entity.
+	// It exists in this package in order to avoid circular dependency with the "taskchecklist" package.
+	TaskLinksInverseTable = "task_checklists"
+	// TaskLinksColumn is the table column denoting the task_links relation/edge.
+	TaskLinksColumn = "checklist_id"
 )
 
 // Columns holds all SQL columns for checklist fields.
@@ -151,6 +160,20 @@ func ByTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTaskLinksCount orders the results by task_links count.
+func ByTaskLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaskLinksStep(), opts...)
+	}
+}
+
+// ByTaskLinks orders the results by task_links terms.
+func ByTaskLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newElementsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -165,3 +188,10 @@ func newTasksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
 	)
 }
+func newTaskLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaskLinksTable, TaskLinksColumn),
+	)
+}
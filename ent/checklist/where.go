@@ -316,6 +316,29 @@ func HasTasksWith(preds ...predicate.Task) predicate.Checklist {
 	})
 }
 
+// HasTaskLinks applies the HasEdge predicate on the "task_links" edge.
+func HasTaskLinks() predicate.Checklist {
+	return predicate.Checklist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TaskLinksTable, TaskLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskLinksWith applies the HasEdge predicate on the "task_links" edge with a given conditions (other predicates).
+func HasTaskLinksWith(preds ...predicate.TaskChecklist) predicate.Checklist {
+	return predicate.Checklist(func(s *sql.Selector) {
+		step := newTaskLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Checklist) predicate.Checklist {
 	return predicate.Checklist(sql.AndPredicates(predicates...))
@@ -9,6 +9,7 @@ import (
 	"jkh/ent/checklist"
 	"jkh/ent/checklistelement"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -100,6 +101,21 @@ func (_c *ChecklistCreate) AddTasks(v ...*Task) *ChecklistCreate {
 	return _c.AddTaskIDs(ids...)
 }
 
+// AddTaskLinkIDs adds the "task_links" edge to the TaskChecklist entity by IDs.
+func (_c *ChecklistCreate) AddTaskLinkIDs(ids ...int) *ChecklistCreate {
+	_c.mutation.AddTaskLinkIDs(ids...)
+	return _c
+}
+
+// AddTaskLinks adds the "task_links" edges to the TaskChecklist entity.
+func (_c *ChecklistCreate) AddTaskLinks(v ...*TaskChecklist) *ChecklistCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTaskLinkIDs(ids...)
+}
+
 // Mutation returns the ChecklistMutation object of the builder.
 func (_c *ChecklistCreate) Mutation() *ChecklistMutation {
 	return _c.mutation
@@ -235,6 +251,22 @@ func (_c *ChecklistCreate) createSpec() (*Checklist, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TaskLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   checklist.TaskLinksTable,
+			Columns: []string{checklist.TaskLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -10,6 +10,7 @@ import (
 	"jkh/ent/checklistelement"
 	"jkh/ent/predicate"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
 	"math"
 
 	"entgo.io/ent"
@@ -21,12 +22,13 @@ import (
 // ChecklistQuery is the builder for querying Checklist entities.
 type ChecklistQuery struct {
 	config
-	ctx          *QueryContext
-	order        []checklist.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Checklist
-	withElements *ChecklistElementQuery
-	withTasks    *TaskQuery
+	ctx           *QueryContext
+	order         []checklist.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Checklist
+	withElements  *ChecklistElementQuery
+	withTasks     *TaskQuery
+	withTaskLinks *TaskChecklistQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (_q *ChecklistQuery) QueryTasks() *TaskQuery {
 	return query
 }
 
+// QueryTaskLinks chains the current query on the "task_links" edge.
+func (_q *ChecklistQuery) QueryTaskLinks() *TaskChecklistQuery {
+	query := (&TaskChecklistClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checklist.Table, checklist.FieldID, selector),
+			sqlgraph.To(taskchecklist.Table, taskchecklist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, checklist.TaskLinksTable, checklist.TaskLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Checklist entity from the query.
 // Returns a *NotFoundError when no Checklist was found.
 func (_q *ChecklistQuery) First(ctx context.Context) (*Checklist, error) {
@@ -294,13 +318,14 @@ func (_q *ChecklistQuery) Clone() *ChecklistQuery {
 		return nil
 	}
 	return &ChecklistQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]checklist.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Checklist{}, _q.predicates...),
-		withElements: _q.withElements.Clone(),
-		withTasks:    _q.withTasks.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]checklist.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.Checklist{}, _q.predicates...),
+		withElements:  _q.withElements.Clone(),
+		withTasks:     _q.withTasks.Clone(),
+		withTaskLinks: _q.withTaskLinks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +354,17 @@ func (_q *ChecklistQuery) WithTasks(opts ...func(*TaskQuery)) *ChecklistQuery {
 	return _q
 }
 
+// WithTaskLinks tells the query-builder to eager-load the nodes that are connected to
+// the "task_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChecklistQuery) WithTaskLinks(opts ...func(*TaskChecklistQuery)) *ChecklistQuery {
+	query := (&TaskChecklistClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTaskLinks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (_q *ChecklistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 	var (
 		nodes       = []*Checklist{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withElements != nil,
 			_q.withTasks != nil,
+			_q.withTaskLinks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +481,13 @@ func (_q *ChecklistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 			return nil, err
 		}
 	}
+	if query := _q.withTaskLinks; query != nil {
+		if err := _q.loadTaskLinks(ctx, query, nodes,
+			func(n *Checklist) { n.Edges.TaskLinks = []*TaskChecklist{} },
+			func(n *Checklist, e *TaskChecklist) { n.Edges.TaskLinks = append(n.Edges.TaskLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +551,36 @@ func (_q *ChecklistQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes
 	}
 	return nil
 }
+func (_q *ChecklistQuery) loadTaskLinks(ctx context.Context, query *TaskChecklistQuery, nodes []*Checklist, init func(*Checklist), assign func(*Checklist, *TaskChecklist)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Checklist)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskchecklist.FieldChecklistID)
+	}
+	query.Where(predicate.TaskChecklist(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(checklist.TaskLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChecklistID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "checklist_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ChecklistQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,6 +10,7 @@ import (
 	"jkh/ent/checklistelement"
 	"jkh/ent/predicate"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -107,6 +108,21 @@ func (_u *ChecklistUpdate) AddTasks(v ...*Task) *ChecklistUpdate {
 	return _u.AddTaskIDs(ids...)
 }
 
+// AddTaskLinkIDs adds the "task_links" edge to the TaskChecklist entity by IDs.
+func (_u *ChecklistUpdate) AddTaskLinkIDs(ids ...int) *ChecklistUpdate {
+	_u.mutation.AddTaskLinkIDs(ids...)
+	return _u
+}
+
+// AddTaskLinks adds the "task_links" edges to the TaskChecklist entity.
+func (_u *ChecklistUpdate) AddTaskLinks(v ...*TaskChecklist) *ChecklistUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskLinkIDs(ids...)
+}
+
 // Mutation returns the ChecklistMutation object of the builder.
 func (_u *ChecklistUpdate) Mutation() *ChecklistMutation {
 	return _u.mutation
@@ -154,6 +170,27 @@ func (_u *ChecklistUpdate) RemoveTasks(v ...*Task) *ChecklistUpdate {
 	return _u.RemoveTaskIDs(ids...)
 }
 
+// ClearTaskLinks clears all "task_links" edges to the TaskChecklist entity.
+func (_u *ChecklistUpdate) ClearTaskLinks() *ChecklistUpdate {
+	_u.mutation.ClearTaskLinks()
+	return _u
+}
+
+// RemoveTaskLinkIDs removes the "task_links" edge to TaskChecklist entities by IDs.
+func (_u *ChecklistUpdate) RemoveTaskLinkIDs(ids ...int) *ChecklistUpdate {
+	_u.mutation.RemoveTaskLinkIDs(ids...)
+	return _u
+}
+
+// RemoveTaskLinks removes "task_links" edges to TaskChecklist entities.
+func (_u *ChecklistUpdate) RemoveTaskLinks(v ...*TaskChecklist) *ChecklistUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskLinkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ChecklistUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -305,6 +342,51 @@ func (_u *ChecklistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TaskLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   checklist.TaskLinksTable,
+			Columns: []string{checklist.TaskLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTaskLinksIDs(); len(nodes) > 0 && !_u.mutation.TaskLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   checklist.TaskLinksTable,
+			Columns: []string{checklist.TaskLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   checklist.TaskLinksTable,
+			Columns: []string{checklist.TaskLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{checklist.Label}
@@ -403,6 +485,21 @@ func (_u *ChecklistUpdateOne) AddTasks(v ...*Task) *ChecklistUpdateOne {
 	return _u.AddTaskIDs(ids...)
 }
 
+// AddTaskLinkIDs adds the "task_links" edge to the TaskChecklist entity by IDs.
+func (_u *ChecklistUpdateOne) AddTaskLinkIDs(ids ...int) *ChecklistUpdateOne {
+	_u.mutation.AddTaskLinkIDs(ids...)
+	return _u
+}
+
+// AddTaskLinks adds the "task_links" edges to the TaskChecklist entity.
+func (_u *ChecklistUpdateOne) AddTaskLinks(v ...*TaskChecklist) *ChecklistUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskLinkIDs(ids...)
+}
+
 // Mutation returns the ChecklistMutation object of the builder.
 func (_u *ChecklistUpdateOne) Mutation() *ChecklistMutation {
 	return _u.mutation
@@ -450,6 +547,27 @@ func (_u *ChecklistUpdateOne) RemoveTasks(v ...*Task) *ChecklistUpdateOne {
 	return _u.RemoveTaskIDs(ids...)
 }
 
+// ClearTaskLinks clears all "task_links" edges to the TaskChecklist entity.
+func (_u *ChecklistUpdateOne) ClearTaskLinks() *ChecklistUpdateOne {
+	_u.mutation.ClearTaskLinks()
+	return _u
+}
+
+// RemoveTaskLinkIDs removes the "task_links" edge to TaskChecklist entities by IDs.
+func (_u *ChecklistUpdateOne) RemoveTaskLinkIDs(ids ...int) *ChecklistUpdateOne {
+	_u.mutation.RemoveTaskLinkIDs(ids...)
+	return _u
+}
+
+// RemoveTaskLinks removes "task_links" edges to TaskChecklist entities.
+func (_u *ChecklistUpdateOne) RemoveTaskLinks(v ...*TaskChecklist) *ChecklistUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskLinkIDs(ids...)
+}
+
 // Where appends a list predicates to the ChecklistUpdate builder.
 func (_u *ChecklistUpdateOne) Where(ps ...predicate.Checklist) *ChecklistUpdateOne {
 	_u.mutation.Where(ps...)
@@ -631,6 +749,51 @@ func (_u *ChecklistUpdateOne) sqlSave(ctx context.Context) (_node *Checklist, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TaskLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   checklist.TaskLinksTable,
+			Columns: []string{checklist.TaskLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTaskLinksIDs(); len(nodes) > 0 && !_u.mutation.TaskLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   checklist.TaskLinksTable,
+			Columns: []string{checklist.TaskLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   checklist.TaskLinksTable,
+			Columns: []string{checklist.TaskLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Checklist{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -24,6 +24,8 @@ type ChecklistElement struct {
 	ElementID int `json:"element_id,omitempty"`
 	// OrderIndex holds the value of the "order_index" field.
 	OrderIndex int `json:"order_index,omitempty"`
+	// PhotoRequired holds the value of the "photo_required" field.
+	PhotoRequired bool `json:"photo_required,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChecklistElementQuery when eager-loading is set.
 	Edges        ChecklistElementEdges `json:"edges"`
@@ -79,6 +81,8 @@ func (*ChecklistElement) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case checklistelement.FieldPhotoRequired:
+			values[i] = new(sql.NullBool)
 		case checklistelement.FieldID, checklistelement.FieldChecklistID, checklistelement.FieldElementID, checklistelement.FieldOrderIndex:
 			values[i] = new(sql.NullInt64)
 		default:
@@ -120,6 +124,12 @@ func (_m *ChecklistElement) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.OrderIndex = int(value.Int64)
 			}
+		case checklistelement.FieldPhotoRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_required", values[i])
+			} else if value.Valid {
+				_m.PhotoRequired = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -179,6 +189,9 @@ func (_m *ChecklistElement) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("order_index=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrderIndex))
+	builder.WriteString(", ")
+	builder.WriteString("photo_required=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PhotoRequired))
 	builder.WriteByte(')')
 	return builder.String()
 }
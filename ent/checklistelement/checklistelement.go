@@ -18,6 +18,8 @@ const (
 	FieldElementID = "element_id"
 	// FieldOrderIndex holds the string denoting the order_index field in the database.
 	FieldOrderIndex = "order_index"
+	// FieldPhotoRequired holds the string denoting the photo_required field in the database.
+	FieldPhotoRequired = "photo_required"
 	// EdgeChecklist holds the string denoting the checklist edge name in mutations.
 	EdgeChecklist = "checklist"
 	// EdgeElementCatalog holds the string denoting the element_catalog edge name in mutations.
@@ -55,6 +57,7 @@ var Columns = []string{
 	FieldChecklistID,
 	FieldElementID,
 	FieldOrderIndex,
+	FieldPhotoRequired,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -67,6 +70,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultPhotoRequired holds the default value on creation for the "photo_required" field.
+	DefaultPhotoRequired bool
+)
+
 // OrderOption defines the ordering options for the ChecklistElement queries.
 type OrderOption func(*sql.Selector)
 
@@ -90,6 +98,11 @@ func ByOrderIndex(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrderIndex, opts...).ToFunc()
 }
 
+// ByPhotoRequired orders the results by the photo_required field.
+func ByPhotoRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhotoRequired, opts...).ToFunc()
+}
+
 // ByChecklistField orders the results by checklist field.
 func ByChecklistField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -69,6 +69,11 @@ func OrderIndex(v int) predicate.ChecklistElement {
 	return predicate.ChecklistElement(sql.FieldEQ(FieldOrderIndex, v))
 }
 
+// PhotoRequired applies equality check predicate on the "photo_required" field. It's identical to PhotoRequiredEQ.
+func PhotoRequired(v bool) predicate.ChecklistElement {
+	return predicate.ChecklistElement(sql.FieldEQ(FieldPhotoRequired, v))
+}
+
 // ChecklistIDEQ applies the EQ predicate on the "checklist_id" field.
 func ChecklistIDEQ(v int) predicate.ChecklistElement {
 	return predicate.ChecklistElement(sql.FieldEQ(FieldChecklistID, v))
@@ -159,6 +164,16 @@ func OrderIndexNotNil() predicate.ChecklistElement {
 	return predicate.ChecklistElement(sql.FieldNotNull(FieldOrderIndex))
 }
 
+// PhotoRequiredEQ applies the EQ predicate on the "photo_required" field.
+func PhotoRequiredEQ(v bool) predicate.ChecklistElement {
+	return predicate.ChecklistElement(sql.FieldEQ(FieldPhotoRequired, v))
+}
+
+// PhotoRequiredNEQ applies the NEQ predicate on the "photo_required" field.
+func PhotoRequiredNEQ(v bool) predicate.ChecklistElement {
+	return predicate.ChecklistElement(sql.FieldNEQ(FieldPhotoRequired, v))
+}
+
 // HasChecklist applies the HasEdge predicate on the "checklist" edge.
 func HasChecklist() predicate.ChecklistElement {
 	return predicate.ChecklistElement(func(s *sql.Selector) {
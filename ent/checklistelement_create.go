@@ -48,6 +48,20 @@ func (_c *ChecklistElementCreate) SetNillableOrderIndex(v *int) *ChecklistElemen
 	return _c
 }
 
+// SetPhotoRequired sets the "photo_required" field.
+func (_c *ChecklistElementCreate) SetPhotoRequired(v bool) *ChecklistElementCreate {
+	_c.mutation.SetPhotoRequired(v)
+	return _c
+}
+
+// SetNillablePhotoRequired sets the "photo_required" field if the given value is not nil.
+func (_c *ChecklistElementCreate) SetNillablePhotoRequired(v *bool) *ChecklistElementCreate {
+	if v != nil {
+		_c.SetPhotoRequired(*v)
+	}
+	return _c
+}
+
 // SetChecklist sets the "checklist" edge to the Checklist entity.
 func (_c *ChecklistElementCreate) SetChecklist(v *Checklist) *ChecklistElementCreate {
 	return _c.SetChecklistID(v.ID)
@@ -86,6 +100,7 @@ func (_c *ChecklistElementCreate) Mutation() *ChecklistElementMutation {
 
 // Save creates the ChecklistElement in the database.
 func (_c *ChecklistElementCreate) Save(ctx context.Context) (*ChecklistElement, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -111,6 +126,14 @@ func (_c *ChecklistElementCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *ChecklistElementCreate) defaults() {
+	if _, ok := _c.mutation.PhotoRequired(); !ok {
+		v := checklistelement.DefaultPhotoRequired
+		_c.mutation.SetPhotoRequired(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *ChecklistElementCreate) check() error {
 	if _, ok := _c.mutation.ChecklistID(); !ok {
@@ -119,6 +142,9 @@ func (_c *ChecklistElementCreate) check() error {
 	if _, ok := _c.mutation.ElementID(); !ok {
 		return &ValidationError{Name: "element_id", err: errors.New(`ent: missing required field "ChecklistElement.element_id"`)}
 	}
+	if _, ok := _c.mutation.PhotoRequired(); !ok {
+		return &ValidationError{Name: "photo_required", err: errors.New(`ent: missing required field "ChecklistElement.photo_required"`)}
+	}
 	if len(_c.mutation.ChecklistIDs()) == 0 {
 		return &ValidationError{Name: "checklist", err: errors.New(`ent: missing required edge "ChecklistElement.checklist"`)}
 	}
@@ -155,6 +181,10 @@ func (_c *ChecklistElementCreate) createSpec() (*ChecklistElement, *sqlgraph.Cre
 		_spec.SetField(checklistelement.FieldOrderIndex, field.TypeInt, value)
 		_node.OrderIndex = value
 	}
+	if value, ok := _c.mutation.PhotoRequired(); ok {
+		_spec.SetField(checklistelement.FieldPhotoRequired, field.TypeBool, value)
+		_node.PhotoRequired = value
+	}
 	if nodes := _c.mutation.ChecklistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -226,6 +256,7 @@ func (_c *ChecklistElementCreateBulk) Save(ctx context.Context) ([]*ChecklistEle
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ChecklistElementMutation)
 				if !ok {
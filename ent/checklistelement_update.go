@@ -85,6 +85,20 @@ func (_u *ChecklistElementUpdate) ClearOrderIndex() *ChecklistElementUpdate {
 	return _u
 }
 
+// SetPhotoRequired sets the "photo_required" field.
+func (_u *ChecklistElementUpdate) SetPhotoRequired(v bool) *ChecklistElementUpdate {
+	_u.mutation.SetPhotoRequired(v)
+	return _u
+}
+
+// SetNillablePhotoRequired sets the "photo_required" field if the given value is not nil.
+func (_u *ChecklistElementUpdate) SetNillablePhotoRequired(v *bool) *ChecklistElementUpdate {
+	if v != nil {
+		_u.SetPhotoRequired(*v)
+	}
+	return _u
+}
+
 // SetChecklist sets the "checklist" edge to the Checklist entity.
 func (_u *ChecklistElementUpdate) SetChecklist(v *Checklist) *ChecklistElementUpdate {
 	return _u.SetChecklistID(v.ID)
@@ -213,6 +227,9 @@ func (_u *ChecklistElementUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.OrderIndexCleared() {
 		_spec.ClearField(checklistelement.FieldOrderIndex, field.TypeInt)
 	}
+	if value, ok := _u.mutation.PhotoRequired(); ok {
+		_spec.SetField(checklistelement.FieldPhotoRequired, field.TypeBool, value)
+	}
 	if _u.mutation.ChecklistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -391,6 +408,20 @@ func (_u *ChecklistElementUpdateOne) ClearOrderIndex() *ChecklistElementUpdateOn
 	return _u
 }
 
+// SetPhotoRequired sets the "photo_required" field.
+func (_u *ChecklistElementUpdateOne) SetPhotoRequired(v bool) *ChecklistElementUpdateOne {
+	_u.mutation.SetPhotoRequired(v)
+	return _u
+}
+
+// SetNillablePhotoRequired sets the "photo_required" field if the given value is not nil.
+func (_u *ChecklistElementUpdateOne) SetNillablePhotoRequired(v *bool) *ChecklistElementUpdateOne {
+	if v != nil {
+		_u.SetPhotoRequired(*v)
+	}
+	return _u
+}
+
 // SetChecklist sets the "checklist" edge to the Checklist entity.
 func (_u *ChecklistElementUpdateOne) SetChecklist(v *Checklist) *ChecklistElementUpdateOne {
 	return _u.SetChecklistID(v.ID)
@@ -549,6 +580,9 @@ func (_u *ChecklistElementUpdateOne) sqlSave(ctx context.Context) (_node *Checkl
 	if _u.mutation.OrderIndexCleared() {
 		_spec.ClearField(checklistelement.FieldOrderIndex, field.TypeInt)
 	}
+	if value, ok := _u.mutation.PhotoRequired(); ok {
+		_spec.SetField(checklistelement.FieldPhotoRequired, field.TypeBool, value)
+	}
 	if _u.mutation.ChecklistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
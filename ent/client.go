@@ -11,6 +11,7 @@ import (
 
 	"jkh/ent/migrate"
 
+	"jkh/ent/actsequence"
 	"jkh/ent/building"
 	"jkh/ent/checklist"
 	"jkh/ent/checklistelement"
@@ -22,6 +23,8 @@ import (
 	"jkh/ent/jkhunit"
 	"jkh/ent/role"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
 
 	"entgo.io/ent"
@@ -35,6 +38,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ActSequence is the client for interacting with the ActSequence builders.
+	ActSequence *ActSequenceClient
 	// Building is the client for interacting with the Building builders.
 	Building *BuildingClient
 	// Checklist is the client for interacting with the Checklist builders.
@@ -57,6 +62,10 @@ type Client struct {
 	Role *RoleClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskChecklist is the client for interacting with the TaskChecklist builders.
+	TaskChecklist *TaskChecklistClient
+	// TaskStatusHistory is the client for interacting with the TaskStatusHistory builders.
+	TaskStatusHistory *TaskStatusHistoryClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -70,6 +79,7 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ActSequence = NewActSequenceClient(c.config)
 	c.Building = NewBuildingClient(c.config)
 	c.Checklist = NewChecklistClient(c.config)
 	c.ChecklistElement = NewChecklistElementClient(c.config)
@@ -81,6 +91,8 @@ func (c *Client) init() {
 	c.JkhUnit = NewJkhUnitClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaskChecklist = NewTaskChecklistClient(c.config)
+	c.TaskStatusHistory = NewTaskStatusHistoryClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -172,20 +184,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Building:         NewBuildingClient(cfg),
-		Checklist:        NewChecklistClient(cfg),
-		ChecklistElement: NewChecklistElementClient(cfg),
-		District:         NewDistrictClient(cfg),
-		ElementCatalog:   NewElementCatalogClient(cfg),
-		InspectionAct:    NewInspectionActClient(cfg),
-		InspectionResult: NewInspectionResultClient(cfg),
-		InspectorUnit:    NewInspectorUnitClient(cfg),
-		JkhUnit:          NewJkhUnitClient(cfg),
-		Role:             NewRoleClient(cfg),
-		Task:             NewTaskClient(cfg),
-		User:             NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ActSequence:       NewActSequenceClient(cfg),
+		Building:          NewBuildingClient(cfg),
+		Checklist:         NewChecklistClient(cfg),
+		ChecklistElement:  NewChecklistElementClient(cfg),
+		District:          NewDistrictClient(cfg),
+		ElementCatalog:    NewElementCatalogClient(cfg),
+		InspectionAct:     NewInspectionActClient(cfg),
+		InspectionResult:  NewInspectionResultClient(cfg),
+		InspectorUnit:     NewInspectorUnitClient(cfg),
+		JkhUnit:           NewJkhUnitClient(cfg),
+		Role:              NewRoleClient(cfg),
+		Task:              NewTaskClient(cfg),
+		TaskChecklist:     NewTaskChecklistClient(cfg),
+		TaskStatusHistory: NewTaskStatusHistoryClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
@@ -203,27 +218,30 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Building:         NewBuildingClient(cfg),
-		Checklist:        NewChecklistClient(cfg),
-		ChecklistElement: NewChecklistElementClient(cfg),
-		District:         NewDistrictClient(cfg),
-		ElementCatalog:   NewElementCatalogClient(cfg),
-		InspectionAct:    NewInspectionActClient(cfg),
-		InspectionResult: NewInspectionResultClient(cfg),
-		InspectorUnit:    NewInspectorUnitClient(cfg),
-		JkhUnit:          NewJkhUnitClient(cfg),
-		Role:             NewRoleClient(cfg),
-		Task:             NewTaskClient(cfg),
-		User:             NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ActSequence:       NewActSequenceClient(cfg),
+		Building:          NewBuildingClient(cfg),
+		Checklist:         NewChecklistClient(cfg),
+		ChecklistElement:  NewChecklistElementClient(cfg),
+		District:          NewDistrictClient(cfg),
+		ElementCatalog:    NewElementCatalogClient(cfg),
+		InspectionAct:     NewInspectionActClient(cfg),
+		InspectionResult:  NewInspectionResultClient(cfg),
+		InspectorUnit:     NewInspectorUnitClient(cfg),
+		JkhUnit:           NewJkhUnitClient(cfg),
+		Role:              NewRoleClient(cfg),
+		Task:              NewTaskClient(cfg),
+		TaskChecklist:     NewTaskChecklistClient(cfg),
+		TaskStatusHistory: NewTaskStatusHistoryClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Building.
+//		ActSequence.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -246,9 +264,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Building, c.Checklist, c.ChecklistElement, c.District, c.ElementCatalog,
-		c.InspectionAct, c.InspectionResult, c.InspectorUnit, c.JkhUnit, c.Role,
-		c.Task, c.User,
+		c.ActSequence, c.Building, c.Checklist, c.ChecklistElement, c.District,
+		c.ElementCatalog, c.InspectionAct, c.InspectionResult, c.InspectorUnit,
+		c.JkhUnit, c.Role, c.Task, c.TaskChecklist, c.TaskStatusHistory, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -258,9 +276,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Building, c.Checklist, c.ChecklistElement, c.District, c.ElementCatalog,
-		c.InspectionAct, c.InspectionResult, c.InspectorUnit, c.JkhUnit, c.Role,
-		c.Task, c.User,
+		c.ActSequence, c.Building, c.Checklist, c.ChecklistElement, c.District,
+		c.ElementCatalog, c.InspectionAct, c.InspectionResult, c.InspectorUnit,
+		c.JkhUnit, c.Role, c.Task, c.TaskChecklist, c.TaskStatusHistory, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -269,6 +287,8 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ActSequenceMutation:
+		return c.ActSequence.mutate(ctx, m)
 	case *BuildingMutation:
 		return c.Building.mutate(ctx, m)
 	case *ChecklistMutation:
@@ -291,6 +311,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Role.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaskChecklistMutation:
+		return c.TaskChecklist.mutate(ctx, m)
+	case *TaskStatusHistoryMutation:
+		return c.TaskStatusHistory.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -298,6 +322,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ActSequenceClient is a client for the ActSequence schema.
+type ActSequenceClient struct {
+	config
+}
+
+// NewActSequenceClient returns a client for the ActSequence from the given config.
+func NewActSequenceClient(c config) *ActSequenceClient {
+	return &ActSequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `actsequence.Hooks(f(g(h())))`.
+func (c *ActSequenceClient) Use(hooks ...Hook) {
+	c.hooks.ActSequence = append(c.hooks.ActSequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `actsequence.Intercept(f(g(h())))`.
+func (c *ActSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActSequence = append(c.inters.ActSequence, interceptors...)
+}
+
+// Create returns a builder for creating a ActSequence entity.
+func (c *ActSequenceClient) Create() *ActSequenceCreate {
+	mutation := newActSequenceMutation(c.config, OpCreate)
+	return &ActSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ActSequence entities.
+func (c *ActSequenceClient) CreateBulk(builders ...*ActSequenceCreate) *ActSequenceCreateBulk {
+	return &ActSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ActSequenceClient) MapCreateBulk(slice any, setFunc func(*ActSequenceCreate, int)) *ActSequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ActSequenceCreateBulk{err: fmt.Errorf("calling to ActSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ActSequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ActSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ActSequence.
+func (c *ActSequenceClient) Update() *ActSequenceUpdate {
+	mutation := newActSequenceMutation(c.config, OpUpdate)
+	return &ActSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ActSequenceClient) UpdateOne(_m *ActSequence) *ActSequenceUpdateOne {
+	mutation := newActSequenceMutation(c.config, OpUpdateOne, withActSequence(_m))
+	return &ActSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ActSequenceClient) UpdateOneID(id int) *ActSequenceUpdateOne {
+	mutation := newActSequenceMutation(c.config, OpUpdateOne, withActSequenceID(id))
+	return &ActSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ActSequence.
+func (c *ActSequenceClient) Delete() *ActSequenceDelete {
+	mutation := newActSequenceMutation(c.config, OpDelete)
+	return &ActSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ActSequenceClient) DeleteOne(_m *ActSequence) *ActSequenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ActSequenceClient) DeleteOneID(id int) *ActSequenceDeleteOne {
+	builder := c.Delete().Where(actsequence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ActSequenceDeleteOne{builder}
+}
+
+// Query returns a query builder for ActSequence.
+func (c *ActSequenceClient) Query() *ActSequenceQuery {
+	return &ActSequenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeActSequence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ActSequence entity by its id.
+func (c *ActSequenceClient) Get(ctx context.Context, id int) (*ActSequence, error) {
+	return c.Query().Where(actsequence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ActSequenceClient) GetX(ctx context.Context, id int) *ActSequence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ActSequenceClient) Hooks() []Hook {
+	return c.hooks.ActSequence
+}
+
+// Interceptors returns the client interceptors.
+func (c *ActSequenceClient) Interceptors() []Interceptor {
+	return c.inters.ActSequence
+}
+
+func (c *ActSequenceClient) mutate(ctx context.Context, m *ActSequenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ActSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ActSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ActSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ActSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ActSequence mutation op: %q", m.Op())
+	}
+}
+
 // BuildingClient is a client for the Building schema.
 type BuildingClient struct {
 	config
@@ -635,6 +792,22 @@ func (c *ChecklistClient) QueryTasks(_m *Checklist) *TaskQuery {
 	return query
 }
 
+// QueryTaskLinks queries the task_links edge of a Checklist.
+func (c *ChecklistClient) QueryTaskLinks(_m *Checklist) *TaskChecklistQuery {
+	query := (&TaskChecklistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checklist.Table, checklist.FieldID, id),
+			sqlgraph.To(taskchecklist.Table, taskchecklist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, checklist.TaskLinksTable, checklist.TaskLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ChecklistClient) Hooks() []Hook {
 	return c.hooks.Checklist
@@ -2152,6 +2325,38 @@ func (c *TaskClient) QueryAct(_m *Task) *InspectionActQuery {
 	return query
 }
 
+// QueryStatusHistory queries the status_history edge of a Task.
+func (c *TaskClient) QueryStatusHistory(_m *Task) *TaskStatusHistoryQuery {
+	query := (&TaskStatusHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskstatushistory.Table, taskstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.StatusHistoryTable, task.StatusHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExtraChecklists queries the extra_checklists edge of a Task.
+func (c *TaskClient) QueryExtraChecklists(_m *Task) *TaskChecklistQuery {
+	query := (&TaskChecklistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskchecklist.Table, taskchecklist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ExtraChecklistsTable, task.ExtraChecklistsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TaskClient) Hooks() []Hook {
 	return c.hooks.Task
@@ -2177,6 +2382,336 @@ func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error)
 	}
 }
 
+// TaskChecklistClient is a client for the TaskChecklist schema.
+type TaskChecklistClient struct {
+	config
+}
+
+// NewTaskChecklistClient returns a client for the TaskChecklist from the given config.
+func NewTaskChecklistClient(c config) *TaskChecklistClient {
+	return &TaskChecklistClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskchecklist.Hooks(f(g(h())))`.
+func (c *TaskChecklistClient) Use(hooks ...Hook) {
+	c.hooks.TaskChecklist = append(c.hooks.TaskChecklist, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskchecklist.Intercept(f(g(h())))`.
+func (c *TaskChecklistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskChecklist = append(c.inters.TaskChecklist, interceptors...)
+}
+
+// Create returns a builder for creating a TaskChecklist entity.
+func (c *TaskChecklistClient) Create() *TaskChecklistCreate {
+	mutation := newTaskChecklistMutation(c.config, OpCreate)
+	return &TaskChecklistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskChecklist entities.
+func (c *TaskChecklistClient) CreateBulk(builders ...*TaskChecklistCreate) *TaskChecklistCreateBulk {
+	return &TaskChecklistCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskChecklistClient) MapCreateBulk(slice any, setFunc func(*TaskChecklistCreate, int)) *TaskChecklistCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskChecklistCreateBulk{err: fmt.Errorf("calling to TaskChecklistClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskChecklistCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskChecklistCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskChecklist.
+func (c *TaskChecklistClient) Update() *TaskChecklistUpdate {
+	mutation := newTaskChecklistMutation(c.config, OpUpdate)
+	return &TaskChecklistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskChecklistClient) UpdateOne(_m *TaskChecklist) *TaskChecklistUpdateOne {
+	mutation := newTaskChecklistMutation(c.config, OpUpdateOne, withTaskChecklist(_m))
+	return &TaskChecklistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskChecklistClient) UpdateOneID(id int) *TaskChecklistUpdateOne {
+	mutation := newTaskChecklistMutation(c.config, OpUpdateOne, withTaskChecklistID(id))
+	return &TaskChecklistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskChecklist.
+func (c *TaskChecklistClient) Delete() *TaskChecklistDelete {
+	mutation := newTaskChecklistMutation(c.config, OpDelete)
+	return &TaskChecklistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskChecklistClient) DeleteOne(_m *TaskChecklist) *TaskChecklistDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskChecklistClient) DeleteOneID(id int) *TaskChecklistDeleteOne {
+	builder := c.Delete().Where(taskchecklist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskChecklistDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskChecklist.
+func (c *TaskChecklistClient) Query() *TaskChecklistQuery {
+	return &TaskChecklistQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskChecklist},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskChecklist entity by its id.
+func (c *TaskChecklistClient) Get(ctx context.Context, id int) (*TaskChecklist, error) {
+	return c.Query().Where(taskchecklist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskChecklistClient) GetX(ctx context.Context, id int) *TaskChecklist {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskChecklist.
+func (c *TaskChecklistClient) QueryTask(_m *TaskChecklist) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskchecklist.Table, taskchecklist.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskchecklist.TaskTable, taskchecklist.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChecklist queries the checklist edge of a TaskChecklist.
+func (c *TaskChecklistClient) QueryChecklist(_m *TaskChecklist) *ChecklistQuery {
+	query := (&ChecklistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskchecklist.Table, taskchecklist.FieldID, id),
+			sqlgraph.To(checklist.Table, checklist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskchecklist.ChecklistTable, taskchecklist.ChecklistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskChecklistClient) Hooks() []Hook {
+	return c.hooks.TaskChecklist
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskChecklistClient) Interceptors() []Interceptor {
+	return c.inters.TaskChecklist
+}
+
+func (c *TaskChecklistClient) mutate(ctx context.Context, m *TaskChecklistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskChecklistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskChecklistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskChecklistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskChecklistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskChecklist mutation op: %q", m.Op())
+	}
+}
+
+// TaskStatusHistoryClient is a client for the TaskStatusHistory schema.
+type TaskStatusHistoryClient struct {
+	config
+}
+
+// NewTaskStatusHistoryClient returns a client for the TaskStatusHistory from the given config.
+func NewTaskStatusHistoryClient(c config) *TaskStatusHistoryClient {
+	return &TaskStatusHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskstatushistory.Hooks(f(g(h())))`.
+func (c *TaskStatusHistoryClient) Use(hooks ...Hook) {
+	c.hooks.TaskStatusHistory = append(c.hooks.TaskStatusHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskstatushistory.Intercept(f(g(h())))`.
+func (c *TaskStatusHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskStatusHistory = append(c.inters.TaskStatusHistory, interceptors...)
+}
+
+// Create returns a builder for creating a TaskStatusHistory entity.
+func (c *TaskStatusHistoryClient) Create() *TaskStatusHistoryCreate {
+	mutation := newTaskStatusHistoryMutation(c.config, OpCreate)
+	return &TaskStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskStatusHistory entities.
+func (c *TaskStatusHistoryClient) CreateBulk(builders ...*TaskStatusHistoryCreate) *TaskStatusHistoryCreateBulk {
+	return &TaskStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskStatusHistoryClient) MapCreateBulk(slice any, setFunc func(*TaskStatusHistoryCreate, int)) *TaskStatusHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskStatusHistoryCreateBulk{err: fmt.Errorf("calling to TaskStatusHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskStatusHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskStatusHistory.
+func (c *TaskStatusHistoryClient) Update() *TaskStatusHistoryUpdate {
+	mutation := newTaskStatusHistoryMutation(c.config, OpUpdate)
+	return &TaskStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskStatusHistoryClient) UpdateOne(_m *TaskStatusHistory) *TaskStatusHistoryUpdateOne {
+	mutation := newTaskStatusHistoryMutation(c.config, OpUpdateOne, withTaskStatusHistory(_m))
+	return &TaskStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskStatusHistoryClient) UpdateOneID(id int) *TaskStatusHistoryUpdateOne {
+	mutation := newTaskStatusHistoryMutation(c.config, OpUpdateOne, withTaskStatusHistoryID(id))
+	return &TaskStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskStatusHistory.
+func (c *TaskStatusHistoryClient) Delete() *TaskStatusHistoryDelete {
+	mutation := newTaskStatusHistoryMutation(c.config, OpDelete)
+	return &TaskStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskStatusHistoryClient) DeleteOne(_m *TaskStatusHistory) *TaskStatusHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskStatusHistoryClient) DeleteOneID(id int) *TaskStatusHistoryDeleteOne {
+	builder := c.Delete().Where(taskstatushistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskStatusHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskStatusHistory.
+func (c *TaskStatusHistoryClient) Query() *TaskStatusHistoryQuery {
+	return &TaskStatusHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskStatusHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskStatusHistory entity by its id.
+func (c *TaskStatusHistoryClient) Get(ctx context.Context, id int) (*TaskStatusHistory, error) {
+	return c.Query().Where(taskstatushistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskStatusHistoryClient) GetX(ctx context.Context, id int) *TaskStatusHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskStatusHistory.
+func (c *TaskStatusHistoryClient) QueryTask(_m *TaskStatusHistory) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskstatushistory.Table, taskstatushistory.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskstatushistory.TaskTable, taskstatushistory.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChangedByUser queries the changed_by_user edge of a TaskStatusHistory.
+func (c *TaskStatusHistoryClient) QueryChangedByUser(_m *TaskStatusHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskstatushistory.Table, taskstatushistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskstatushistory.ChangedByUserTable, taskstatushistory.ChangedByUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskStatusHistoryClient) Hooks() []Hook {
+	return c.hooks.TaskStatusHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskStatusHistoryClient) Interceptors() []Interceptor {
+	return c.inters.TaskStatusHistory
+}
+
+func (c *TaskStatusHistoryClient) mutate(ctx context.Context, m *TaskStatusHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskStatusHistory mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -2349,6 +2884,22 @@ func (c *UserClient) QueryAssignedUnits(_m *User) *InspectorUnitQuery {
 	return query
 }
 
+// QueryStatusChanges queries the status_changes edge of a User.
+func (c *UserClient) QueryStatusChanges(_m *User) *TaskStatusHistoryQuery {
+	query := (&TaskStatusHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(taskstatushistory.Table, taskstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StatusChangesTable, user.StatusChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2377,11 +2928,13 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Building, Checklist, ChecklistElement, District, ElementCatalog, InspectionAct,
-		InspectionResult, InspectorUnit, JkhUnit, Role, Task, User []ent.Hook
+		ActSequence, Building, Checklist, ChecklistElement, District, ElementCatalog,
+		InspectionAct, InspectionResult, InspectorUnit, JkhUnit, Role, Task,
+		TaskChecklist, TaskStatusHistory, User []ent.Hook
 	}
 	inters struct {
-		Building, Checklist, ChecklistElement, District, ElementCatalog, InspectionAct,
-		InspectionResult, InspectorUnit, JkhUnit, Role, Task, User []ent.Interceptor
+		ActSequence, Building, Checklist, ChecklistElement, District, ElementCatalog,
+		InspectionAct, InspectionResult, InspectorUnit, JkhUnit, Role, Task,
+		TaskChecklist, TaskStatusHistory, User []ent.Interceptor
 	}
 )
@@ -18,6 +18,8 @@ type District struct {
 	ID int `json:"id,omitempty"`
 	// Название района (уникальное).
 	Name string `json:"name,omitempty"`
+	// Описание района: границы, контакты, ответственные службы.
+	Description string `json:"description,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DistrictQuery when eager-loading is set.
 	Edges        DistrictEdges `json:"edges"`
@@ -60,7 +62,7 @@ func (*District) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case district.FieldID:
 			values[i] = new(sql.NullInt64)
-		case district.FieldName:
+		case district.FieldName, district.FieldDescription:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -89,6 +91,12 @@ func (_m *District) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case district.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -137,6 +145,9 @@ func (_m *District) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -27,6 +27,20 @@ func (_c *DistrictCreate) SetName(v string) *DistrictCreate {
 	return _c
 }
 
+// SetDescription sets the "description" field.
+func (_c *DistrictCreate) SetDescription(v string) *DistrictCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *DistrictCreate) SetNillableDescription(v *string) *DistrictCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
 // AddJkhUnitIDs adds the "jkh_units" edge to the JkhUnit entity by IDs.
 func (_c *DistrictCreate) AddJkhUnitIDs(ids ...int) *DistrictCreate {
 	_c.mutation.AddJkhUnitIDs(ids...)
@@ -124,6 +138,10 @@ func (_c *DistrictCreate) createSpec() (*District, *sqlgraph.CreateSpec) {
 		_spec.SetField(district.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(district.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
 	if nodes := _c.mutation.JkhUnitsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -43,6 +43,26 @@ func (_u *DistrictUpdate) SetNillableName(v *string) *DistrictUpdate {
 	return _u
 }
 
+// SetDescription sets the "description" field.
+func (_u *DistrictUpdate) SetDescription(v string) *DistrictUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DistrictUpdate) SetNillableDescription(v *string) *DistrictUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DistrictUpdate) ClearDescription() *DistrictUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
 // AddJkhUnitIDs adds the "jkh_units" edge to the JkhUnit entity by IDs.
 func (_u *DistrictUpdate) AddJkhUnitIDs(ids ...int) *DistrictUpdate {
 	_u.mutation.AddJkhUnitIDs(ids...)
@@ -159,6 +179,12 @@ func (_u *DistrictUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(district.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(district.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(district.FieldDescription, field.TypeString)
+	}
 	if _u.mutation.JkhUnitsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -283,6 +309,26 @@ func (_u *DistrictUpdateOne) SetNillableName(v *string) *DistrictUpdateOne {
 	return _u
 }
 
+// SetDescription sets the "description" field.
+func (_u *DistrictUpdateOne) SetDescription(v string) *DistrictUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DistrictUpdateOne) SetNillableDescription(v *string) *DistrictUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DistrictUpdateOne) ClearDescription() *DistrictUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
 // AddJkhUnitIDs adds the "jkh_units" edge to the JkhUnit entity by IDs.
 func (_u *DistrictUpdateOne) AddJkhUnitIDs(ids ...int) *DistrictUpdateOne {
 	_u.mutation.AddJkhUnitIDs(ids...)
@@ -429,6 +475,12 @@ func (_u *DistrictUpdateOne) sqlSave(ctx context.Context) (_node *District, err
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(district.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(district.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(district.FieldDescription, field.TypeString)
+	}
 	if _u.mutation.JkhUnitsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
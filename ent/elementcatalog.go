@@ -18,6 +18,8 @@ type ElementCatalog struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// NameEn holds the value of the "name_en" field.
+	NameEn string `json:"name_en,omitempty"`
 	// Category holds the value of the "category" field.
 	Category string `json:"category,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -51,7 +53,7 @@ func (*ElementCatalog) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case elementcatalog.FieldID:
 			values[i] = new(sql.NullInt64)
-		case elementcatalog.FieldName, elementcatalog.FieldCategory:
+		case elementcatalog.FieldName, elementcatalog.FieldNameEn, elementcatalog.FieldCategory:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -80,6 +82,12 @@ func (_m *ElementCatalog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case elementcatalog.FieldNameEn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_en", values[i])
+			} else if value.Valid {
+				_m.NameEn = value.String
+			}
 		case elementcatalog.FieldCategory:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field category", values[i])
@@ -130,6 +138,9 @@ func (_m *ElementCatalog) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("name_en=")
+	builder.WriteString(_m.NameEn)
+	builder.WriteString(", ")
 	builder.WriteString("category=")
 	builder.WriteString(_m.Category)
 	builder.WriteByte(')')
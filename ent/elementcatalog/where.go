@@ -59,6 +59,11 @@ func Name(v string) predicate.ElementCatalog {
 	return predicate.ElementCatalog(sql.FieldEQ(FieldName, v))
 }
 
+// NameEn applies equality check predicate on the "name_en" field. It's identical to NameEnEQ.
+func NameEn(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldEQ(FieldNameEn, v))
+}
+
 // Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
 func Category(v string) predicate.ElementCatalog {
 	return predicate.ElementCatalog(sql.FieldEQ(FieldCategory, v))
@@ -129,6 +134,81 @@ func NameContainsFold(v string) predicate.ElementCatalog {
 	return predicate.ElementCatalog(sql.FieldContainsFold(FieldName, v))
 }
 
+// NameEnEQ applies the EQ predicate on the "name_en" field.
+func NameEnEQ(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldEQ(FieldNameEn, v))
+}
+
+// NameEnNEQ applies the NEQ predicate on the "name_en" field.
+func NameEnNEQ(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldNEQ(FieldNameEn, v))
+}
+
+// NameEnIn applies the In predicate on the "name_en" field.
+func NameEnIn(vs ...string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldIn(FieldNameEn, vs...))
+}
+
+// NameEnNotIn applies the NotIn predicate on the "name_en" field.
+func NameEnNotIn(vs ...string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldNotIn(FieldNameEn, vs...))
+}
+
+// NameEnGT applies the GT predicate on the "name_en" field.
+func NameEnGT(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldGT(FieldNameEn, v))
+}
+
+// NameEnGTE applies the GTE predicate on the "name_en" field.
+func NameEnGTE(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldGTE(FieldNameEn, v))
+}
+
+// NameEnLT applies the LT predicate on the "name_en" field.
+func NameEnLT(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldLT(FieldNameEn, v))
+}
+
+// NameEnLTE applies the LTE predicate on the "name_en" field.
+func NameEnLTE(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldLTE(FieldNameEn, v))
+}
+
+// NameEnContains applies the Contains predicate on the "name_en" field.
+func NameEnContains(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldContains(FieldNameEn, v))
+}
+
+// NameEnHasPrefix applies the HasPrefix predicate on the "name_en" field.
+func NameEnHasPrefix(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldHasPrefix(FieldNameEn, v))
+}
+
+// NameEnHasSuffix applies the HasSuffix predicate on the "name_en" field.
+func NameEnHasSuffix(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldHasSuffix(FieldNameEn, v))
+}
+
+// NameEnIsNil applies the IsNil predicate on the "name_en" field.
+func NameEnIsNil() predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldIsNull(FieldNameEn))
+}
+
+// NameEnNotNil applies the NotNil predicate on the "name_en" field.
+func NameEnNotNil() predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldNotNull(FieldNameEn))
+}
+
+// NameEnEqualFold applies the EqualFold predicate on the "name_en" field.
+func NameEnEqualFold(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldEqualFold(FieldNameEn, v))
+}
+
+// NameEnContainsFold applies the ContainsFold predicate on the "name_en" field.
+func NameEnContainsFold(v string) predicate.ElementCatalog {
+	return predicate.ElementCatalog(sql.FieldContainsFold(FieldNameEn, v))
+}
+
 // CategoryEQ applies the EQ predicate on the "category" field.
 func CategoryEQ(v string) predicate.ElementCatalog {
 	return predicate.ElementCatalog(sql.FieldEQ(FieldCategory, v))
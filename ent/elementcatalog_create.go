@@ -26,6 +26,20 @@ func (_c *ElementCatalogCreate) SetName(v string) *ElementCatalogCreate {
 	return _c
 }
 
+// SetNameEn sets the "name_en" field.
+func (_c *ElementCatalogCreate) SetNameEn(v string) *ElementCatalogCreate {
+	_c.mutation.SetNameEn(v)
+	return _c
+}
+
+// SetNillableNameEn sets the "name_en" field if the given value is not nil.
+func (_c *ElementCatalogCreate) SetNillableNameEn(v *string) *ElementCatalogCreate {
+	if v != nil {
+		_c.SetNameEn(*v)
+	}
+	return _c
+}
+
 // SetCategory sets the "category" field.
 func (_c *ElementCatalogCreate) SetCategory(v string) *ElementCatalogCreate {
 	_c.mutation.SetCategory(v)
@@ -122,6 +136,10 @@ func (_c *ElementCatalogCreate) createSpec() (*ElementCatalog, *sqlgraph.CreateS
 		_spec.SetField(elementcatalog.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.NameEn(); ok {
+		_spec.SetField(elementcatalog.FieldNameEn, field.TypeString, value)
+		_node.NameEn = value
+	}
 	if value, ok := _c.mutation.Category(); ok {
 		_spec.SetField(elementcatalog.FieldCategory, field.TypeString, value)
 		_node.Category = value
@@ -42,6 +42,26 @@ func (_u *ElementCatalogUpdate) SetNillableName(v *string) *ElementCatalogUpdate
 	return _u
 }
 
+// SetNameEn sets the "name_en" field.
+func (_u *ElementCatalogUpdate) SetNameEn(v string) *ElementCatalogUpdate {
+	_u.mutation.SetNameEn(v)
+	return _u
+}
+
+// SetNillableNameEn sets the "name_en" field if the given value is not nil.
+func (_u *ElementCatalogUpdate) SetNillableNameEn(v *string) *ElementCatalogUpdate {
+	if v != nil {
+		_u.SetNameEn(*v)
+	}
+	return _u
+}
+
+// ClearNameEn clears the value of the "name_en" field.
+func (_u *ElementCatalogUpdate) ClearNameEn() *ElementCatalogUpdate {
+	_u.mutation.ClearNameEn()
+	return _u
+}
+
 // SetCategory sets the "category" field.
 func (_u *ElementCatalogUpdate) SetCategory(v string) *ElementCatalogUpdate {
 	_u.mutation.SetCategory(v)
@@ -142,6 +162,12 @@ func (_u *ElementCatalogUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(elementcatalog.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.NameEn(); ok {
+		_spec.SetField(elementcatalog.FieldNameEn, field.TypeString, value)
+	}
+	if _u.mutation.NameEnCleared() {
+		_spec.ClearField(elementcatalog.FieldNameEn, field.TypeString)
+	}
 	if value, ok := _u.mutation.Category(); ok {
 		_spec.SetField(elementcatalog.FieldCategory, field.TypeString, value)
 	}
@@ -227,6 +253,26 @@ func (_u *ElementCatalogUpdateOne) SetNillableName(v *string) *ElementCatalogUpd
 	return _u
 }
 
+// SetNameEn sets the "name_en" field.
+func (_u *ElementCatalogUpdateOne) SetNameEn(v string) *ElementCatalogUpdateOne {
+	_u.mutation.SetNameEn(v)
+	return _u
+}
+
+// SetNillableNameEn sets the "name_en" field if the given value is not nil.
+func (_u *ElementCatalogUpdateOne) SetNillableNameEn(v *string) *ElementCatalogUpdateOne {
+	if v != nil {
+		_u.SetNameEn(*v)
+	}
+	return _u
+}
+
+// ClearNameEn clears the value of the "name_en" field.
+func (_u *ElementCatalogUpdateOne) ClearNameEn() *ElementCatalogUpdateOne {
+	_u.mutation.ClearNameEn()
+	return _u
+}
+
 // SetCategory sets the "category" field.
 func (_u *ElementCatalogUpdateOne) SetCategory(v string) *ElementCatalogUpdateOne {
 	_u.mutation.SetCategory(v)
@@ -357,6 +403,12 @@ func (_u *ElementCatalogUpdateOne) sqlSave(ctx context.Context) (_node *ElementC
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(elementcatalog.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.NameEn(); ok {
+		_spec.SetField(elementcatalog.FieldNameEn, field.TypeString, value)
+	}
+	if _u.mutation.NameEnCleared() {
+		_spec.ClearField(elementcatalog.FieldNameEn, field.TypeString)
+	}
 	if value, ok := _u.mutation.Category(); ok {
 		_spec.SetField(elementcatalog.FieldCategory, field.TypeString, value)
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"jkh/ent/actsequence"
 	"jkh/ent/building"
 	"jkh/ent/checklist"
 	"jkh/ent/checklistelement"
@@ -17,6 +18,8 @@ import (
 	"jkh/ent/jkhunit"
 	"jkh/ent/role"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
 	"reflect"
 	"sync"
@@ -84,18 +87,21 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			building.Table:         building.ValidColumn,
-			checklist.Table:        checklist.ValidColumn,
-			checklistelement.Table: checklistelement.ValidColumn,
-			district.Table:         district.ValidColumn,
-			elementcatalog.Table:   elementcatalog.ValidColumn,
-			inspectionact.Table:    inspectionact.ValidColumn,
-			inspectionresult.Table: inspectionresult.ValidColumn,
-			inspectorunit.Table:    inspectorunit.ValidColumn,
-			jkhunit.Table:          jkhunit.ValidColumn,
-			role.Table:             role.ValidColumn,
-			task.Table:             task.ValidColumn,
-			user.Table:             user.ValidColumn,
+			actsequence.Table:       actsequence.ValidColumn,
+			building.Table:          building.ValidColumn,
+			checklist.Table:         checklist.ValidColumn,
+			checklistelement.Table:  checklistelement.ValidColumn,
+			district.Table:          district.ValidColumn,
+			elementcatalog.Table:    elementcatalog.ValidColumn,
+			inspectionact.Table:     inspectionact.ValidColumn,
+			inspectionresult.Table:  inspectionresult.ValidColumn,
+			inspectorunit.Table:     inspectorunit.ValidColumn,
+			jkhunit.Table:           jkhunit.ValidColumn,
+			role.Table:              role.ValidColumn,
+			task.Table:              task.ValidColumn,
+			taskchecklist.Table:     taskchecklist.ValidColumn,
+			taskstatushistory.Table: taskstatushistory.ValidColumn,
+			user.Table:              user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -8,6 +8,18 @@ import (
 	"jkh/ent"
 )
 
+// The ActSequenceFunc type is an adapter to allow the use of ordinary
+// function as ActSequence mutator.
+type ActSequenceFunc func(context.Context, *ent.ActSequenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ActSequenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ActSequenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActSequenceMutation", m)
+}
+
 // The BuildingFunc type is an adapter to allow the use of ordinary
 // function as Building mutator.
 type BuildingFunc func(context.Context, *ent.BuildingMutation) (ent.Value, error)
@@ -140,6 +152,30 @@ func (f TaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskMutation", m)
 }
 
+// The TaskChecklistFunc type is an adapter to allow the use of ordinary
+// function as TaskChecklist mutator.
+type TaskChecklistFunc func(context.Context, *ent.TaskChecklistMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskChecklistFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskChecklistMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskChecklistMutation", m)
+}
+
+// The TaskStatusHistoryFunc type is an adapter to allow the use of ordinary
+// function as TaskStatusHistory mutator.
+type TaskStatusHistoryFunc func(context.Context, *ent.TaskStatusHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskStatusHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskStatusHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskStatusHistoryMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -26,10 +26,18 @@ type InspectionAct struct {
 	ApprovedAt time.Time `json:"approved_at,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
+	// ActNumber holds the value of the "act_number" field.
+	ActNumber *string `json:"act_number,omitempty"`
 	// Conclusion holds the value of the "conclusion" field.
 	Conclusion string `json:"conclusion,omitempty"`
+	// ApprovalComment holds the value of the "approval_comment" field.
+	ApprovalComment *string `json:"approval_comment,omitempty"`
 	// DocumentPath holds the value of the "document_path" field.
 	DocumentPath string `json:"document_path,omitempty"`
+	// Language holds the value of the "language" field.
+	Language inspectionact.Language `json:"language,omitempty"`
+	// IncludeUnfilled holds the value of the "include_unfilled" field.
+	IncludeUnfilled bool `json:"include_unfilled,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InspectionActQuery when eager-loading is set.
 	Edges        InspectionActEdges `json:"edges"`
@@ -61,9 +69,11 @@ func (*InspectionAct) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case inspectionact.FieldIncludeUnfilled:
+			values[i] = new(sql.NullBool)
 		case inspectionact.FieldID, inspectionact.FieldTaskID:
 			values[i] = new(sql.NullInt64)
-		case inspectionact.FieldStatus, inspectionact.FieldConclusion, inspectionact.FieldDocumentPath:
+		case inspectionact.FieldStatus, inspectionact.FieldActNumber, inspectionact.FieldConclusion, inspectionact.FieldApprovalComment, inspectionact.FieldDocumentPath, inspectionact.FieldLanguage:
 			values[i] = new(sql.NullString)
 		case inspectionact.FieldCreatedAt, inspectionact.FieldApprovedAt:
 			values[i] = new(sql.NullTime)
@@ -112,18 +122,44 @@ func (_m *InspectionAct) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = value.String
 			}
+		case inspectionact.FieldActNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field act_number", values[i])
+			} else if value.Valid {
+				_m.ActNumber = new(string)
+				*_m.ActNumber = value.String
+			}
 		case inspectionact.FieldConclusion:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field conclusion", values[i])
 			} else if value.Valid {
 				_m.Conclusion = value.String
 			}
+		case inspectionact.FieldApprovalComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_comment", values[i])
+			} else if value.Valid {
+				_m.ApprovalComment = new(string)
+				*_m.ApprovalComment = value.String
+			}
 		case inspectionact.FieldDocumentPath:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field document_path", values[i])
 			} else if value.Valid {
 				_m.DocumentPath = value.String
 			}
+		case inspectionact.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = inspectionact.Language(value.String)
+			}
+		case inspectionact.FieldIncludeUnfilled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_unfilled", values[i])
+			} else if value.Valid {
+				_m.IncludeUnfilled = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -177,11 +213,27 @@ func (_m *InspectionAct) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
+	if v := _m.ActNumber; v != nil {
+		builder.WriteString("act_number=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("conclusion=")
 	builder.WriteString(_m.Conclusion)
 	builder.WriteString(", ")
+	if v := _m.ApprovalComment; v != nil {
+		builder.WriteString("approval_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("document_path=")
 	builder.WriteString(_m.DocumentPath)
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Language))
+	builder.WriteString(", ")
+	builder.WriteString("include_unfilled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeUnfilled))
 	builder.WriteByte(')')
 	return builder.String()
 }
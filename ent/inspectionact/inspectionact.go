@@ -3,6 +3,7 @@
 package inspectionact
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -22,10 +23,18 @@ const (
 	FieldApprovedAt = "approved_at"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldActNumber holds the string denoting the act_number field in the database.
+	FieldActNumber = "act_number"
 	// FieldConclusion holds the string denoting the conclusion field in the database.
 	FieldConclusion = "conclusion"
+	// FieldApprovalComment holds the string denoting the approval_comment field in the database.
+	FieldApprovalComment = "approval_comment"
 	// FieldDocumentPath holds the string denoting the document_path field in the database.
 	FieldDocumentPath = "document_path"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldIncludeUnfilled holds the string denoting the include_unfilled field in the database.
+	FieldIncludeUnfilled = "include_unfilled"
 	// EdgeTask holds the string denoting the task edge name in mutations.
 	EdgeTask = "task"
 	// Table holds the table name of the inspectionact in the database.
@@ -46,8 +55,12 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldApprovedAt,
 	FieldStatus,
+	FieldActNumber,
 	FieldConclusion,
+	FieldApprovalComment,
 	FieldDocumentPath,
+	FieldLanguage,
+	FieldIncludeUnfilled,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -65,10 +78,40 @@ var (
 	DefaultCreatedAt func() time.Time
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// ActNumberValidator is a validator for the "act_number" field. It is called by the builders before save.
+	ActNumberValidator func(string) error
 	// DocumentPathValidator is a validator for the "document_path" field. It is called by the builders before save.
 	DocumentPathValidator func(string) error
+	// DefaultIncludeUnfilled holds the default value on creation for the "include_unfilled" field.
+	DefaultIncludeUnfilled bool
 )
 
+// Language defines the type for the "language" enum field.
+type Language string
+
+// LanguageRu is the default value of the Language enum.
+const DefaultLanguage = LanguageRu
+
+// Language values.
+const (
+	LanguageRu Language = "ru"
+	LanguageEn Language = "en"
+)
+
+func (l Language) String() string {
+	return string(l)
+}
+
+// LanguageValidator is a validator for the "language" field enum values. It is called by the builders before save.
+func LanguageValidator(l Language) error {
+	switch l {
+	case LanguageRu, LanguageEn:
+		return nil
+	default:
+		return fmt.Errorf("inspectionact: invalid enum value for language field: %q", l)
+	}
+}
+
 // OrderOption defines the ordering options for the InspectionAct queries.
 type OrderOption func(*sql.Selector)
 
@@ -97,16 +140,36 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByActNumber orders the results by the act_number field.
+func ByActNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActNumber, opts...).ToFunc()
+}
+
 // ByConclusion orders the results by the conclusion field.
 func ByConclusion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConclusion, opts...).ToFunc()
 }
 
+// ByApprovalComment orders the results by the approval_comment field.
+func ByApprovalComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalComment, opts...).ToFunc()
+}
+
 // ByDocumentPath orders the results by the document_path field.
 func ByDocumentPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDocumentPath, opts...).ToFunc()
 }
 
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByIncludeUnfilled orders the results by the include_unfilled field.
+func ByIncludeUnfilled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeUnfilled, opts...).ToFunc()
+}
+
 // ByTaskField orders the results by task field.
 func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
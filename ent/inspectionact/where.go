@@ -75,16 +75,31 @@ func Status(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldEQ(FieldStatus, v))
 }
 
+// ActNumber applies equality check predicate on the "act_number" field. It's identical to ActNumberEQ.
+func ActNumber(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEQ(FieldActNumber, v))
+}
+
 // Conclusion applies equality check predicate on the "conclusion" field. It's identical to ConclusionEQ.
 func Conclusion(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldEQ(FieldConclusion, v))
 }
 
+// ApprovalComment applies equality check predicate on the "approval_comment" field. It's identical to ApprovalCommentEQ.
+func ApprovalComment(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEQ(FieldApprovalComment, v))
+}
+
 // DocumentPath applies equality check predicate on the "document_path" field. It's identical to DocumentPathEQ.
 func DocumentPath(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldEQ(FieldDocumentPath, v))
 }
 
+// IncludeUnfilled applies equality check predicate on the "include_unfilled" field. It's identical to IncludeUnfilledEQ.
+func IncludeUnfilled(v bool) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEQ(FieldIncludeUnfilled, v))
+}
+
 // TaskIDEQ applies the EQ predicate on the "task_id" field.
 func TaskIDEQ(v int) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldEQ(FieldTaskID, v))
@@ -260,6 +275,81 @@ func StatusContainsFold(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// ActNumberEQ applies the EQ predicate on the "act_number" field.
+func ActNumberEQ(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEQ(FieldActNumber, v))
+}
+
+// ActNumberNEQ applies the NEQ predicate on the "act_number" field.
+func ActNumberNEQ(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNEQ(FieldActNumber, v))
+}
+
+// ActNumberIn applies the In predicate on the "act_number" field.
+func ActNumberIn(vs ...string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldIn(FieldActNumber, vs...))
+}
+
+// ActNumberNotIn applies the NotIn predicate on the "act_number" field.
+func ActNumberNotIn(vs ...string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNotIn(FieldActNumber, vs...))
+}
+
+// ActNumberGT applies the GT predicate on the "act_number" field.
+func ActNumberGT(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldGT(FieldActNumber, v))
+}
+
+// ActNumberGTE applies the GTE predicate on the "act_number" field.
+func ActNumberGTE(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldGTE(FieldActNumber, v))
+}
+
+// ActNumberLT applies the LT predicate on the "act_number" field.
+func ActNumberLT(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldLT(FieldActNumber, v))
+}
+
+// ActNumberLTE applies the LTE predicate on the "act_number" field.
+func ActNumberLTE(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldLTE(FieldActNumber, v))
+}
+
+// ActNumberContains applies the Contains predicate on the "act_number" field.
+func ActNumberContains(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldContains(FieldActNumber, v))
+}
+
+// ActNumberHasPrefix applies the HasPrefix predicate on the "act_number" field.
+func ActNumberHasPrefix(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldHasPrefix(FieldActNumber, v))
+}
+
+// ActNumberHasSuffix applies the HasSuffix predicate on the "act_number" field.
+func ActNumberHasSuffix(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldHasSuffix(FieldActNumber, v))
+}
+
+// ActNumberIsNil applies the IsNil predicate on the "act_number" field.
+func ActNumberIsNil() predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldIsNull(FieldActNumber))
+}
+
+// ActNumberNotNil applies the NotNil predicate on the "act_number" field.
+func ActNumberNotNil() predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNotNull(FieldActNumber))
+}
+
+// ActNumberEqualFold applies the EqualFold predicate on the "act_number" field.
+func ActNumberEqualFold(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEqualFold(FieldActNumber, v))
+}
+
+// ActNumberContainsFold applies the ContainsFold predicate on the "act_number" field.
+func ActNumberContainsFold(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldContainsFold(FieldActNumber, v))
+}
+
 // ConclusionEQ applies the EQ predicate on the "conclusion" field.
 func ConclusionEQ(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldEQ(FieldConclusion, v))
@@ -335,6 +425,81 @@ func ConclusionContainsFold(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldContainsFold(FieldConclusion, v))
 }
 
+// ApprovalCommentEQ applies the EQ predicate on the "approval_comment" field.
+func ApprovalCommentEQ(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEQ(FieldApprovalComment, v))
+}
+
+// ApprovalCommentNEQ applies the NEQ predicate on the "approval_comment" field.
+func ApprovalCommentNEQ(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNEQ(FieldApprovalComment, v))
+}
+
+// ApprovalCommentIn applies the In predicate on the "approval_comment" field.
+func ApprovalCommentIn(vs ...string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldIn(FieldApprovalComment, vs...))
+}
+
+// ApprovalCommentNotIn applies the NotIn predicate on the "approval_comment" field.
+func ApprovalCommentNotIn(vs ...string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNotIn(FieldApprovalComment, vs...))
+}
+
+// ApprovalCommentGT applies the GT predicate on the "approval_comment" field.
+func ApprovalCommentGT(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldGT(FieldApprovalComment, v))
+}
+
+// ApprovalCommentGTE applies the GTE predicate on the "approval_comment" field.
+func ApprovalCommentGTE(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldGTE(FieldApprovalComment, v))
+}
+
+// ApprovalCommentLT applies the LT predicate on the "approval_comment" field.
+func ApprovalCommentLT(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldLT(FieldApprovalComment, v))
+}
+
+// ApprovalCommentLTE applies the LTE predicate on the "approval_comment" field.
+func ApprovalCommentLTE(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldLTE(FieldApprovalComment, v))
+}
+
+// ApprovalCommentContains applies the Contains predicate on the "approval_comment" field.
+func ApprovalCommentContains(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldContains(FieldApprovalComment, v))
+}
+
+// ApprovalCommentHasPrefix applies the HasPrefix predicate on the "approval_comment" field.
+func ApprovalCommentHasPrefix(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldHasPrefix(FieldApprovalComment, v))
+}
+
+// ApprovalCommentHasSuffix applies the HasSuffix predicate on the "approval_comment" field.
+func ApprovalCommentHasSuffix(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldHasSuffix(FieldApprovalComment, v))
+}
+
+// ApprovalCommentIsNil applies the IsNil predicate on the "approval_comment" field.
+func ApprovalCommentIsNil() predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldIsNull(FieldApprovalComment))
+}
+
+// ApprovalCommentNotNil applies the NotNil predicate on the "approval_comment" field.
+func ApprovalCommentNotNil() predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNotNull(FieldApprovalComment))
+}
+
+// ApprovalCommentEqualFold applies the EqualFold predicate on the "approval_comment" field.
+func ApprovalCommentEqualFold(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEqualFold(FieldApprovalComment, v))
+}
+
+// ApprovalCommentContainsFold applies the ContainsFold predicate on the "approval_comment" field.
+func ApprovalCommentContainsFold(v string) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldContainsFold(FieldApprovalComment, v))
+}
+
 // DocumentPathEQ applies the EQ predicate on the "document_path" field.
 func DocumentPathEQ(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldEQ(FieldDocumentPath, v))
@@ -410,6 +575,36 @@ func DocumentPathContainsFold(v string) predicate.InspectionAct {
 	return predicate.InspectionAct(sql.FieldContainsFold(FieldDocumentPath, v))
 }
 
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v Language) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v Language) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...Language) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...Language) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// IncludeUnfilledEQ applies the EQ predicate on the "include_unfilled" field.
+func IncludeUnfilledEQ(v bool) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldEQ(FieldIncludeUnfilled, v))
+}
+
+// IncludeUnfilledNEQ applies the NEQ predicate on the "include_unfilled" field.
+func IncludeUnfilledNEQ(v bool) predicate.InspectionAct {
+	return predicate.InspectionAct(sql.FieldNEQ(FieldIncludeUnfilled, v))
+}
+
 // HasTask applies the HasEdge predicate on the "task" edge.
 func HasTask() predicate.InspectionAct {
 	return predicate.InspectionAct(func(s *sql.Selector) {
@@ -69,6 +69,20 @@ func (_c *InspectionActCreate) SetNillableStatus(v *string) *InspectionActCreate
 	return _c
 }
 
+// SetActNumber sets the "act_number" field.
+func (_c *InspectionActCreate) SetActNumber(v string) *InspectionActCreate {
+	_c.mutation.SetActNumber(v)
+	return _c
+}
+
+// SetNillableActNumber sets the "act_number" field if the given value is not nil.
+func (_c *InspectionActCreate) SetNillableActNumber(v *string) *InspectionActCreate {
+	if v != nil {
+		_c.SetActNumber(*v)
+	}
+	return _c
+}
+
 // SetConclusion sets the "conclusion" field.
 func (_c *InspectionActCreate) SetConclusion(v string) *InspectionActCreate {
 	_c.mutation.SetConclusion(v)
@@ -83,6 +97,20 @@ func (_c *InspectionActCreate) SetNillableConclusion(v *string) *InspectionActCr
 	return _c
 }
 
+// SetApprovalComment sets the "approval_comment" field.
+func (_c *InspectionActCreate) SetApprovalComment(v string) *InspectionActCreate {
+	_c.mutation.SetApprovalComment(v)
+	return _c
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_c *InspectionActCreate) SetNillableApprovalComment(v *string) *InspectionActCreate {
+	if v != nil {
+		_c.SetApprovalComment(*v)
+	}
+	return _c
+}
+
 // SetDocumentPath sets the "document_path" field.
 func (_c *InspectionActCreate) SetDocumentPath(v string) *InspectionActCreate {
 	_c.mutation.SetDocumentPath(v)
@@ -97,6 +125,34 @@ func (_c *InspectionActCreate) SetNillableDocumentPath(v *string) *InspectionAct
 	return _c
 }
 
+// SetLanguage sets the "language" field.
+func (_c *InspectionActCreate) SetLanguage(v inspectionact.Language) *InspectionActCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_c *InspectionActCreate) SetNillableLanguage(v *inspectionact.Language) *InspectionActCreate {
+	if v != nil {
+		_c.SetLanguage(*v)
+	}
+	return _c
+}
+
+// SetIncludeUnfilled sets the "include_unfilled" field.
+func (_c *InspectionActCreate) SetIncludeUnfilled(v bool) *InspectionActCreate {
+	_c.mutation.SetIncludeUnfilled(v)
+	return _c
+}
+
+// SetNillableIncludeUnfilled sets the "include_unfilled" field if the given value is not nil.
+func (_c *InspectionActCreate) SetNillableIncludeUnfilled(v *bool) *InspectionActCreate {
+	if v != nil {
+		_c.SetIncludeUnfilled(*v)
+	}
+	return _c
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_c *InspectionActCreate) SetTask(v *Task) *InspectionActCreate {
 	return _c.SetTaskID(v.ID)
@@ -145,6 +201,14 @@ func (_c *InspectionActCreate) defaults() {
 		v := inspectionact.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Language(); !ok {
+		v := inspectionact.DefaultLanguage
+		_c.mutation.SetLanguage(v)
+	}
+	if _, ok := _c.mutation.IncludeUnfilled(); !ok {
+		v := inspectionact.DefaultIncludeUnfilled
+		_c.mutation.SetIncludeUnfilled(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -158,11 +222,27 @@ func (_c *InspectionActCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InspectionAct.status"`)}
 	}
+	if v, ok := _c.mutation.ActNumber(); ok {
+		if err := inspectionact.ActNumberValidator(v); err != nil {
+			return &ValidationError{Name: "act_number", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.act_number": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.DocumentPath(); ok {
 		if err := inspectionact.DocumentPathValidator(v); err != nil {
 			return &ValidationError{Name: "document_path", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.document_path": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "InspectionAct.language"`)}
+	}
+	if v, ok := _c.mutation.Language(); ok {
+		if err := inspectionact.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.language": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncludeUnfilled(); !ok {
+		return &ValidationError{Name: "include_unfilled", err: errors.New(`ent: missing required field "InspectionAct.include_unfilled"`)}
+	}
 	if len(_c.mutation.TaskIDs()) == 0 {
 		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "InspectionAct.task"`)}
 	}
@@ -204,14 +284,30 @@ func (_c *InspectionActCreate) createSpec() (*InspectionAct, *sqlgraph.CreateSpe
 		_spec.SetField(inspectionact.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.ActNumber(); ok {
+		_spec.SetField(inspectionact.FieldActNumber, field.TypeString, value)
+		_node.ActNumber = &value
+	}
 	if value, ok := _c.mutation.Conclusion(); ok {
 		_spec.SetField(inspectionact.FieldConclusion, field.TypeString, value)
 		_node.Conclusion = value
 	}
+	if value, ok := _c.mutation.ApprovalComment(); ok {
+		_spec.SetField(inspectionact.FieldApprovalComment, field.TypeString, value)
+		_node.ApprovalComment = &value
+	}
 	if value, ok := _c.mutation.DocumentPath(); ok {
 		_spec.SetField(inspectionact.FieldDocumentPath, field.TypeString, value)
 		_node.DocumentPath = value
 	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(inspectionact.FieldLanguage, field.TypeEnum, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.IncludeUnfilled(); ok {
+		_spec.SetField(inspectionact.FieldIncludeUnfilled, field.TypeBool, value)
+		_node.IncludeUnfilled = value
+	}
 	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
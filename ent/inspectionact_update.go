@@ -77,6 +77,26 @@ func (_u *InspectionActUpdate) SetNillableStatus(v *string) *InspectionActUpdate
 	return _u
 }
 
+// SetActNumber sets the "act_number" field.
+func (_u *InspectionActUpdate) SetActNumber(v string) *InspectionActUpdate {
+	_u.mutation.SetActNumber(v)
+	return _u
+}
+
+// SetNillableActNumber sets the "act_number" field if the given value is not nil.
+func (_u *InspectionActUpdate) SetNillableActNumber(v *string) *InspectionActUpdate {
+	if v != nil {
+		_u.SetActNumber(*v)
+	}
+	return _u
+}
+
+// ClearActNumber clears the value of the "act_number" field.
+func (_u *InspectionActUpdate) ClearActNumber() *InspectionActUpdate {
+	_u.mutation.ClearActNumber()
+	return _u
+}
+
 // SetConclusion sets the "conclusion" field.
 func (_u *InspectionActUpdate) SetConclusion(v string) *InspectionActUpdate {
 	_u.mutation.SetConclusion(v)
@@ -97,6 +117,26 @@ func (_u *InspectionActUpdate) ClearConclusion() *InspectionActUpdate {
 	return _u
 }
 
+// SetApprovalComment sets the "approval_comment" field.
+func (_u *InspectionActUpdate) SetApprovalComment(v string) *InspectionActUpdate {
+	_u.mutation.SetApprovalComment(v)
+	return _u
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_u *InspectionActUpdate) SetNillableApprovalComment(v *string) *InspectionActUpdate {
+	if v != nil {
+		_u.SetApprovalComment(*v)
+	}
+	return _u
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (_u *InspectionActUpdate) ClearApprovalComment() *InspectionActUpdate {
+	_u.mutation.ClearApprovalComment()
+	return _u
+}
+
 // SetDocumentPath sets the "document_path" field.
 func (_u *InspectionActUpdate) SetDocumentPath(v string) *InspectionActUpdate {
 	_u.mutation.SetDocumentPath(v)
@@ -117,6 +157,34 @@ func (_u *InspectionActUpdate) ClearDocumentPath() *InspectionActUpdate {
 	return _u
 }
 
+// SetLanguage sets the "language" field.
+func (_u *InspectionActUpdate) SetLanguage(v inspectionact.Language) *InspectionActUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *InspectionActUpdate) SetNillableLanguage(v *inspectionact.Language) *InspectionActUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetIncludeUnfilled sets the "include_unfilled" field.
+func (_u *InspectionActUpdate) SetIncludeUnfilled(v bool) *InspectionActUpdate {
+	_u.mutation.SetIncludeUnfilled(v)
+	return _u
+}
+
+// SetNillableIncludeUnfilled sets the "include_unfilled" field if the given value is not nil.
+func (_u *InspectionActUpdate) SetNillableIncludeUnfilled(v *bool) *InspectionActUpdate {
+	if v != nil {
+		_u.SetIncludeUnfilled(*v)
+	}
+	return _u
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_u *InspectionActUpdate) SetTask(v *Task) *InspectionActUpdate {
 	return _u.SetTaskID(v.ID)
@@ -162,11 +230,21 @@ func (_u *InspectionActUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *InspectionActUpdate) check() error {
+	if v, ok := _u.mutation.ActNumber(); ok {
+		if err := inspectionact.ActNumberValidator(v); err != nil {
+			return &ValidationError{Name: "act_number", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.act_number": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.DocumentPath(); ok {
 		if err := inspectionact.DocumentPathValidator(v); err != nil {
 			return &ValidationError{Name: "document_path", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.document_path": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := inspectionact.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.language": %w`, err)}
+		}
+	}
 	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "InspectionAct.task"`)
 	}
@@ -194,18 +272,36 @@ func (_u *InspectionActUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(inspectionact.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ActNumber(); ok {
+		_spec.SetField(inspectionact.FieldActNumber, field.TypeString, value)
+	}
+	if _u.mutation.ActNumberCleared() {
+		_spec.ClearField(inspectionact.FieldActNumber, field.TypeString)
+	}
 	if value, ok := _u.mutation.Conclusion(); ok {
 		_spec.SetField(inspectionact.FieldConclusion, field.TypeString, value)
 	}
 	if _u.mutation.ConclusionCleared() {
 		_spec.ClearField(inspectionact.FieldConclusion, field.TypeString)
 	}
+	if value, ok := _u.mutation.ApprovalComment(); ok {
+		_spec.SetField(inspectionact.FieldApprovalComment, field.TypeString, value)
+	}
+	if _u.mutation.ApprovalCommentCleared() {
+		_spec.ClearField(inspectionact.FieldApprovalComment, field.TypeString)
+	}
 	if value, ok := _u.mutation.DocumentPath(); ok {
 		_spec.SetField(inspectionact.FieldDocumentPath, field.TypeString, value)
 	}
 	if _u.mutation.DocumentPathCleared() {
 		_spec.ClearField(inspectionact.FieldDocumentPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(inspectionact.FieldLanguage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IncludeUnfilled(); ok {
+		_spec.SetField(inspectionact.FieldIncludeUnfilled, field.TypeBool, value)
+	}
 	if _u.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -303,6 +399,26 @@ func (_u *InspectionActUpdateOne) SetNillableStatus(v *string) *InspectionActUpd
 	return _u
 }
 
+// SetActNumber sets the "act_number" field.
+func (_u *InspectionActUpdateOne) SetActNumber(v string) *InspectionActUpdateOne {
+	_u.mutation.SetActNumber(v)
+	return _u
+}
+
+// SetNillableActNumber sets the "act_number" field if the given value is not nil.
+func (_u *InspectionActUpdateOne) SetNillableActNumber(v *string) *InspectionActUpdateOne {
+	if v != nil {
+		_u.SetActNumber(*v)
+	}
+	return _u
+}
+
+// ClearActNumber clears the value of the "act_number" field.
+func (_u *InspectionActUpdateOne) ClearActNumber() *InspectionActUpdateOne {
+	_u.mutation.ClearActNumber()
+	return _u
+}
+
 // SetConclusion sets the "conclusion" field.
 func (_u *InspectionActUpdateOne) SetConclusion(v string) *InspectionActUpdateOne {
 	_u.mutation.SetConclusion(v)
@@ -323,6 +439,26 @@ func (_u *InspectionActUpdateOne) ClearConclusion() *InspectionActUpdateOne {
 	return _u
 }
 
+// SetApprovalComment sets the "approval_comment" field.
+func (_u *InspectionActUpdateOne) SetApprovalComment(v string) *InspectionActUpdateOne {
+	_u.mutation.SetApprovalComment(v)
+	return _u
+}
+
+// SetNillableApprovalComment sets the "approval_comment" field if the given value is not nil.
+func (_u *InspectionActUpdateOne) SetNillableApprovalComment(v *string) *InspectionActUpdateOne {
+	if v != nil {
+		_u.SetApprovalComment(*v)
+	}
+	return _u
+}
+
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (_u *InspectionActUpdateOne) ClearApprovalComment() *InspectionActUpdateOne {
+	_u.mutation.ClearApprovalComment()
+	return _u
+}
+
 // SetDocumentPath sets the "document_path" field.
 func (_u *InspectionActUpdateOne) SetDocumentPath(v string) *InspectionActUpdateOne {
 	_u.mutation.SetDocumentPath(v)
@@ -343,6 +479,34 @@ func (_u *InspectionActUpdateOne) ClearDocumentPath() *InspectionActUpdateOne {
 	return _u
 }
 
+// SetLanguage sets the "language" field.
+func (_u *InspectionActUpdateOne) SetLanguage(v inspectionact.Language) *InspectionActUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *InspectionActUpdateOne) SetNillableLanguage(v *inspectionact.Language) *InspectionActUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetIncludeUnfilled sets the "include_unfilled" field.
+func (_u *InspectionActUpdateOne) SetIncludeUnfilled(v bool) *InspectionActUpdateOne {
+	_u.mutation.SetIncludeUnfilled(v)
+	return _u
+}
+
+// SetNillableIncludeUnfilled sets the "include_unfilled" field if the given value is not nil.
+func (_u *InspectionActUpdateOne) SetNillableIncludeUnfilled(v *bool) *InspectionActUpdateOne {
+	if v != nil {
+		_u.SetIncludeUnfilled(*v)
+	}
+	return _u
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_u *InspectionActUpdateOne) SetTask(v *Task) *InspectionActUpdateOne {
 	return _u.SetTaskID(v.ID)
@@ -401,11 +565,21 @@ func (_u *InspectionActUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *InspectionActUpdateOne) check() error {
+	if v, ok := _u.mutation.ActNumber(); ok {
+		if err := inspectionact.ActNumberValidator(v); err != nil {
+			return &ValidationError{Name: "act_number", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.act_number": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.DocumentPath(); ok {
 		if err := inspectionact.DocumentPathValidator(v); err != nil {
 			return &ValidationError{Name: "document_path", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.document_path": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := inspectionact.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "InspectionAct.language": %w`, err)}
+		}
+	}
 	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "InspectionAct.task"`)
 	}
@@ -450,18 +624,36 @@ func (_u *InspectionActUpdateOne) sqlSave(ctx context.Context) (_node *Inspectio
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(inspectionact.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ActNumber(); ok {
+		_spec.SetField(inspectionact.FieldActNumber, field.TypeString, value)
+	}
+	if _u.mutation.ActNumberCleared() {
+		_spec.ClearField(inspectionact.FieldActNumber, field.TypeString)
+	}
 	if value, ok := _u.mutation.Conclusion(); ok {
 		_spec.SetField(inspectionact.FieldConclusion, field.TypeString, value)
 	}
 	if _u.mutation.ConclusionCleared() {
 		_spec.ClearField(inspectionact.FieldConclusion, field.TypeString)
 	}
+	if value, ok := _u.mutation.ApprovalComment(); ok {
+		_spec.SetField(inspectionact.FieldApprovalComment, field.TypeString, value)
+	}
+	if _u.mutation.ApprovalCommentCleared() {
+		_spec.ClearField(inspectionact.FieldApprovalComment, field.TypeString)
+	}
 	if value, ok := _u.mutation.DocumentPath(); ok {
 		_spec.SetField(inspectionact.FieldDocumentPath, field.TypeString, value)
 	}
 	if _u.mutation.DocumentPathCleared() {
 		_spec.ClearField(inspectionact.FieldDocumentPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(inspectionact.FieldLanguage, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IncludeUnfilled(); ok {
+		_spec.SetField(inspectionact.FieldIncludeUnfilled, field.TypeBool, value)
+	}
 	if _u.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
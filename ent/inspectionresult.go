@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"jkh/ent/checklistelement"
 	"jkh/ent/inspectionresult"
@@ -23,10 +24,14 @@ type InspectionResult struct {
 	TaskID int `json:"task_id,omitempty"`
 	// ChecklistElementID holds the value of the "checklist_element_id" field.
 	ChecklistElementID int `json:"checklist_element_id,omitempty"`
-	// Статус состояния: Исправное, Удовлетворительное, Неудовлетворительное, Аварийное.
+	// Статус состояния: Исправное, Удовлетворительное, Неудовлетворительное, Аварийное, Неприменимо (элемент отсутствует в здании).
 	ConditionStatus inspectionresult.ConditionStatus `json:"condition_status,omitempty"`
 	// Comment holds the value of the "comment" field.
 	Comment string `json:"comment,omitempty"`
+	// InternalNote holds the value of the "internal_note" field.
+	InternalNote string `json:"internal_note,omitempty"`
+	// PhotoPaths holds the value of the "photo_paths" field.
+	PhotoPaths []string `json:"photo_paths,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -75,9 +80,11 @@ func (*InspectionResult) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case inspectionresult.FieldPhotoPaths:
+			values[i] = new([]byte)
 		case inspectionresult.FieldID, inspectionresult.FieldTaskID, inspectionresult.FieldChecklistElementID:
 			values[i] = new(sql.NullInt64)
-		case inspectionresult.FieldConditionStatus, inspectionresult.FieldComment:
+		case inspectionresult.FieldConditionStatus, inspectionresult.FieldComment, inspectionresult.FieldInternalNote:
 			values[i] = new(sql.NullString)
 		case inspectionresult.FieldCreatedAt, inspectionresult.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -126,6 +133,20 @@ func (_m *InspectionResult) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Comment = value.String
 			}
+		case inspectionresult.FieldInternalNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internal_note", values[i])
+			} else if value.Valid {
+				_m.InternalNote = value.String
+			}
+		case inspectionresult.FieldPhotoPaths:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field photo_paths", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PhotoPaths); err != nil {
+					return fmt.Errorf("unmarshal field photo_paths: %w", err)
+				}
+			}
 		case inspectionresult.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -196,6 +217,12 @@ func (_m *InspectionResult) String() string {
 	builder.WriteString("comment=")
 	builder.WriteString(_m.Comment)
 	builder.WriteString(", ")
+	builder.WriteString("internal_note=")
+	builder.WriteString(_m.InternalNote)
+	builder.WriteString(", ")
+	builder.WriteString("photo_paths=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PhotoPaths))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
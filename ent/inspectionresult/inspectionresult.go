@@ -23,6 +23,10 @@ const (
 	FieldConditionStatus = "condition_status"
 	// FieldComment holds the string denoting the comment field in the database.
 	FieldComment = "comment"
+	// FieldInternalNote holds the string denoting the internal_note field in the database.
+	FieldInternalNote = "internal_note"
+	// FieldPhotoPaths holds the string denoting the photo_paths field in the database.
+	FieldPhotoPaths = "photo_paths"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -56,6 +60,8 @@ var Columns = []string{
 	FieldChecklistElementID,
 	FieldConditionStatus,
 	FieldComment,
+	FieldInternalNote,
+	FieldPhotoPaths,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -88,6 +94,7 @@ const (
 	ConditionStatusУдовлетворительное   ConditionStatus = "Удовлетворительное"
 	ConditionStatusНеудовлетворительное ConditionStatus = "Неудовлетворительное"
 	ConditionStatusАварийное            ConditionStatus = "Аварийное"
+	ConditionStatusНеприменимо          ConditionStatus = "Неприменимо"
 )
 
 func (cs ConditionStatus) String() string {
@@ -97,7 +104,7 @@ func (cs ConditionStatus) String() string {
 // ConditionStatusValidator is a validator for the "condition_status" field enum values. It is called by the builders before save.
 func ConditionStatusValidator(cs ConditionStatus) error {
 	switch cs {
-	case ConditionStatusИсправное, ConditionStatusУдовлетворительное, ConditionStatusНеудовлетворительное, ConditionStatusАварийное:
+	case ConditionStatusИсправное, ConditionStatusУдовлетворительное, ConditionStatusНеудовлетворительное, ConditionStatusАварийное, ConditionStatusНеприменимо:
 		return nil
 	default:
 		return fmt.Errorf("inspectionresult: invalid enum value for condition_status field: %q", cs)
@@ -132,6 +139,11 @@ func ByComment(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldComment, opts...).ToFunc()
 }
 
+// ByInternalNote orders the results by the internal_note field.
+func ByInternalNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternalNote, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -70,6 +70,11 @@ func Comment(v string) predicate.InspectionResult {
 	return predicate.InspectionResult(sql.FieldEQ(FieldComment, v))
 }
 
+// InternalNote applies equality check predicate on the "internal_note" field. It's identical to InternalNoteEQ.
+func InternalNote(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldEQ(FieldInternalNote, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.InspectionResult {
 	return predicate.InspectionResult(sql.FieldEQ(FieldCreatedAt, v))
@@ -215,6 +220,91 @@ func CommentContainsFold(v string) predicate.InspectionResult {
 	return predicate.InspectionResult(sql.FieldContainsFold(FieldComment, v))
 }
 
+// InternalNoteEQ applies the EQ predicate on the "internal_note" field.
+func InternalNoteEQ(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldEQ(FieldInternalNote, v))
+}
+
+// InternalNoteNEQ applies the NEQ predicate on the "internal_note" field.
+func InternalNoteNEQ(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldNEQ(FieldInternalNote, v))
+}
+
+// InternalNoteIn applies the In predicate on the "internal_note" field.
+func InternalNoteIn(vs ...string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldIn(FieldInternalNote, vs...))
+}
+
+// InternalNoteNotIn applies the NotIn predicate on the "internal_note" field.
+func InternalNoteNotIn(vs ...string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldNotIn(FieldInternalNote, vs...))
+}
+
+// InternalNoteGT applies the GT predicate on the "internal_note" field.
+func InternalNoteGT(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldGT(FieldInternalNote, v))
+}
+
+// InternalNoteGTE applies the GTE predicate on the "internal_note" field.
+func InternalNoteGTE(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldGTE(FieldInternalNote, v))
+}
+
+// InternalNoteLT applies the LT predicate on the "internal_note" field.
+func InternalNoteLT(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldLT(FieldInternalNote, v))
+}
+
+// InternalNoteLTE applies the LTE predicate on the "internal_note" field.
+func InternalNoteLTE(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldLTE(FieldInternalNote, v))
+}
+
+// InternalNoteContains applies the Contains predicate on the "internal_note" field.
+func InternalNoteContains(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldContains(FieldInternalNote, v))
+}
+
+// InternalNoteHasPrefix applies the HasPrefix predicate on the "internal_note" field.
+func InternalNoteHasPrefix(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldHasPrefix(FieldInternalNote, v))
+}
+
+// InternalNoteHasSuffix applies the HasSuffix predicate on the "internal_note" field.
+func InternalNoteHasSuffix(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldHasSuffix(FieldInternalNote, v))
+}
+
+// InternalNoteIsNil applies the IsNil predicate on the "internal_note" field.
+func InternalNoteIsNil() predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldIsNull(FieldInternalNote))
+}
+
+// InternalNoteNotNil applies the NotNil predicate on the "internal_note" field.
+func InternalNoteNotNil() predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldNotNull(FieldInternalNote))
+}
+
+// InternalNoteEqualFold applies the EqualFold predicate on the "internal_note" field.
+func InternalNoteEqualFold(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldEqualFold(FieldInternalNote, v))
+}
+
+// InternalNoteContainsFold applies the ContainsFold predicate on the "internal_note" field.
+func InternalNoteContainsFold(v string) predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldContainsFold(FieldInternalNote, v))
+}
+
+// PhotoPathsIsNil applies the IsNil predicate on the "photo_paths" field.
+func PhotoPathsIsNil() predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldIsNull(FieldPhotoPaths))
+}
+
+// PhotoPathsNotNil applies the NotNil predicate on the "photo_paths" field.
+func PhotoPathsNotNil() predicate.InspectionResult {
+	return predicate.InspectionResult(sql.FieldNotNull(FieldPhotoPaths))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.InspectionResult {
 	return predicate.InspectionResult(sql.FieldEQ(FieldCreatedAt, v))
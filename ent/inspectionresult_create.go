@@ -54,6 +54,26 @@ func (_c *InspectionResultCreate) SetNillableComment(v *string) *InspectionResul
 	return _c
 }
 
+// SetInternalNote sets the "internal_note" field.
+func (_c *InspectionResultCreate) SetInternalNote(v string) *InspectionResultCreate {
+	_c.mutation.SetInternalNote(v)
+	return _c
+}
+
+// SetNillableInternalNote sets the "internal_note" field if the given value is not nil.
+func (_c *InspectionResultCreate) SetNillableInternalNote(v *string) *InspectionResultCreate {
+	if v != nil {
+		_c.SetInternalNote(*v)
+	}
+	return _c
+}
+
+// SetPhotoPaths sets the "photo_paths" field.
+func (_c *InspectionResultCreate) SetPhotoPaths(v []string) *InspectionResultCreate {
+	_c.mutation.SetPhotoPaths(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *InspectionResultCreate) SetCreatedAt(v time.Time) *InspectionResultCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -199,6 +219,14 @@ func (_c *InspectionResultCreate) createSpec() (*InspectionResult, *sqlgraph.Cre
 		_spec.SetField(inspectionresult.FieldComment, field.TypeString, value)
 		_node.Comment = value
 	}
+	if value, ok := _c.mutation.InternalNote(); ok {
+		_spec.SetField(inspectionresult.FieldInternalNote, field.TypeString, value)
+		_node.InternalNote = value
+	}
+	if value, ok := _c.mutation.PhotoPaths(); ok {
+		_spec.SetField(inspectionresult.FieldPhotoPaths, field.TypeJSON, value)
+		_node.PhotoPaths = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(inspectionresult.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
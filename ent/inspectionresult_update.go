@@ -14,6 +14,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -92,6 +93,44 @@ func (_u *InspectionResultUpdate) ClearComment() *InspectionResultUpdate {
 	return _u
 }
 
+// SetInternalNote sets the "internal_note" field.
+func (_u *InspectionResultUpdate) SetInternalNote(v string) *InspectionResultUpdate {
+	_u.mutation.SetInternalNote(v)
+	return _u
+}
+
+// SetNillableInternalNote sets the "internal_note" field if the given value is not nil.
+func (_u *InspectionResultUpdate) SetNillableInternalNote(v *string) *InspectionResultUpdate {
+	if v != nil {
+		_u.SetInternalNote(*v)
+	}
+	return _u
+}
+
+// ClearInternalNote clears the value of the "internal_note" field.
+func (_u *InspectionResultUpdate) ClearInternalNote() *InspectionResultUpdate {
+	_u.mutation.ClearInternalNote()
+	return _u
+}
+
+// SetPhotoPaths sets the "photo_paths" field.
+func (_u *InspectionResultUpdate) SetPhotoPaths(v []string) *InspectionResultUpdate {
+	_u.mutation.SetPhotoPaths(v)
+	return _u
+}
+
+// AppendPhotoPaths appends value to the "photo_paths" field.
+func (_u *InspectionResultUpdate) AppendPhotoPaths(v []string) *InspectionResultUpdate {
+	_u.mutation.AppendPhotoPaths(v)
+	return _u
+}
+
+// ClearPhotoPaths clears the value of the "photo_paths" field.
+func (_u *InspectionResultUpdate) ClearPhotoPaths() *InspectionResultUpdate {
+	_u.mutation.ClearPhotoPaths()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *InspectionResultUpdate) SetUpdatedAt(v time.Time) *InspectionResultUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -198,6 +237,23 @@ func (_u *InspectionResultUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.CommentCleared() {
 		_spec.ClearField(inspectionresult.FieldComment, field.TypeString)
 	}
+	if value, ok := _u.mutation.InternalNote(); ok {
+		_spec.SetField(inspectionresult.FieldInternalNote, field.TypeString, value)
+	}
+	if _u.mutation.InternalNoteCleared() {
+		_spec.ClearField(inspectionresult.FieldInternalNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.PhotoPaths(); ok {
+		_spec.SetField(inspectionresult.FieldPhotoPaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPhotoPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inspectionresult.FieldPhotoPaths, value)
+		})
+	}
+	if _u.mutation.PhotoPathsCleared() {
+		_spec.ClearField(inspectionresult.FieldPhotoPaths, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(inspectionresult.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -341,6 +397,44 @@ func (_u *InspectionResultUpdateOne) ClearComment() *InspectionResultUpdateOne {
 	return _u
 }
 
+// SetInternalNote sets the "internal_note" field.
+func (_u *InspectionResultUpdateOne) SetInternalNote(v string) *InspectionResultUpdateOne {
+	_u.mutation.SetInternalNote(v)
+	return _u
+}
+
+// SetNillableInternalNote sets the "internal_note" field if the given value is not nil.
+func (_u *InspectionResultUpdateOne) SetNillableInternalNote(v *string) *InspectionResultUpdateOne {
+	if v != nil {
+		_u.SetInternalNote(*v)
+	}
+	return _u
+}
+
+// ClearInternalNote clears the value of the "internal_note" field.
+func (_u *InspectionResultUpdateOne) ClearInternalNote() *InspectionResultUpdateOne {
+	_u.mutation.ClearInternalNote()
+	return _u
+}
+
+// SetPhotoPaths sets the "photo_paths" field.
+func (_u *InspectionResultUpdateOne) SetPhotoPaths(v []string) *InspectionResultUpdateOne {
+	_u.mutation.SetPhotoPaths(v)
+	return _u
+}
+
+// AppendPhotoPaths appends value to the "photo_paths" field.
+func (_u *InspectionResultUpdateOne) AppendPhotoPaths(v []string) *InspectionResultUpdateOne {
+	_u.mutation.AppendPhotoPaths(v)
+	return _u
+}
+
+// ClearPhotoPaths clears the value of the "photo_paths" field.
+func (_u *InspectionResultUpdateOne) ClearPhotoPaths() *InspectionResultUpdateOne {
+	_u.mutation.ClearPhotoPaths()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *InspectionResultUpdateOne) SetUpdatedAt(v time.Time) *InspectionResultUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -477,6 +571,23 @@ func (_u *InspectionResultUpdateOne) sqlSave(ctx context.Context) (_node *Inspec
 	if _u.mutation.CommentCleared() {
 		_spec.ClearField(inspectionresult.FieldComment, field.TypeString)
 	}
+	if value, ok := _u.mutation.InternalNote(); ok {
+		_spec.SetField(inspectionresult.FieldInternalNote, field.TypeString, value)
+	}
+	if _u.mutation.InternalNoteCleared() {
+		_spec.ClearField(inspectionresult.FieldInternalNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.PhotoPaths(); ok {
+		_spec.SetField(inspectionresult.FieldPhotoPaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPhotoPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inspectionresult.FieldPhotoPaths, value)
+		})
+	}
+	if _u.mutation.PhotoPathsCleared() {
+		_spec.ClearField(inspectionresult.FieldPhotoPaths, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(inspectionresult.FieldUpdatedAt, field.TypeTime, value)
 	}
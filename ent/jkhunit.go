@@ -21,6 +21,8 @@ type JkhUnit struct {
 	DistrictID int `json:"district_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the JkhUnitQuery when eager-loading is set.
 	Edges        JkhUnitEdges `json:"edges"`
@@ -76,7 +78,7 @@ func (*JkhUnit) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case jkhunit.FieldID, jkhunit.FieldDistrictID:
 			values[i] = new(sql.NullInt64)
-		case jkhunit.FieldName:
+		case jkhunit.FieldName, jkhunit.FieldDescription:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -111,6 +113,12 @@ func (_m *JkhUnit) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case jkhunit.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -167,6 +175,9 @@ func (_m *JkhUnit) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
 	builder.WriteByte(')')
 	return builder.String()
 }
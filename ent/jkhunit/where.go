@@ -64,6 +64,11 @@ func Name(v string) predicate.JkhUnit {
 	return predicate.JkhUnit(sql.FieldEQ(FieldName, v))
 }
 
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldEQ(FieldDescription, v))
+}
+
 // DistrictIDEQ applies the EQ predicate on the "district_id" field.
 func DistrictIDEQ(v int) predicate.JkhUnit {
 	return predicate.JkhUnit(sql.FieldEQ(FieldDistrictID, v))
@@ -149,6 +154,81 @@ func NameContainsFold(v string) predicate.JkhUnit {
 	return predicate.JkhUnit(sql.FieldContainsFold(FieldName, v))
 }
 
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.JkhUnit {
+	return predicate.JkhUnit(sql.FieldContainsFold(FieldDescription, v))
+}
+
 // HasDistrict applies the HasEdge predicate on the "district" edge.
 func HasDistrict() predicate.JkhUnit {
 	return predicate.JkhUnit(func(s *sql.Selector) {
@@ -34,6 +34,20 @@ func (_c *JkhUnitCreate) SetName(v string) *JkhUnitCreate {
 	return _c
 }
 
+// SetDescription sets the "description" field.
+func (_c *JkhUnitCreate) SetDescription(v string) *JkhUnitCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *JkhUnitCreate) SetNillableDescription(v *string) *JkhUnitCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
 // SetDistrict sets the "district" edge to the District entity.
 func (_c *JkhUnitCreate) SetDistrict(v *District) *JkhUnitCreate {
 	return _c.SetDistrictID(v.ID)
@@ -142,6 +156,10 @@ func (_c *JkhUnitCreate) createSpec() (*JkhUnit, *sqlgraph.CreateSpec) {
 		_spec.SetField(jkhunit.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(jkhunit.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
 	if nodes := _c.mutation.DistrictIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
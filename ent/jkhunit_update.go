@@ -58,6 +58,26 @@ func (_u *JkhUnitUpdate) SetNillableName(v *string) *JkhUnitUpdate {
 	return _u
 }
 
+// SetDescription sets the "description" field.
+func (_u *JkhUnitUpdate) SetDescription(v string) *JkhUnitUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *JkhUnitUpdate) SetNillableDescription(v *string) *JkhUnitUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *JkhUnitUpdate) ClearDescription() *JkhUnitUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
 // SetDistrict sets the "district" edge to the District entity.
 func (_u *JkhUnitUpdate) SetDistrict(v *District) *JkhUnitUpdate {
 	return _u.SetDistrictID(v.ID)
@@ -196,6 +216,12 @@ func (_u *JkhUnitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(jkhunit.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(jkhunit.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(jkhunit.FieldDescription, field.TypeString)
+	}
 	if _u.mutation.DistrictCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -363,6 +389,26 @@ func (_u *JkhUnitUpdateOne) SetNillableName(v *string) *JkhUnitUpdateOne {
 	return _u
 }
 
+// SetDescription sets the "description" field.
+func (_u *JkhUnitUpdateOne) SetDescription(v string) *JkhUnitUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *JkhUnitUpdateOne) SetNillableDescription(v *string) *JkhUnitUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *JkhUnitUpdateOne) ClearDescription() *JkhUnitUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
 // SetDistrict sets the "district" edge to the District entity.
 func (_u *JkhUnitUpdateOne) SetDistrict(v *District) *JkhUnitUpdateOne {
 	return _u.SetDistrictID(v.ID)
@@ -531,6 +577,12 @@ func (_u *JkhUnitUpdateOne) sqlSave(ctx context.Context) (_node *JkhUnit, err er
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(jkhunit.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(jkhunit.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(jkhunit.FieldDescription, field.TypeString)
+	}
 	if _u.mutation.DistrictCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -8,6 +8,18 @@ import (
 )
 
 var (
+	// ActSequencesColumns holds the columns for the "act_sequences" table.
+	ActSequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "year", Type: field.TypeInt, Unique: true},
+		{Name: "value", Type: field.TypeInt, Default: 0},
+	}
+	// ActSequencesTable holds the schema information for the "act_sequences" table.
+	ActSequencesTable = &schema.Table{
+		Name:       "act_sequences",
+		Columns:    ActSequencesColumns,
+		PrimaryKey: []*schema.Column{ActSequencesColumns[0]},
+	}
 	// BuildingsColumns holds the columns for the "buildings" table.
 	BuildingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -15,6 +27,11 @@ var (
 		{Name: "construction_year", Type: field.TypeInt, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "photo", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "contact_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "contact_phone", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "floors", Type: field.TypeInt, Nullable: true},
+		{Name: "entrances", Type: field.TypeInt, Nullable: true},
+		{Name: "apartments", Type: field.TypeInt, Nullable: true},
 		{Name: "district_id", Type: field.TypeInt},
 		{Name: "jkh_unit_id", Type: field.TypeInt},
 		{Name: "inspector_id", Type: field.TypeInt, Nullable: true},
@@ -27,19 +44,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "buildings_districts_buildings",
-				Columns:    []*schema.Column{BuildingsColumns[5]},
+				Columns:    []*schema.Column{BuildingsColumns[10]},
 				RefColumns: []*schema.Column{DistrictsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "buildings_jkh_units_buildings",
-				Columns:    []*schema.Column{BuildingsColumns[6]},
+				Columns:    []*schema.Column{BuildingsColumns[11]},
 				RefColumns: []*schema.Column{JkhUnitsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "buildings_users_assigned_buildings",
-				Columns:    []*schema.Column{BuildingsColumns[7]},
+				Columns:    []*schema.Column{BuildingsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -63,6 +80,7 @@ var (
 	ChecklistElementsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "order_index", Type: field.TypeInt, Nullable: true},
+		{Name: "photo_required", Type: field.TypeBool, Default: false},
 		{Name: "checklist_id", Type: field.TypeInt},
 		{Name: "element_id", Type: field.TypeInt},
 	}
@@ -74,13 +92,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "checklist_elements_checklists_elements",
-				Columns:    []*schema.Column{ChecklistElementsColumns[2]},
+				Columns:    []*schema.Column{ChecklistElementsColumns[3]},
 				RefColumns: []*schema.Column{ChecklistsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "checklist_elements_element_catalogs_checklist_elements",
-				Columns:    []*schema.Column{ChecklistElementsColumns[3]},
+				Columns:    []*schema.Column{ChecklistElementsColumns[4]},
 				RefColumns: []*schema.Column{ElementCatalogsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -90,6 +108,7 @@ var (
 	DistrictsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 	}
 	// DistrictsTable holds the schema information for the "districts" table.
 	DistrictsTable = &schema.Table{
@@ -101,6 +120,7 @@ var (
 	ElementCatalogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "name_en", Type: field.TypeString, Nullable: true},
 		{Name: "category", Type: field.TypeString, Nullable: true},
 	}
 	// ElementCatalogsTable holds the schema information for the "element_catalogs" table.
@@ -115,8 +135,12 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "создан"},
+		{Name: "act_number", Type: field.TypeString, Unique: true, Nullable: true, Size: 50},
 		{Name: "conclusion", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "approval_comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "document_path", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "language", Type: field.TypeEnum, Enums: []string{"ru", "en"}, Default: "ru"},
+		{Name: "include_unfilled", Type: field.TypeBool, Default: false},
 		{Name: "task_id", Type: field.TypeInt, Unique: true},
 	}
 	// InspectionActsTable holds the schema information for the "inspection_acts" table.
@@ -127,7 +151,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "inspection_acts_tasks_act",
-				Columns:    []*schema.Column{InspectionActsColumns[6]},
+				Columns:    []*schema.Column{InspectionActsColumns[10]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -136,8 +160,10 @@ var (
 	// InspectionResultsColumns holds the columns for the "inspection_results" table.
 	InspectionResultsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "condition_status", Type: field.TypeEnum, Enums: []string{"Исправное", "Удовлетворительное", "Неудовлетворительное", "Аварийное"}},
+		{Name: "condition_status", Type: field.TypeEnum, Enums: []string{"Исправное", "Удовлетворительное", "Неудовлетворительное", "Аварийное", "Неприменимо"}},
 		{Name: "comment", Type: field.TypeString, Nullable: true},
+		{Name: "internal_note", Type: field.TypeString, Nullable: true},
+		{Name: "photo_paths", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "checklist_element_id", Type: field.TypeInt},
@@ -151,13 +177,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "inspection_results_checklist_elements_inspection_results",
-				Columns:    []*schema.Column{InspectionResultsColumns[5]},
+				Columns:    []*schema.Column{InspectionResultsColumns[7]},
 				RefColumns: []*schema.Column{ChecklistElementsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "inspection_results_tasks_results",
-				Columns:    []*schema.Column{InspectionResultsColumns[6]},
+				Columns:    []*schema.Column{InspectionResultsColumns[8]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -193,6 +219,7 @@ var (
 	JkhUnitsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "district_id", Type: field.TypeInt},
 	}
 	// JkhUnitsTable holds the schema information for the "jkh_units" table.
@@ -203,7 +230,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "jkh_units_districts_jkh_units",
-				Columns:    []*schema.Column{JkhUnitsColumns[2]},
+				Columns:    []*schema.Column{JkhUnitsColumns[3]},
 				RefColumns: []*schema.Column{DistrictsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -260,6 +287,68 @@ var (
 			},
 		},
 	}
+	// TaskChecklistsColumns holds the columns for the "task_checklists" table.
+	TaskChecklistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "checklist_id", Type: field.TypeInt},
+		{Name: "task_id", Type: field.TypeInt},
+	}
+	// TaskChecklistsTable holds the schema information for the "task_checklists" table.
+	TaskChecklistsTable = &schema.Table{
+		Name:       "task_checklists",
+		Columns:    TaskChecklistsColumns,
+		PrimaryKey: []*schema.Column{TaskChecklistsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_checklists_checklists_task_links",
+				Columns:    []*schema.Column{TaskChecklistsColumns[1]},
+				RefColumns: []*schema.Column{ChecklistsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "task_checklists_tasks_extra_checklists",
+				Columns:    []*schema.Column{TaskChecklistsColumns[2]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskchecklist_task_id_checklist_id",
+				Unique:  true,
+				Columns: []*schema.Column{TaskChecklistsColumns[2], TaskChecklistsColumns[1]},
+			},
+		},
+	}
+	// TaskStatusHistoriesColumns holds the columns for the "task_status_histories" table.
+	TaskStatusHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_status", Type: field.TypeString},
+		{Name: "to_status", Type: field.TypeString},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt},
+		{Name: "changed_by", Type: field.TypeInt, Nullable: true},
+	}
+	// TaskStatusHistoriesTable holds the schema information for the "task_status_histories" table.
+	TaskStatusHistoriesTable = &schema.Table{
+		Name:       "task_status_histories",
+		Columns:    TaskStatusHistoriesColumns,
+		PrimaryKey: []*schema.Column{TaskStatusHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_status_histories_tasks_status_history",
+				Columns:    []*schema.Column{TaskStatusHistoriesColumns[4]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_status_histories_users_status_changes",
+				Columns:    []*schema.Column{TaskStatusHistoriesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -268,6 +357,7 @@ var (
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "first_name", Type: field.TypeString},
 		{Name: "last_name", Type: field.TypeString},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 		{Name: "role_id", Type: field.TypeInt},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -278,7 +368,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_roles_users",
-				Columns:    []*schema.Column{UsersColumns[6]},
+				Columns:    []*schema.Column{UsersColumns[7]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -286,6 +376,7 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ActSequencesTable,
 		BuildingsTable,
 		ChecklistsTable,
 		ChecklistElementsTable,
@@ -297,6 +388,8 @@ var (
 		JkhUnitsTable,
 		RolesTable,
 		TasksTable,
+		TaskChecklistsTable,
+		TaskStatusHistoriesTable,
 		UsersTable,
 	}
 )
@@ -316,5 +409,9 @@ func init() {
 	TasksTable.ForeignKeys[0].RefTable = BuildingsTable
 	TasksTable.ForeignKeys[1].RefTable = ChecklistsTable
 	TasksTable.ForeignKeys[2].RefTable = UsersTable
+	TaskChecklistsTable.ForeignKeys[0].RefTable = ChecklistsTable
+	TaskChecklistsTable.ForeignKeys[1].RefTable = TasksTable
+	TaskStatusHistoriesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskStatusHistoriesTable.ForeignKeys[1].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = RolesTable
 }
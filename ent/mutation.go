@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"jkh/ent/actsequence"
 	"jkh/ent/building"
 	"jkh/ent/checklist"
 	"jkh/ent/checklistelement"
@@ -18,6 +19,8 @@ import (
 	"jkh/ent/predicate"
 	"jkh/ent/role"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
 	"sync"
 	"time"
@@ -35,57 +38,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBuilding         = "Building"
-	TypeChecklist        = "Checklist"
-	TypeChecklistElement = "ChecklistElement"
-	TypeDistrict         = "District"
-	TypeElementCatalog   = "ElementCatalog"
-	TypeInspectionAct    = "InspectionAct"
-	TypeInspectionResult = "InspectionResult"
-	TypeInspectorUnit    = "InspectorUnit"
-	TypeJkhUnit          = "JkhUnit"
-	TypeRole             = "Role"
-	TypeTask             = "Task"
-	TypeUser             = "User"
+	TypeActSequence       = "ActSequence"
+	TypeBuilding          = "Building"
+	TypeChecklist         = "Checklist"
+	TypeChecklistElement  = "ChecklistElement"
+	TypeDistrict          = "District"
+	TypeElementCatalog    = "ElementCatalog"
+	TypeInspectionAct     = "InspectionAct"
+	TypeInspectionResult  = "InspectionResult"
+	TypeInspectorUnit     = "InspectorUnit"
+	TypeJkhUnit           = "JkhUnit"
+	TypeRole              = "Role"
+	TypeTask              = "Task"
+	TypeTaskChecklist     = "TaskChecklist"
+	TypeTaskStatusHistory = "TaskStatusHistory"
+	TypeUser              = "User"
 )
 
-// BuildingMutation represents an operation that mutates the Building nodes in the graph.
-type BuildingMutation struct {
+// ActSequenceMutation represents an operation that mutates the ActSequence nodes in the graph.
+type ActSequenceMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	address              *string
-	construction_year    *int
-	addconstruction_year *int
-	description          *string
-	photo                *string
-	clearedFields        map[string]struct{}
-	jkh_unit             *int
-	clearedjkh_unit      bool
-	district             *int
-	cleareddistrict      bool
-	inspector            *int
-	clearedinspector     bool
-	tasks                map[int]struct{}
-	removedtasks         map[int]struct{}
-	clearedtasks         bool
-	done                 bool
-	oldValue             func(context.Context) (*Building, error)
-	predicates           []predicate.Building
+	op            Op
+	typ           string
+	id            *int
+	year          *int
+	addyear       *int
+	value         *int
+	addvalue      *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ActSequence, error)
+	predicates    []predicate.ActSequence
 }
 
-var _ ent.Mutation = (*BuildingMutation)(nil)
+var _ ent.Mutation = (*ActSequenceMutation)(nil)
 
-// buildingOption allows management of the mutation configuration using functional options.
-type buildingOption func(*BuildingMutation)
+// actsequenceOption allows management of the mutation configuration using functional options.
+type actsequenceOption func(*ActSequenceMutation)
 
-// newBuildingMutation creates new mutation for the Building entity.
-func newBuildingMutation(c config, op Op, opts ...buildingOption) *BuildingMutation {
-	m := &BuildingMutation{
+// newActSequenceMutation creates new mutation for the ActSequence entity.
+func newActSequenceMutation(c config, op Op, opts ...actsequenceOption) *ActSequenceMutation {
+	m := &ActSequenceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBuilding,
+		typ:           TypeActSequence,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -94,20 +90,20 @@ func newBuildingMutation(c config, op Op, opts ...buildingOption) *BuildingMutat
 	return m
 }
 
-// withBuildingID sets the ID field of the mutation.
-func withBuildingID(id int) buildingOption {
-	return func(m *BuildingMutation) {
+// withActSequenceID sets the ID field of the mutation.
+func withActSequenceID(id int) actsequenceOption {
+	return func(m *ActSequenceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Building
+			value *ActSequence
 		)
-		m.oldValue = func(ctx context.Context) (*Building, error) {
+		m.oldValue = func(ctx context.Context) (*ActSequence, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Building.Get(ctx, id)
+					value, err = m.Client().ActSequence.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -116,10 +112,10 @@ func withBuildingID(id int) buildingOption {
 	}
 }
 
-// withBuilding sets the old Building of the mutation.
-func withBuilding(node *Building) buildingOption {
-	return func(m *BuildingMutation) {
-		m.oldValue = func(context.Context) (*Building, error) {
+// withActSequence sets the old ActSequence of the mutation.
+func withActSequence(node *ActSequence) actsequenceOption {
+	return func(m *ActSequenceMutation) {
+		m.oldValue = func(context.Context) (*ActSequence, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -128,7 +124,7 @@ func withBuilding(node *Building) buildingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BuildingMutation) Client() *Client {
+func (m ActSequenceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -136,7 +132,7 @@ func (m BuildingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BuildingMutation) Tx() (*Tx, error) {
+func (m ActSequenceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -147,7 +143,7 @@ func (m BuildingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BuildingMutation) ID() (id int, exists bool) {
+func (m *ActSequenceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -158,7 +154,7 @@ func (m *BuildingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BuildingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ActSequenceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -167,784 +163,3818 @@ func (m *BuildingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Building.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ActSequence.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDistrictID sets the "district_id" field.
-func (m *BuildingMutation) SetDistrictID(i int) {
-	m.district = &i
+// SetYear sets the "year" field.
+func (m *ActSequenceMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
 }
 
-// DistrictID returns the value of the "district_id" field in the mutation.
-func (m *BuildingMutation) DistrictID() (r int, exists bool) {
-	v := m.district
+// Year returns the value of the "year" field in the mutation.
+func (m *ActSequenceMutation) Year() (r int, exists bool) {
+	v := m.year
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDistrictID returns the old "district_id" field's value of the Building entity.
-// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// OldYear returns the old "year" field's value of the ActSequence entity.
+// If the ActSequence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BuildingMutation) OldDistrictID(ctx context.Context) (v int, err error) {
+func (m *ActSequenceMutation) OldYear(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDistrictID is only allowed on UpdateOne operations")
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDistrictID requires an ID field in the mutation")
+		return v, errors.New("OldYear requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDistrictID: %w", err)
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
 	}
-	return oldValue.DistrictID, nil
-}
-
-// ResetDistrictID resets all changes to the "district_id" field.
-func (m *BuildingMutation) ResetDistrictID() {
-	m.district = nil
+	return oldValue.Year, nil
 }
 
-// SetJkhUnitID sets the "jkh_unit_id" field.
-func (m *BuildingMutation) SetJkhUnitID(i int) {
-	m.jkh_unit = &i
+// AddYear adds i to the "year" field.
+func (m *ActSequenceMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
 }
 
-// JkhUnitID returns the value of the "jkh_unit_id" field in the mutation.
-func (m *BuildingMutation) JkhUnitID() (r int, exists bool) {
-	v := m.jkh_unit
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *ActSequenceMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJkhUnitID returns the old "jkh_unit_id" field's value of the Building entity.
-// If the Building object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BuildingMutation) OldJkhUnitID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJkhUnitID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJkhUnitID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJkhUnitID: %w", err)
-	}
-	return oldValue.JkhUnitID, nil
-}
-
-// ResetJkhUnitID resets all changes to the "jkh_unit_id" field.
-func (m *BuildingMutation) ResetJkhUnitID() {
-	m.jkh_unit = nil
+// ResetYear resets all changes to the "year" field.
+func (m *ActSequenceMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
 }
 
-// SetInspectorID sets the "inspector_id" field.
-func (m *BuildingMutation) SetInspectorID(i int) {
-	m.inspector = &i
+// SetValue sets the "value" field.
+func (m *ActSequenceMutation) SetValue(i int) {
+	m.value = &i
+	m.addvalue = nil
 }
 
-// InspectorID returns the value of the "inspector_id" field in the mutation.
-func (m *BuildingMutation) InspectorID() (r int, exists bool) {
-	v := m.inspector
+// Value returns the value of the "value" field in the mutation.
+func (m *ActSequenceMutation) Value() (r int, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInspectorID returns the old "inspector_id" field's value of the Building entity.
-// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the ActSequence entity.
+// If the ActSequence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BuildingMutation) OldInspectorID(ctx context.Context) (v int, err error) {
+func (m *ActSequenceMutation) OldValue(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInspectorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInspectorID requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInspectorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.InspectorID, nil
+	return oldValue.Value, nil
 }
 
-// ClearInspectorID clears the value of the "inspector_id" field.
-func (m *BuildingMutation) ClearInspectorID() {
-	m.inspector = nil
-	m.clearedFields[building.FieldInspectorID] = struct{}{}
+// AddValue adds i to the "value" field.
+func (m *ActSequenceMutation) AddValue(i int) {
+	if m.addvalue != nil {
+		*m.addvalue += i
+	} else {
+		m.addvalue = &i
+	}
 }
 
-// InspectorIDCleared returns if the "inspector_id" field was cleared in this mutation.
-func (m *BuildingMutation) InspectorIDCleared() bool {
-	_, ok := m.clearedFields[building.FieldInspectorID]
-	return ok
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *ActSequenceMutation) AddedValue() (r int, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetInspectorID resets all changes to the "inspector_id" field.
-func (m *BuildingMutation) ResetInspectorID() {
-	m.inspector = nil
-	delete(m.clearedFields, building.FieldInspectorID)
+// ResetValue resets all changes to the "value" field.
+func (m *ActSequenceMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
 }
 
-// SetAddress sets the "address" field.
-func (m *BuildingMutation) SetAddress(s string) {
-	m.address = &s
+// Where appends a list predicates to the ActSequenceMutation builder.
+func (m *ActSequenceMutation) Where(ps ...predicate.ActSequence) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Address returns the value of the "address" field in the mutation.
-func (m *BuildingMutation) Address() (r string, exists bool) {
-	v := m.address
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ActSequenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ActSequenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActSequence, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldAddress returns the old "address" field's value of the Building entity.
-// If the Building object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BuildingMutation) OldAddress(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
-	}
-	return oldValue.Address, nil
+// Op returns the operation name.
+func (m *ActSequenceMutation) Op() Op {
+	return m.op
 }
 
-// ResetAddress resets all changes to the "address" field.
-func (m *BuildingMutation) ResetAddress() {
-	m.address = nil
+// SetOp allows setting the mutation operation.
+func (m *ActSequenceMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetConstructionYear sets the "construction_year" field.
-func (m *BuildingMutation) SetConstructionYear(i int) {
-	m.construction_year = &i
-	m.addconstruction_year = nil
+// Type returns the node type of this mutation (ActSequence).
+func (m *ActSequenceMutation) Type() string {
+	return m.typ
 }
 
-// ConstructionYear returns the value of the "construction_year" field in the mutation.
-func (m *BuildingMutation) ConstructionYear() (r int, exists bool) {
-	v := m.construction_year
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ActSequenceMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.year != nil {
+		fields = append(fields, actsequence.FieldYear)
 	}
-	return *v, true
+	if m.value != nil {
+		fields = append(fields, actsequence.FieldValue)
+	}
+	return fields
 }
 
-// OldConstructionYear returns the old "construction_year" field's value of the Building entity.
-// If the Building object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BuildingMutation) OldConstructionYear(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConstructionYear is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConstructionYear requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConstructionYear: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ActSequenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case actsequence.FieldYear:
+		return m.Year()
+	case actsequence.FieldValue:
+		return m.Value()
 	}
-	return oldValue.ConstructionYear, nil
+	return nil, false
 }
 
-// AddConstructionYear adds i to the "construction_year" field.
-func (m *BuildingMutation) AddConstructionYear(i int) {
-	if m.addconstruction_year != nil {
-		*m.addconstruction_year += i
-	} else {
-		m.addconstruction_year = &i
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ActSequenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case actsequence.FieldYear:
+		return m.OldYear(ctx)
+	case actsequence.FieldValue:
+		return m.OldValue(ctx)
 	}
+	return nil, fmt.Errorf("unknown ActSequence field %s", name)
 }
 
-// AddedConstructionYear returns the value that was added to the "construction_year" field in this mutation.
-func (m *BuildingMutation) AddedConstructionYear() (r int, exists bool) {
-	v := m.addconstruction_year
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ActSequenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case actsequence.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYear(v)
+		return nil
+	case actsequence.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ActSequence field %s", name)
 }
 
-// ClearConstructionYear clears the value of the "construction_year" field.
-func (m *BuildingMutation) ClearConstructionYear() {
-	m.construction_year = nil
-	m.addconstruction_year = nil
-	m.clearedFields[building.FieldConstructionYear] = struct{}{}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ActSequenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addyear != nil {
+		fields = append(fields, actsequence.FieldYear)
+	}
+	if m.addvalue != nil {
+		fields = append(fields, actsequence.FieldValue)
+	}
+	return fields
 }
 
-// ConstructionYearCleared returns if the "construction_year" field was cleared in this mutation.
-func (m *BuildingMutation) ConstructionYearCleared() bool {
-	_, ok := m.clearedFields[building.FieldConstructionYear]
-	return ok
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ActSequenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case actsequence.FieldYear:
+		return m.AddedYear()
+	case actsequence.FieldValue:
+		return m.AddedValue()
+	}
+	return nil, false
 }
 
-// ResetConstructionYear resets all changes to the "construction_year" field.
-func (m *BuildingMutation) ResetConstructionYear() {
-	m.construction_year = nil
-	m.addconstruction_year = nil
-	delete(m.clearedFields, building.FieldConstructionYear)
-}
-
-// SetDescription sets the "description" field.
-func (m *BuildingMutation) SetDescription(s string) {
-	m.description = &s
-}
-
-// Description returns the value of the "description" field in the mutation.
-func (m *BuildingMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the Building entity.
-// If the Building object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BuildingMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ActSequenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case actsequence.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	case actsequence.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
 	}
-	return oldValue.Description, nil
+	return fmt.Errorf("unknown ActSequence numeric field %s", name)
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *BuildingMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[building.FieldDescription] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ActSequenceMutation) ClearedFields() []string {
+	return nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *BuildingMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[building.FieldDescription]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ActSequenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *BuildingMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, building.FieldDescription)
-}
-
-// SetPhoto sets the "photo" field.
-func (m *BuildingMutation) SetPhoto(s string) {
-	m.photo = &s
-}
-
-// Photo returns the value of the "photo" field in the mutation.
-func (m *BuildingMutation) Photo() (r string, exists bool) {
-	v := m.photo
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ActSequenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ActSequence nullable field %s", name)
 }
 
-// OldPhoto returns the old "photo" field's value of the Building entity.
-// If the Building object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BuildingMutation) OldPhoto(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhoto is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhoto requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhoto: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ActSequenceMutation) ResetField(name string) error {
+	switch name {
+	case actsequence.FieldYear:
+		m.ResetYear()
+		return nil
+	case actsequence.FieldValue:
+		m.ResetValue()
+		return nil
 	}
-	return oldValue.Photo, nil
+	return fmt.Errorf("unknown ActSequence field %s", name)
 }
 
-// ClearPhoto clears the value of the "photo" field.
-func (m *BuildingMutation) ClearPhoto() {
-	m.photo = nil
-	m.clearedFields[building.FieldPhoto] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ActSequenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// PhotoCleared returns if the "photo" field was cleared in this mutation.
-func (m *BuildingMutation) PhotoCleared() bool {
-	_, ok := m.clearedFields[building.FieldPhoto]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ActSequenceMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetPhoto resets all changes to the "photo" field.
-func (m *BuildingMutation) ResetPhoto() {
-	m.photo = nil
-	delete(m.clearedFields, building.FieldPhoto)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ActSequenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearJkhUnit clears the "jkh_unit" edge to the JkhUnit entity.
-func (m *BuildingMutation) ClearJkhUnit() {
-	m.clearedjkh_unit = true
-	m.clearedFields[building.FieldJkhUnitID] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ActSequenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// JkhUnitCleared reports if the "jkh_unit" edge to the JkhUnit entity was cleared.
-func (m *BuildingMutation) JkhUnitCleared() bool {
-	return m.clearedjkh_unit
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ActSequenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// JkhUnitIDs returns the "jkh_unit" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// JkhUnitID instead. It exists only for internal usage by the builders.
-func (m *BuildingMutation) JkhUnitIDs() (ids []int) {
-	if id := m.jkh_unit; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ActSequenceMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetJkhUnit resets all changes to the "jkh_unit" edge.
-func (m *BuildingMutation) ResetJkhUnit() {
-	m.jkh_unit = nil
-	m.clearedjkh_unit = false
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ActSequenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ActSequence unique edge %s", name)
 }
 
-// ClearDistrict clears the "district" edge to the District entity.
-func (m *BuildingMutation) ClearDistrict() {
-	m.cleareddistrict = true
-	m.clearedFields[building.FieldDistrictID] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ActSequenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ActSequence edge %s", name)
 }
 
-// DistrictCleared reports if the "district" edge to the District entity was cleared.
-func (m *BuildingMutation) DistrictCleared() bool {
-	return m.cleareddistrict
+// BuildingMutation represents an operation that mutates the Building nodes in the graph.
+type BuildingMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	address              *string
+	construction_year    *int
+	addconstruction_year *int
+	description          *string
+	photo                *string
+	contact_name         *string
+	contact_phone        *string
+	floors               *int
+	addfloors            *int
+	entrances            *int
+	addentrances         *int
+	apartments           *int
+	addapartments        *int
+	clearedFields        map[string]struct{}
+	jkh_unit             *int
+	clearedjkh_unit      bool
+	district             *int
+	cleareddistrict      bool
+	inspector            *int
+	clearedinspector     bool
+	tasks                map[int]struct{}
+	removedtasks         map[int]struct{}
+	clearedtasks         bool
+	done                 bool
+	oldValue             func(context.Context) (*Building, error)
+	predicates           []predicate.Building
 }
 
-// DistrictIDs returns the "district" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DistrictID instead. It exists only for internal usage by the builders.
-func (m *BuildingMutation) DistrictIDs() (ids []int) {
-	if id := m.district; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
+var _ ent.Mutation = (*BuildingMutation)(nil)
 
-// ResetDistrict resets all changes to the "district" edge.
-func (m *BuildingMutation) ResetDistrict() {
-	m.district = nil
-	m.cleareddistrict = false
-}
+// buildingOption allows management of the mutation configuration using functional options.
+type buildingOption func(*BuildingMutation)
 
-// ClearInspector clears the "inspector" edge to the User entity.
-func (m *BuildingMutation) ClearInspector() {
-	m.clearedinspector = true
-	m.clearedFields[building.FieldInspectorID] = struct{}{}
+// newBuildingMutation creates new mutation for the Building entity.
+func newBuildingMutation(c config, op Op, opts ...buildingOption) *BuildingMutation {
+	m := &BuildingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBuilding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// InspectorCleared reports if the "inspector" edge to the User entity was cleared.
-func (m *BuildingMutation) InspectorCleared() bool {
-	return m.InspectorIDCleared() || m.clearedinspector
+// withBuildingID sets the ID field of the mutation.
+func withBuildingID(id int) buildingOption {
+	return func(m *BuildingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Building
+		)
+		m.oldValue = func(ctx context.Context) (*Building, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Building.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// InspectorIDs returns the "inspector" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// InspectorID instead. It exists only for internal usage by the builders.
-func (m *BuildingMutation) InspectorIDs() (ids []int) {
-	if id := m.inspector; id != nil {
-		ids = append(ids, *id)
+// withBuilding sets the old Building of the mutation.
+func withBuilding(node *Building) buildingOption {
+	return func(m *BuildingMutation) {
+		m.oldValue = func(context.Context) (*Building, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetInspector resets all changes to the "inspector" edge.
-func (m *BuildingMutation) ResetInspector() {
-	m.inspector = nil
-	m.clearedinspector = false
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BuildingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
-func (m *BuildingMutation) AddTaskIDs(ids ...int) {
-	if m.tasks == nil {
-		m.tasks = make(map[int]struct{})
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BuildingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	for i := range ids {
-		m.tasks[ids[i]] = struct{}{}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BuildingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// ClearTasks clears the "tasks" edge to the Task entity.
-func (m *BuildingMutation) ClearTasks() {
-	m.clearedtasks = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BuildingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Building.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
-func (m *BuildingMutation) TasksCleared() bool {
-	return m.clearedtasks
+// SetDistrictID sets the "district_id" field.
+func (m *BuildingMutation) SetDistrictID(i int) {
+	m.district = &i
 }
 
-// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
-func (m *BuildingMutation) RemoveTaskIDs(ids ...int) {
-	if m.removedtasks == nil {
-		m.removedtasks = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.tasks, ids[i])
-		m.removedtasks[ids[i]] = struct{}{}
+// DistrictID returns the value of the "district_id" field in the mutation.
+func (m *BuildingMutation) DistrictID() (r int, exists bool) {
+	v := m.district
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
-func (m *BuildingMutation) RemovedTasksIDs() (ids []int) {
-	for id := range m.removedtasks {
-		ids = append(ids, id)
+// OldDistrictID returns the old "district_id" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldDistrictID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDistrictID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDistrictID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDistrictID: %w", err)
+	}
+	return oldValue.DistrictID, nil
 }
 
-// TasksIDs returns the "tasks" edge IDs in the mutation.
-func (m *BuildingMutation) TasksIDs() (ids []int) {
-	for id := range m.tasks {
-		ids = append(ids, id)
-	}
-	return
+// ResetDistrictID resets all changes to the "district_id" field.
+func (m *BuildingMutation) ResetDistrictID() {
+	m.district = nil
 }
 
-// ResetTasks resets all changes to the "tasks" edge.
-func (m *BuildingMutation) ResetTasks() {
-	m.tasks = nil
-	m.clearedtasks = false
-	m.removedtasks = nil
+// SetJkhUnitID sets the "jkh_unit_id" field.
+func (m *BuildingMutation) SetJkhUnitID(i int) {
+	m.jkh_unit = &i
 }
 
-// Where appends a list predicates to the BuildingMutation builder.
-func (m *BuildingMutation) Where(ps ...predicate.Building) {
-	m.predicates = append(m.predicates, ps...)
+// JkhUnitID returns the value of the "jkh_unit_id" field in the mutation.
+func (m *BuildingMutation) JkhUnitID() (r int, exists bool) {
+	v := m.jkh_unit
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the BuildingMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BuildingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Building, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldJkhUnitID returns the old "jkh_unit_id" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldJkhUnitID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJkhUnitID is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJkhUnitID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJkhUnitID: %w", err)
+	}
+	return oldValue.JkhUnitID, nil
 }
 
-// Op returns the operation name.
-func (m *BuildingMutation) Op() Op {
-	return m.op
+// ResetJkhUnitID resets all changes to the "jkh_unit_id" field.
+func (m *BuildingMutation) ResetJkhUnitID() {
+	m.jkh_unit = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *BuildingMutation) SetOp(op Op) {
-	m.op = op
+// SetInspectorID sets the "inspector_id" field.
+func (m *BuildingMutation) SetInspectorID(i int) {
+	m.inspector = &i
 }
 
-// Type returns the node type of this mutation (Building).
-func (m *BuildingMutation) Type() string {
-	return m.typ
+// InspectorID returns the value of the "inspector_id" field in the mutation.
+func (m *BuildingMutation) InspectorID() (r int, exists bool) {
+	v := m.inspector
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BuildingMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.district != nil {
-		fields = append(fields, building.FieldDistrictID)
+// OldInspectorID returns the old "inspector_id" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldInspectorID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInspectorID is only allowed on UpdateOne operations")
 	}
-	if m.jkh_unit != nil {
-		fields = append(fields, building.FieldJkhUnitID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInspectorID requires an ID field in the mutation")
 	}
-	if m.inspector != nil {
-		fields = append(fields, building.FieldInspectorID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInspectorID: %w", err)
 	}
-	if m.address != nil {
-		fields = append(fields, building.FieldAddress)
+	return oldValue.InspectorID, nil
+}
+
+// ClearInspectorID clears the value of the "inspector_id" field.
+func (m *BuildingMutation) ClearInspectorID() {
+	m.inspector = nil
+	m.clearedFields[building.FieldInspectorID] = struct{}{}
+}
+
+// InspectorIDCleared returns if the "inspector_id" field was cleared in this mutation.
+func (m *BuildingMutation) InspectorIDCleared() bool {
+	_, ok := m.clearedFields[building.FieldInspectorID]
+	return ok
+}
+
+// ResetInspectorID resets all changes to the "inspector_id" field.
+func (m *BuildingMutation) ResetInspectorID() {
+	m.inspector = nil
+	delete(m.clearedFields, building.FieldInspectorID)
+}
+
+// SetAddress sets the "address" field.
+func (m *BuildingMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *BuildingMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
 	}
-	if m.construction_year != nil {
-		fields = append(fields, building.FieldConstructionYear)
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
-	if m.description != nil {
-		fields = append(fields, building.FieldDescription)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
 	}
-	if m.photo != nil {
-		fields = append(fields, building.FieldPhoto)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
 	}
-	return fields
+	return oldValue.Address, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BuildingMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case building.FieldDistrictID:
-		return m.DistrictID()
-	case building.FieldJkhUnitID:
-		return m.JkhUnitID()
-	case building.FieldInspectorID:
-		return m.InspectorID()
-	case building.FieldAddress:
-		return m.Address()
-	case building.FieldConstructionYear:
-		return m.ConstructionYear()
-	case building.FieldDescription:
-		return m.Description()
-	case building.FieldPhoto:
-		return m.Photo()
-	}
-	return nil, false
+// ResetAddress resets all changes to the "address" field.
+func (m *BuildingMutation) ResetAddress() {
+	m.address = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BuildingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+// SetConstructionYear sets the "construction_year" field.
+func (m *BuildingMutation) SetConstructionYear(i int) {
+	m.construction_year = &i
+	m.addconstruction_year = nil
+}
+
+// ConstructionYear returns the value of the "construction_year" field in the mutation.
+func (m *BuildingMutation) ConstructionYear() (r int, exists bool) {
+	v := m.construction_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConstructionYear returns the old "construction_year" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldConstructionYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConstructionYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConstructionYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConstructionYear: %w", err)
+	}
+	return oldValue.ConstructionYear, nil
+}
+
+// AddConstructionYear adds i to the "construction_year" field.
+func (m *BuildingMutation) AddConstructionYear(i int) {
+	if m.addconstruction_year != nil {
+		*m.addconstruction_year += i
+	} else {
+		m.addconstruction_year = &i
+	}
+}
+
+// AddedConstructionYear returns the value that was added to the "construction_year" field in this mutation.
+func (m *BuildingMutation) AddedConstructionYear() (r int, exists bool) {
+	v := m.addconstruction_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearConstructionYear clears the value of the "construction_year" field.
+func (m *BuildingMutation) ClearConstructionYear() {
+	m.construction_year = nil
+	m.addconstruction_year = nil
+	m.clearedFields[building.FieldConstructionYear] = struct{}{}
+}
+
+// ConstructionYearCleared returns if the "construction_year" field was cleared in this mutation.
+func (m *BuildingMutation) ConstructionYearCleared() bool {
+	_, ok := m.clearedFields[building.FieldConstructionYear]
+	return ok
+}
+
+// ResetConstructionYear resets all changes to the "construction_year" field.
+func (m *BuildingMutation) ResetConstructionYear() {
+	m.construction_year = nil
+	m.addconstruction_year = nil
+	delete(m.clearedFields, building.FieldConstructionYear)
+}
+
+// SetDescription sets the "description" field.
+func (m *BuildingMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BuildingMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BuildingMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[building.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BuildingMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[building.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BuildingMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, building.FieldDescription)
+}
+
+// SetPhoto sets the "photo" field.
+func (m *BuildingMutation) SetPhoto(s string) {
+	m.photo = &s
+}
+
+// Photo returns the value of the "photo" field in the mutation.
+func (m *BuildingMutation) Photo() (r string, exists bool) {
+	v := m.photo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhoto returns the old "photo" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldPhoto(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhoto is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhoto requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhoto: %w", err)
+	}
+	return oldValue.Photo, nil
+}
+
+// ClearPhoto clears the value of the "photo" field.
+func (m *BuildingMutation) ClearPhoto() {
+	m.photo = nil
+	m.clearedFields[building.FieldPhoto] = struct{}{}
+}
+
+// PhotoCleared returns if the "photo" field was cleared in this mutation.
+func (m *BuildingMutation) PhotoCleared() bool {
+	_, ok := m.clearedFields[building.FieldPhoto]
+	return ok
+}
+
+// ResetPhoto resets all changes to the "photo" field.
+func (m *BuildingMutation) ResetPhoto() {
+	m.photo = nil
+	delete(m.clearedFields, building.FieldPhoto)
+}
+
+// SetContactName sets the "contact_name" field.
+func (m *BuildingMutation) SetContactName(s string) {
+	m.contact_name = &s
+}
+
+// ContactName returns the value of the "contact_name" field in the mutation.
+func (m *BuildingMutation) ContactName() (r string, exists bool) {
+	v := m.contact_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContactName returns the old "contact_name" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldContactName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContactName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContactName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContactName: %w", err)
+	}
+	return oldValue.ContactName, nil
+}
+
+// ClearContactName clears the value of the "contact_name" field.
+func (m *BuildingMutation) ClearContactName() {
+	m.contact_name = nil
+	m.clearedFields[building.FieldContactName] = struct{}{}
+}
+
+// ContactNameCleared returns if the "contact_name" field was cleared in this mutation.
+func (m *BuildingMutation) ContactNameCleared() bool {
+	_, ok := m.clearedFields[building.FieldContactName]
+	return ok
+}
+
+// ResetContactName resets all changes to the "contact_name" field.
+func (m *BuildingMutation) ResetContactName() {
+	m.contact_name = nil
+	delete(m.clearedFields, building.FieldContactName)
+}
+
+// SetContactPhone sets the "contact_phone" field.
+func (m *BuildingMutation) SetContactPhone(s string) {
+	m.contact_phone = &s
+}
+
+// ContactPhone returns the value of the "contact_phone" field in the mutation.
+func (m *BuildingMutation) ContactPhone() (r string, exists bool) {
+	v := m.contact_phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContactPhone returns the old "contact_phone" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldContactPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContactPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContactPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContactPhone: %w", err)
+	}
+	return oldValue.ContactPhone, nil
+}
+
+// ClearContactPhone clears the value of the "contact_phone" field.
+func (m *BuildingMutation) ClearContactPhone() {
+	m.contact_phone = nil
+	m.clearedFields[building.FieldContactPhone] = struct{}{}
+}
+
+// ContactPhoneCleared returns if the "contact_phone" field was cleared in this mutation.
+func (m *BuildingMutation) ContactPhoneCleared() bool {
+	_, ok := m.clearedFields[building.FieldContactPhone]
+	return ok
+}
+
+// ResetContactPhone resets all changes to the "contact_phone" field.
+func (m *BuildingMutation) ResetContactPhone() {
+	m.contact_phone = nil
+	delete(m.clearedFields, building.FieldContactPhone)
+}
+
+// SetFloors sets the "floors" field.
+func (m *BuildingMutation) SetFloors(i int) {
+	m.floors = &i
+	m.addfloors = nil
+}
+
+// Floors returns the value of the "floors" field in the mutation.
+func (m *BuildingMutation) Floors() (r int, exists bool) {
+	v := m.floors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFloors returns the old "floors" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldFloors(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFloors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFloors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFloors: %w", err)
+	}
+	return oldValue.Floors, nil
+}
+
+// AddFloors adds i to the "floors" field.
+func (m *BuildingMutation) AddFloors(i int) {
+	if m.addfloors != nil {
+		*m.addfloors += i
+	} else {
+		m.addfloors = &i
+	}
+}
+
+// AddedFloors returns the value that was added to the "floors" field in this mutation.
+func (m *BuildingMutation) AddedFloors() (r int, exists bool) {
+	v := m.addfloors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFloors clears the value of the "floors" field.
+func (m *BuildingMutation) ClearFloors() {
+	m.floors = nil
+	m.addfloors = nil
+	m.clearedFields[building.FieldFloors] = struct{}{}
+}
+
+// FloorsCleared returns if the "floors" field was cleared in this mutation.
+func (m *BuildingMutation) FloorsCleared() bool {
+	_, ok := m.clearedFields[building.FieldFloors]
+	return ok
+}
+
+// ResetFloors resets all changes to the "floors" field.
+func (m *BuildingMutation) ResetFloors() {
+	m.floors = nil
+	m.addfloors = nil
+	delete(m.clearedFields, building.FieldFloors)
+}
+
+// SetEntrances sets the "entrances" field.
+func (m *BuildingMutation) SetEntrances(i int) {
+	m.entrances = &i
+	m.addentrances = nil
+}
+
+// Entrances returns the value of the "entrances" field in the mutation.
+func (m *BuildingMutation) Entrances() (r int, exists bool) {
+	v := m.entrances
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntrances returns the old "entrances" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldEntrances(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntrances is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntrances requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntrances: %w", err)
+	}
+	return oldValue.Entrances, nil
+}
+
+// AddEntrances adds i to the "entrances" field.
+func (m *BuildingMutation) AddEntrances(i int) {
+	if m.addentrances != nil {
+		*m.addentrances += i
+	} else {
+		m.addentrances = &i
+	}
+}
+
+// AddedEntrances returns the value that was added to the "entrances" field in this mutation.
+func (m *BuildingMutation) AddedEntrances() (r int, exists bool) {
+	v := m.addentrances
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEntrances clears the value of the "entrances" field.
+func (m *BuildingMutation) ClearEntrances() {
+	m.entrances = nil
+	m.addentrances = nil
+	m.clearedFields[building.FieldEntrances] = struct{}{}
+}
+
+// EntrancesCleared returns if the "entrances" field was cleared in this mutation.
+func (m *BuildingMutation) EntrancesCleared() bool {
+	_, ok := m.clearedFields[building.FieldEntrances]
+	return ok
+}
+
+// ResetEntrances resets all changes to the "entrances" field.
+func (m *BuildingMutation) ResetEntrances() {
+	m.entrances = nil
+	m.addentrances = nil
+	delete(m.clearedFields, building.FieldEntrances)
+}
+
+// SetApartments sets the "apartments" field.
+func (m *BuildingMutation) SetApartments(i int) {
+	m.apartments = &i
+	m.addapartments = nil
+}
+
+// Apartments returns the value of the "apartments" field in the mutation.
+func (m *BuildingMutation) Apartments() (r int, exists bool) {
+	v := m.apartments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApartments returns the old "apartments" field's value of the Building entity.
+// If the Building object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildingMutation) OldApartments(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApartments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApartments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApartments: %w", err)
+	}
+	return oldValue.Apartments, nil
+}
+
+// AddApartments adds i to the "apartments" field.
+func (m *BuildingMutation) AddApartments(i int) {
+	if m.addapartments != nil {
+		*m.addapartments += i
+	} else {
+		m.addapartments = &i
+	}
+}
+
+// AddedApartments returns the value that was added to the "apartments" field in this mutation.
+func (m *BuildingMutation) AddedApartments() (r int, exists bool) {
+	v := m.addapartments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearApartments clears the value of the "apartments" field.
+func (m *BuildingMutation) ClearApartments() {
+	m.apartments = nil
+	m.addapartments = nil
+	m.clearedFields[building.FieldApartments] = struct{}{}
+}
+
+// ApartmentsCleared returns if the "apartments" field was cleared in this mutation.
+func (m *BuildingMutation) ApartmentsCleared() bool {
+	_, ok := m.clearedFields[building.FieldApartments]
+	return ok
+}
+
+// ResetApartments resets all changes to the "apartments" field.
+func (m *BuildingMutation) ResetApartments() {
+	m.apartments = nil
+	m.addapartments = nil
+	delete(m.clearedFields, building.FieldApartments)
+}
+
+// ClearJkhUnit clears the "jkh_unit" edge to the JkhUnit entity.
+func (m *BuildingMutation) ClearJkhUnit() {
+	m.clearedjkh_unit = true
+	m.clearedFields[building.FieldJkhUnitID] = struct{}{}
+}
+
+// JkhUnitCleared reports if the "jkh_unit" edge to the JkhUnit entity was cleared.
+func (m *BuildingMutation) JkhUnitCleared() bool {
+	return m.clearedjkh_unit
+}
+
+// JkhUnitIDs returns the "jkh_unit" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JkhUnitID instead. It exists only for internal usage by the builders.
+func (m *BuildingMutation) JkhUnitIDs() (ids []int) {
+	if id := m.jkh_unit; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJkhUnit resets all changes to the "jkh_unit" edge.
+func (m *BuildingMutation) ResetJkhUnit() {
+	m.jkh_unit = nil
+	m.clearedjkh_unit = false
+}
+
+// ClearDistrict clears the "district" edge to the District entity.
+func (m *BuildingMutation) ClearDistrict() {
+	m.cleareddistrict = true
+	m.clearedFields[building.FieldDistrictID] = struct{}{}
+}
+
+// DistrictCleared reports if the "district" edge to the District entity was cleared.
+func (m *BuildingMutation) DistrictCleared() bool {
+	return m.cleareddistrict
+}
+
+// DistrictIDs returns the "district" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DistrictID instead. It exists only for internal usage by the builders.
+func (m *BuildingMutation) DistrictIDs() (ids []int) {
+	if id := m.district; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDistrict resets all changes to the "district" edge.
+func (m *BuildingMutation) ResetDistrict() {
+	m.district = nil
+	m.cleareddistrict = false
+}
+
+// ClearInspector clears the "inspector" edge to the User entity.
+func (m *BuildingMutation) ClearInspector() {
+	m.clearedinspector = true
+	m.clearedFields[building.FieldInspectorID] = struct{}{}
+}
+
+// InspectorCleared reports if the "inspector" edge to the User entity was cleared.
+func (m *BuildingMutation) InspectorCleared() bool {
+	return m.InspectorIDCleared() || m.clearedinspector
+}
+
+// InspectorIDs returns the "inspector" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InspectorID instead. It exists only for internal usage by the builders.
+func (m *BuildingMutation) InspectorIDs() (ids []int) {
+	if id := m.inspector; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInspector resets all changes to the "inspector" edge.
+func (m *BuildingMutation) ResetInspector() {
+	m.inspector = nil
+	m.clearedinspector = false
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *BuildingMutation) AddTaskIDs(ids ...int) {
+	if m.tasks == nil {
+		m.tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *BuildingMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *BuildingMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *BuildingMutation) RemoveTaskIDs(ids ...int) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *BuildingMutation) RemovedTasksIDs() (ids []int) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *BuildingMutation) TasksIDs() (ids []int) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *BuildingMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// Where appends a list predicates to the BuildingMutation builder.
+func (m *BuildingMutation) Where(ps ...predicate.Building) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BuildingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BuildingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Building, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BuildingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BuildingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Building).
+func (m *BuildingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BuildingMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.district != nil {
+		fields = append(fields, building.FieldDistrictID)
+	}
+	if m.jkh_unit != nil {
+		fields = append(fields, building.FieldJkhUnitID)
+	}
+	if m.inspector != nil {
+		fields = append(fields, building.FieldInspectorID)
+	}
+	if m.address != nil {
+		fields = append(fields, building.FieldAddress)
+	}
+	if m.construction_year != nil {
+		fields = append(fields, building.FieldConstructionYear)
+	}
+	if m.description != nil {
+		fields = append(fields, building.FieldDescription)
+	}
+	if m.photo != nil {
+		fields = append(fields, building.FieldPhoto)
+	}
+	if m.contact_name != nil {
+		fields = append(fields, building.FieldContactName)
+	}
+	if m.contact_phone != nil {
+		fields = append(fields, building.FieldContactPhone)
+	}
+	if m.floors != nil {
+		fields = append(fields, building.FieldFloors)
+	}
+	if m.entrances != nil {
+		fields = append(fields, building.FieldEntrances)
+	}
+	if m.apartments != nil {
+		fields = append(fields, building.FieldApartments)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BuildingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case building.FieldDistrictID:
+		return m.DistrictID()
+	case building.FieldJkhUnitID:
+		return m.JkhUnitID()
+	case building.FieldInspectorID:
+		return m.InspectorID()
+	case building.FieldAddress:
+		return m.Address()
+	case building.FieldConstructionYear:
+		return m.ConstructionYear()
+	case building.FieldDescription:
+		return m.Description()
+	case building.FieldPhoto:
+		return m.Photo()
+	case building.FieldContactName:
+		return m.ContactName()
+	case building.FieldContactPhone:
+		return m.ContactPhone()
+	case building.FieldFloors:
+		return m.Floors()
+	case building.FieldEntrances:
+		return m.Entrances()
+	case building.FieldApartments:
+		return m.Apartments()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BuildingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case building.FieldDistrictID:
+		return m.OldDistrictID(ctx)
+	case building.FieldJkhUnitID:
+		return m.OldJkhUnitID(ctx)
+	case building.FieldInspectorID:
+		return m.OldInspectorID(ctx)
+	case building.FieldAddress:
+		return m.OldAddress(ctx)
+	case building.FieldConstructionYear:
+		return m.OldConstructionYear(ctx)
+	case building.FieldDescription:
+		return m.OldDescription(ctx)
+	case building.FieldPhoto:
+		return m.OldPhoto(ctx)
+	case building.FieldContactName:
+		return m.OldContactName(ctx)
+	case building.FieldContactPhone:
+		return m.OldContactPhone(ctx)
+	case building.FieldFloors:
+		return m.OldFloors(ctx)
+	case building.FieldEntrances:
+		return m.OldEntrances(ctx)
+	case building.FieldApartments:
+		return m.OldApartments(ctx)
+	}
+	return nil, fmt.Errorf("unknown Building field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuildingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case building.FieldDistrictID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDistrictID(v)
+		return nil
+	case building.FieldJkhUnitID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJkhUnitID(v)
+		return nil
+	case building.FieldInspectorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInspectorID(v)
+		return nil
+	case building.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case building.FieldConstructionYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConstructionYear(v)
+		return nil
+	case building.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case building.FieldPhoto:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhoto(v)
+		return nil
+	case building.FieldContactName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContactName(v)
+		return nil
+	case building.FieldContactPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContactPhone(v)
+		return nil
+	case building.FieldFloors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFloors(v)
+		return nil
+	case building.FieldEntrances:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntrances(v)
+		return nil
+	case building.FieldApartments:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApartments(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Building field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BuildingMutation) AddedFields() []string {
+	var fields []string
+	if m.addconstruction_year != nil {
+		fields = append(fields, building.FieldConstructionYear)
+	}
+	if m.addfloors != nil {
+		fields = append(fields, building.FieldFloors)
+	}
+	if m.addentrances != nil {
+		fields = append(fields, building.FieldEntrances)
+	}
+	if m.addapartments != nil {
+		fields = append(fields, building.FieldApartments)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BuildingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case building.FieldConstructionYear:
+		return m.AddedConstructionYear()
+	case building.FieldFloors:
+		return m.AddedFloors()
+	case building.FieldEntrances:
+		return m.AddedEntrances()
+	case building.FieldApartments:
+		return m.AddedApartments()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuildingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case building.FieldConstructionYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConstructionYear(v)
+		return nil
+	case building.FieldFloors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFloors(v)
+		return nil
+	case building.FieldEntrances:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntrances(v)
+		return nil
+	case building.FieldApartments:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApartments(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Building numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BuildingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(building.FieldInspectorID) {
+		fields = append(fields, building.FieldInspectorID)
+	}
+	if m.FieldCleared(building.FieldConstructionYear) {
+		fields = append(fields, building.FieldConstructionYear)
+	}
+	if m.FieldCleared(building.FieldDescription) {
+		fields = append(fields, building.FieldDescription)
+	}
+	if m.FieldCleared(building.FieldPhoto) {
+		fields = append(fields, building.FieldPhoto)
+	}
+	if m.FieldCleared(building.FieldContactName) {
+		fields = append(fields, building.FieldContactName)
+	}
+	if m.FieldCleared(building.FieldContactPhone) {
+		fields = append(fields, building.FieldContactPhone)
+	}
+	if m.FieldCleared(building.FieldFloors) {
+		fields = append(fields, building.FieldFloors)
+	}
+	if m.FieldCleared(building.FieldEntrances) {
+		fields = append(fields, building.FieldEntrances)
+	}
+	if m.FieldCleared(building.FieldApartments) {
+		fields = append(fields, building.FieldApartments)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BuildingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BuildingMutation) ClearField(name string) error {
+	switch name {
+	case building.FieldInspectorID:
+		m.ClearInspectorID()
+		return nil
+	case building.FieldConstructionYear:
+		m.ClearConstructionYear()
+		return nil
+	case building.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case building.FieldPhoto:
+		m.ClearPhoto()
+		return nil
+	case building.FieldContactName:
+		m.ClearContactName()
+		return nil
+	case building.FieldContactPhone:
+		m.ClearContactPhone()
+		return nil
+	case building.FieldFloors:
+		m.ClearFloors()
+		return nil
+	case building.FieldEntrances:
+		m.ClearEntrances()
+		return nil
+	case building.FieldApartments:
+		m.ClearApartments()
+		return nil
+	}
+	return fmt.Errorf("unknown Building nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BuildingMutation) ResetField(name string) error {
+	switch name {
+	case building.FieldDistrictID:
+		m.ResetDistrictID()
+		return nil
+	case building.FieldJkhUnitID:
+		m.ResetJkhUnitID()
+		return nil
+	case building.FieldInspectorID:
+		m.ResetInspectorID()
+		return nil
+	case building.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case building.FieldConstructionYear:
+		m.ResetConstructionYear()
+		return nil
+	case building.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case building.FieldPhoto:
+		m.ResetPhoto()
+		return nil
+	case building.FieldContactName:
+		m.ResetContactName()
+		return nil
+	case building.FieldContactPhone:
+		m.ResetContactPhone()
+		return nil
+	case building.FieldFloors:
+		m.ResetFloors()
+		return nil
+	case building.FieldEntrances:
+		m.ResetEntrances()
+		return nil
+	case building.FieldApartments:
+		m.ResetApartments()
+		return nil
+	}
+	return fmt.Errorf("unknown Building field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BuildingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.jkh_unit != nil {
+		edges = append(edges, building.EdgeJkhUnit)
+	}
+	if m.district != nil {
+		edges = append(edges, building.EdgeDistrict)
+	}
+	if m.inspector != nil {
+		edges = append(edges, building.EdgeInspector)
+	}
+	if m.tasks != nil {
+		edges = append(edges, building.EdgeTasks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BuildingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case building.EdgeJkhUnit:
+		if id := m.jkh_unit; id != nil {
+			return []ent.Value{*id}
+		}
+	case building.EdgeDistrict:
+		if id := m.district; id != nil {
+			return []ent.Value{*id}
+		}
+	case building.EdgeInspector:
+		if id := m.inspector; id != nil {
+			return []ent.Value{*id}
+		}
+	case building.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BuildingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtasks != nil {
+		edges = append(edges, building.EdgeTasks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BuildingMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case building.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BuildingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedjkh_unit {
+		edges = append(edges, building.EdgeJkhUnit)
+	}
+	if m.cleareddistrict {
+		edges = append(edges, building.EdgeDistrict)
+	}
+	if m.clearedinspector {
+		edges = append(edges, building.EdgeInspector)
+	}
+	if m.clearedtasks {
+		edges = append(edges, building.EdgeTasks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BuildingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case building.EdgeJkhUnit:
+		return m.clearedjkh_unit
+	case building.EdgeDistrict:
+		return m.cleareddistrict
+	case building.EdgeInspector:
+		return m.clearedinspector
+	case building.EdgeTasks:
+		return m.clearedtasks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BuildingMutation) ClearEdge(name string) error {
+	switch name {
+	case building.EdgeJkhUnit:
+		m.ClearJkhUnit()
+		return nil
+	case building.EdgeDistrict:
+		m.ClearDistrict()
+		return nil
+	case building.EdgeInspector:
+		m.ClearInspector()
+		return nil
+	}
+	return fmt.Errorf("unknown Building unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BuildingMutation) ResetEdge(name string) error {
+	switch name {
+	case building.EdgeJkhUnit:
+		m.ResetJkhUnit()
+		return nil
+	case building.EdgeDistrict:
+		m.ResetDistrict()
+		return nil
+	case building.EdgeInspector:
+		m.ResetInspector()
+		return nil
+	case building.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	}
+	return fmt.Errorf("unknown Building edge %s", name)
+}
+
+// ChecklistMutation represents an operation that mutates the Checklist nodes in the graph.
+type ChecklistMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	title             *string
+	inspection_type   *checklist.InspectionType
+	description       *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	elements          map[int]struct{}
+	removedelements   map[int]struct{}
+	clearedelements   bool
+	tasks             map[int]struct{}
+	removedtasks      map[int]struct{}
+	clearedtasks      bool
+	task_links        map[int]struct{}
+	removedtask_links map[int]struct{}
+	clearedtask_links bool
+	done              bool
+	oldValue          func(context.Context) (*Checklist, error)
+	predicates        []predicate.Checklist
+}
+
+var _ ent.Mutation = (*ChecklistMutation)(nil)
+
+// checklistOption allows management of the mutation configuration using functional options.
+type checklistOption func(*ChecklistMutation)
+
+// newChecklistMutation creates new mutation for the Checklist entity.
+func newChecklistMutation(c config, op Op, opts ...checklistOption) *ChecklistMutation {
+	m := &ChecklistMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChecklist,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChecklistID sets the ID field of the mutation.
+func withChecklistID(id int) checklistOption {
+	return func(m *ChecklistMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Checklist
+		)
+		m.oldValue = func(ctx context.Context) (*Checklist, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Checklist.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChecklist sets the old Checklist of the mutation.
+func withChecklist(node *Checklist) checklistOption {
+	return func(m *ChecklistMutation) {
+		m.oldValue = func(context.Context) (*Checklist, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChecklistMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChecklistMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChecklistMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChecklistMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Checklist.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *ChecklistMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ChecklistMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Checklist entity.
+// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ChecklistMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetInspectionType sets the "inspection_type" field.
+func (m *ChecklistMutation) SetInspectionType(ct checklist.InspectionType) {
+	m.inspection_type = &ct
+}
+
+// InspectionType returns the value of the "inspection_type" field in the mutation.
+func (m *ChecklistMutation) InspectionType() (r checklist.InspectionType, exists bool) {
+	v := m.inspection_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInspectionType returns the old "inspection_type" field's value of the Checklist entity.
+// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistMutation) OldInspectionType(ctx context.Context) (v checklist.InspectionType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInspectionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInspectionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInspectionType: %w", err)
+	}
+	return oldValue.InspectionType, nil
+}
+
+// ResetInspectionType resets all changes to the "inspection_type" field.
+func (m *ChecklistMutation) ResetInspectionType() {
+	m.inspection_type = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ChecklistMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ChecklistMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Checklist entity.
+// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ChecklistMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[checklist.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ChecklistMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[checklist.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ChecklistMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, checklist.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChecklistMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChecklistMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Checklist entity.
+// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChecklistMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddElementIDs adds the "elements" edge to the ChecklistElement entity by ids.
+func (m *ChecklistMutation) AddElementIDs(ids ...int) {
+	if m.elements == nil {
+		m.elements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.elements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearElements clears the "elements" edge to the ChecklistElement entity.
+func (m *ChecklistMutation) ClearElements() {
+	m.clearedelements = true
+}
+
+// ElementsCleared reports if the "elements" edge to the ChecklistElement entity was cleared.
+func (m *ChecklistMutation) ElementsCleared() bool {
+	return m.clearedelements
+}
+
+// RemoveElementIDs removes the "elements" edge to the ChecklistElement entity by IDs.
+func (m *ChecklistMutation) RemoveElementIDs(ids ...int) {
+	if m.removedelements == nil {
+		m.removedelements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.elements, ids[i])
+		m.removedelements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedElements returns the removed IDs of the "elements" edge to the ChecklistElement entity.
+func (m *ChecklistMutation) RemovedElementsIDs() (ids []int) {
+	for id := range m.removedelements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ElementsIDs returns the "elements" edge IDs in the mutation.
+func (m *ChecklistMutation) ElementsIDs() (ids []int) {
+	for id := range m.elements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetElements resets all changes to the "elements" edge.
+func (m *ChecklistMutation) ResetElements() {
+	m.elements = nil
+	m.clearedelements = false
+	m.removedelements = nil
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *ChecklistMutation) AddTaskIDs(ids ...int) {
+	if m.tasks == nil {
+		m.tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *ChecklistMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *ChecklistMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *ChecklistMutation) RemoveTaskIDs(ids ...int) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *ChecklistMutation) RemovedTasksIDs() (ids []int) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *ChecklistMutation) TasksIDs() (ids []int) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *ChecklistMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// AddTaskLinkIDs adds the "task_links" edge to the TaskChecklist entity by ids.
+func (m *ChecklistMutation) AddTaskLinkIDs(ids ...int) {
+	if m.task_links == nil {
+		m.task_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.task_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTaskLinks clears the "task_links" edge to the TaskChecklist entity.
+func (m *ChecklistMutation) ClearTaskLinks() {
+	m.clearedtask_links = true
+}
+
+// TaskLinksCleared reports if the "task_links" edge to the TaskChecklist entity was cleared.
+func (m *ChecklistMutation) TaskLinksCleared() bool {
+	return m.clearedtask_links
+}
+
+// RemoveTaskLinkIDs removes the "task_links" edge to the TaskChecklist entity by IDs.
+func (m *ChecklistMutation) RemoveTaskLinkIDs(ids ...int) {
+	if m.removedtask_links == nil {
+		m.removedtask_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.task_links, ids[i])
+		m.removedtask_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTaskLinks returns the removed IDs of the "task_links" edge to the TaskChecklist entity.
+func (m *ChecklistMutation) RemovedTaskLinksIDs() (ids []int) {
+	for id := range m.removedtask_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TaskLinksIDs returns the "task_links" edge IDs in the mutation.
+func (m *ChecklistMutation) TaskLinksIDs() (ids []int) {
+	for id := range m.task_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTaskLinks resets all changes to the "task_links" edge.
+func (m *ChecklistMutation) ResetTaskLinks() {
+	m.task_links = nil
+	m.clearedtask_links = false
+	m.removedtask_links = nil
+}
+
+// Where appends a list predicates to the ChecklistMutation builder.
+func (m *ChecklistMutation) Where(ps ...predicate.Checklist) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChecklistMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChecklistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Checklist, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChecklistMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChecklistMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Checklist).
+func (m *ChecklistMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChecklistMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.title != nil {
+		fields = append(fields, checklist.FieldTitle)
+	}
+	if m.inspection_type != nil {
+		fields = append(fields, checklist.FieldInspectionType)
+	}
+	if m.description != nil {
+		fields = append(fields, checklist.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, checklist.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChecklistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checklist.FieldTitle:
+		return m.Title()
+	case checklist.FieldInspectionType:
+		return m.InspectionType()
+	case checklist.FieldDescription:
+		return m.Description()
+	case checklist.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChecklistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checklist.FieldTitle:
+		return m.OldTitle(ctx)
+	case checklist.FieldInspectionType:
+		return m.OldInspectionType(ctx)
+	case checklist.FieldDescription:
+		return m.OldDescription(ctx)
+	case checklist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Checklist field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChecklistMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checklist.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case checklist.FieldInspectionType:
+		v, ok := value.(checklist.InspectionType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInspectionType(v)
+		return nil
+	case checklist.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case checklist.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Checklist field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChecklistMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChecklistMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChecklistMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Checklist numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChecklistMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(checklist.FieldDescription) {
+		fields = append(fields, checklist.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChecklistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChecklistMutation) ClearField(name string) error {
+	switch name {
+	case checklist.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Checklist nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChecklistMutation) ResetField(name string) error {
+	switch name {
+	case checklist.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case checklist.FieldInspectionType:
+		m.ResetInspectionType()
+		return nil
+	case checklist.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case checklist.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Checklist field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChecklistMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.elements != nil {
+		edges = append(edges, checklist.EdgeElements)
+	}
+	if m.tasks != nil {
+		edges = append(edges, checklist.EdgeTasks)
+	}
+	if m.task_links != nil {
+		edges = append(edges, checklist.EdgeTaskLinks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChecklistMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case checklist.EdgeElements:
+		ids := make([]ent.Value, 0, len(m.elements))
+		for id := range m.elements {
+			ids = append(ids, id)
+		}
+		return ids
+	case checklist.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case checklist.EdgeTaskLinks:
+		ids := make([]ent.Value, 0, len(m.task_links))
+		for id := range m.task_links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChecklistMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedelements != nil {
+		edges = append(edges, checklist.EdgeElements)
+	}
+	if m.removedtasks != nil {
+		edges = append(edges, checklist.EdgeTasks)
+	}
+	if m.removedtask_links != nil {
+		edges = append(edges, checklist.EdgeTaskLinks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChecklistMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case checklist.EdgeElements:
+		ids := make([]ent.Value, 0, len(m.removedelements))
+		for id := range m.removedelements {
+			ids = append(ids, id)
+		}
+		return ids
+	case checklist.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case checklist.EdgeTaskLinks:
+		ids := make([]ent.Value, 0, len(m.removedtask_links))
+		for id := range m.removedtask_links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChecklistMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedelements {
+		edges = append(edges, checklist.EdgeElements)
+	}
+	if m.clearedtasks {
+		edges = append(edges, checklist.EdgeTasks)
+	}
+	if m.clearedtask_links {
+		edges = append(edges, checklist.EdgeTaskLinks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChecklistMutation) EdgeCleared(name string) bool {
+	switch name {
+	case checklist.EdgeElements:
+		return m.clearedelements
+	case checklist.EdgeTasks:
+		return m.clearedtasks
+	case checklist.EdgeTaskLinks:
+		return m.clearedtask_links
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChecklistMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Checklist unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChecklistMutation) ResetEdge(name string) error {
+	switch name {
+	case checklist.EdgeElements:
+		m.ResetElements()
+		return nil
+	case checklist.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	case checklist.EdgeTaskLinks:
+		m.ResetTaskLinks()
+		return nil
+	}
+	return fmt.Errorf("unknown Checklist edge %s", name)
+}
+
+// ChecklistElementMutation represents an operation that mutates the ChecklistElement nodes in the graph.
+type ChecklistElementMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	order_index               *int
+	addorder_index            *int
+	photo_required            *bool
+	clearedFields             map[string]struct{}
+	checklist                 *int
+	clearedchecklist          bool
+	element_catalog           *int
+	clearedelement_catalog    bool
+	inspection_results        map[int]struct{}
+	removedinspection_results map[int]struct{}
+	clearedinspection_results bool
+	done                      bool
+	oldValue                  func(context.Context) (*ChecklistElement, error)
+	predicates                []predicate.ChecklistElement
+}
+
+var _ ent.Mutation = (*ChecklistElementMutation)(nil)
+
+// checklistelementOption allows management of the mutation configuration using functional options.
+type checklistelementOption func(*ChecklistElementMutation)
+
+// newChecklistElementMutation creates new mutation for the ChecklistElement entity.
+func newChecklistElementMutation(c config, op Op, opts ...checklistelementOption) *ChecklistElementMutation {
+	m := &ChecklistElementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChecklistElement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChecklistElementID sets the ID field of the mutation.
+func withChecklistElementID(id int) checklistelementOption {
+	return func(m *ChecklistElementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChecklistElement
+		)
+		m.oldValue = func(ctx context.Context) (*ChecklistElement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChecklistElement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChecklistElement sets the old ChecklistElement of the mutation.
+func withChecklistElement(node *ChecklistElement) checklistelementOption {
+	return func(m *ChecklistElementMutation) {
+		m.oldValue = func(context.Context) (*ChecklistElement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChecklistElementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChecklistElementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChecklistElementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChecklistElementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChecklistElement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChecklistID sets the "checklist_id" field.
+func (m *ChecklistElementMutation) SetChecklistID(i int) {
+	m.checklist = &i
+}
+
+// ChecklistID returns the value of the "checklist_id" field in the mutation.
+func (m *ChecklistElementMutation) ChecklistID() (r int, exists bool) {
+	v := m.checklist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecklistID returns the old "checklist_id" field's value of the ChecklistElement entity.
+// If the ChecklistElement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistElementMutation) OldChecklistID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecklistID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecklistID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecklistID: %w", err)
+	}
+	return oldValue.ChecklistID, nil
+}
+
+// ResetChecklistID resets all changes to the "checklist_id" field.
+func (m *ChecklistElementMutation) ResetChecklistID() {
+	m.checklist = nil
+}
+
+// SetElementID sets the "element_id" field.
+func (m *ChecklistElementMutation) SetElementID(i int) {
+	m.element_catalog = &i
+}
+
+// ElementID returns the value of the "element_id" field in the mutation.
+func (m *ChecklistElementMutation) ElementID() (r int, exists bool) {
+	v := m.element_catalog
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldElementID returns the old "element_id" field's value of the ChecklistElement entity.
+// If the ChecklistElement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistElementMutation) OldElementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldElementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldElementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldElementID: %w", err)
+	}
+	return oldValue.ElementID, nil
+}
+
+// ResetElementID resets all changes to the "element_id" field.
+func (m *ChecklistElementMutation) ResetElementID() {
+	m.element_catalog = nil
+}
+
+// SetOrderIndex sets the "order_index" field.
+func (m *ChecklistElementMutation) SetOrderIndex(i int) {
+	m.order_index = &i
+	m.addorder_index = nil
+}
+
+// OrderIndex returns the value of the "order_index" field in the mutation.
+func (m *ChecklistElementMutation) OrderIndex() (r int, exists bool) {
+	v := m.order_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderIndex returns the old "order_index" field's value of the ChecklistElement entity.
+// If the ChecklistElement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistElementMutation) OldOrderIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderIndex: %w", err)
+	}
+	return oldValue.OrderIndex, nil
+}
+
+// AddOrderIndex adds i to the "order_index" field.
+func (m *ChecklistElementMutation) AddOrderIndex(i int) {
+	if m.addorder_index != nil {
+		*m.addorder_index += i
+	} else {
+		m.addorder_index = &i
+	}
+}
+
+// AddedOrderIndex returns the value that was added to the "order_index" field in this mutation.
+func (m *ChecklistElementMutation) AddedOrderIndex() (r int, exists bool) {
+	v := m.addorder_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrderIndex clears the value of the "order_index" field.
+func (m *ChecklistElementMutation) ClearOrderIndex() {
+	m.order_index = nil
+	m.addorder_index = nil
+	m.clearedFields[checklistelement.FieldOrderIndex] = struct{}{}
+}
+
+// OrderIndexCleared returns if the "order_index" field was cleared in this mutation.
+func (m *ChecklistElementMutation) OrderIndexCleared() bool {
+	_, ok := m.clearedFields[checklistelement.FieldOrderIndex]
+	return ok
+}
+
+// ResetOrderIndex resets all changes to the "order_index" field.
+func (m *ChecklistElementMutation) ResetOrderIndex() {
+	m.order_index = nil
+	m.addorder_index = nil
+	delete(m.clearedFields, checklistelement.FieldOrderIndex)
+}
+
+// SetPhotoRequired sets the "photo_required" field.
+func (m *ChecklistElementMutation) SetPhotoRequired(b bool) {
+	m.photo_required = &b
+}
+
+// PhotoRequired returns the value of the "photo_required" field in the mutation.
+func (m *ChecklistElementMutation) PhotoRequired() (r bool, exists bool) {
+	v := m.photo_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhotoRequired returns the old "photo_required" field's value of the ChecklistElement entity.
+// If the ChecklistElement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistElementMutation) OldPhotoRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhotoRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhotoRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhotoRequired: %w", err)
+	}
+	return oldValue.PhotoRequired, nil
+}
+
+// ResetPhotoRequired resets all changes to the "photo_required" field.
+func (m *ChecklistElementMutation) ResetPhotoRequired() {
+	m.photo_required = nil
+}
+
+// ClearChecklist clears the "checklist" edge to the Checklist entity.
+func (m *ChecklistElementMutation) ClearChecklist() {
+	m.clearedchecklist = true
+	m.clearedFields[checklistelement.FieldChecklistID] = struct{}{}
+}
+
+// ChecklistCleared reports if the "checklist" edge to the Checklist entity was cleared.
+func (m *ChecklistElementMutation) ChecklistCleared() bool {
+	return m.clearedchecklist
+}
+
+// ChecklistIDs returns the "checklist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChecklistID instead. It exists only for internal usage by the builders.
+func (m *ChecklistElementMutation) ChecklistIDs() (ids []int) {
+	if id := m.checklist; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChecklist resets all changes to the "checklist" edge.
+func (m *ChecklistElementMutation) ResetChecklist() {
+	m.checklist = nil
+	m.clearedchecklist = false
+}
+
+// SetElementCatalogID sets the "element_catalog" edge to the ElementCatalog entity by id.
+func (m *ChecklistElementMutation) SetElementCatalogID(id int) {
+	m.element_catalog = &id
+}
+
+// ClearElementCatalog clears the "element_catalog" edge to the ElementCatalog entity.
+func (m *ChecklistElementMutation) ClearElementCatalog() {
+	m.clearedelement_catalog = true
+	m.clearedFields[checklistelement.FieldElementID] = struct{}{}
+}
+
+// ElementCatalogCleared reports if the "element_catalog" edge to the ElementCatalog entity was cleared.
+func (m *ChecklistElementMutation) ElementCatalogCleared() bool {
+	return m.clearedelement_catalog
+}
+
+// ElementCatalogID returns the "element_catalog" edge ID in the mutation.
+func (m *ChecklistElementMutation) ElementCatalogID() (id int, exists bool) {
+	if m.element_catalog != nil {
+		return *m.element_catalog, true
+	}
+	return
+}
+
+// ElementCatalogIDs returns the "element_catalog" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ElementCatalogID instead. It exists only for internal usage by the builders.
+func (m *ChecklistElementMutation) ElementCatalogIDs() (ids []int) {
+	if id := m.element_catalog; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetElementCatalog resets all changes to the "element_catalog" edge.
+func (m *ChecklistElementMutation) ResetElementCatalog() {
+	m.element_catalog = nil
+	m.clearedelement_catalog = false
+}
+
+// AddInspectionResultIDs adds the "inspection_results" edge to the InspectionResult entity by ids.
+func (m *ChecklistElementMutation) AddInspectionResultIDs(ids ...int) {
+	if m.inspection_results == nil {
+		m.inspection_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.inspection_results[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInspectionResults clears the "inspection_results" edge to the InspectionResult entity.
+func (m *ChecklistElementMutation) ClearInspectionResults() {
+	m.clearedinspection_results = true
+}
+
+// InspectionResultsCleared reports if the "inspection_results" edge to the InspectionResult entity was cleared.
+func (m *ChecklistElementMutation) InspectionResultsCleared() bool {
+	return m.clearedinspection_results
+}
+
+// RemoveInspectionResultIDs removes the "inspection_results" edge to the InspectionResult entity by IDs.
+func (m *ChecklistElementMutation) RemoveInspectionResultIDs(ids ...int) {
+	if m.removedinspection_results == nil {
+		m.removedinspection_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.inspection_results, ids[i])
+		m.removedinspection_results[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInspectionResults returns the removed IDs of the "inspection_results" edge to the InspectionResult entity.
+func (m *ChecklistElementMutation) RemovedInspectionResultsIDs() (ids []int) {
+	for id := range m.removedinspection_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InspectionResultsIDs returns the "inspection_results" edge IDs in the mutation.
+func (m *ChecklistElementMutation) InspectionResultsIDs() (ids []int) {
+	for id := range m.inspection_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInspectionResults resets all changes to the "inspection_results" edge.
+func (m *ChecklistElementMutation) ResetInspectionResults() {
+	m.inspection_results = nil
+	m.clearedinspection_results = false
+	m.removedinspection_results = nil
+}
+
+// Where appends a list predicates to the ChecklistElementMutation builder.
+func (m *ChecklistElementMutation) Where(ps ...predicate.ChecklistElement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChecklistElementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChecklistElementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChecklistElement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChecklistElementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChecklistElementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChecklistElement).
+func (m *ChecklistElementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChecklistElementMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.checklist != nil {
+		fields = append(fields, checklistelement.FieldChecklistID)
+	}
+	if m.element_catalog != nil {
+		fields = append(fields, checklistelement.FieldElementID)
+	}
+	if m.order_index != nil {
+		fields = append(fields, checklistelement.FieldOrderIndex)
+	}
+	if m.photo_required != nil {
+		fields = append(fields, checklistelement.FieldPhotoRequired)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChecklistElementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checklistelement.FieldChecklistID:
+		return m.ChecklistID()
+	case checklistelement.FieldElementID:
+		return m.ElementID()
+	case checklistelement.FieldOrderIndex:
+		return m.OrderIndex()
+	case checklistelement.FieldPhotoRequired:
+		return m.PhotoRequired()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChecklistElementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checklistelement.FieldChecklistID:
+		return m.OldChecklistID(ctx)
+	case checklistelement.FieldElementID:
+		return m.OldElementID(ctx)
+	case checklistelement.FieldOrderIndex:
+		return m.OldOrderIndex(ctx)
+	case checklistelement.FieldPhotoRequired:
+		return m.OldPhotoRequired(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChecklistElement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChecklistElementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checklistelement.FieldChecklistID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecklistID(v)
+		return nil
+	case checklistelement.FieldElementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetElementID(v)
+		return nil
+	case checklistelement.FieldOrderIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderIndex(v)
+		return nil
+	case checklistelement.FieldPhotoRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhotoRequired(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistElement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChecklistElementMutation) AddedFields() []string {
+	var fields []string
+	if m.addorder_index != nil {
+		fields = append(fields, checklistelement.FieldOrderIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChecklistElementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case checklistelement.FieldOrderIndex:
+		return m.AddedOrderIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChecklistElementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case checklistelement.FieldOrderIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrderIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistElement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChecklistElementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(checklistelement.FieldOrderIndex) {
+		fields = append(fields, checklistelement.FieldOrderIndex)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChecklistElementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChecklistElementMutation) ClearField(name string) error {
+	switch name {
+	case checklistelement.FieldOrderIndex:
+		m.ClearOrderIndex()
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistElement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChecklistElementMutation) ResetField(name string) error {
+	switch name {
+	case checklistelement.FieldChecklistID:
+		m.ResetChecklistID()
+		return nil
+	case checklistelement.FieldElementID:
+		m.ResetElementID()
+		return nil
+	case checklistelement.FieldOrderIndex:
+		m.ResetOrderIndex()
+		return nil
+	case checklistelement.FieldPhotoRequired:
+		m.ResetPhotoRequired()
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistElement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChecklistElementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.checklist != nil {
+		edges = append(edges, checklistelement.EdgeChecklist)
+	}
+	if m.element_catalog != nil {
+		edges = append(edges, checklistelement.EdgeElementCatalog)
+	}
+	if m.inspection_results != nil {
+		edges = append(edges, checklistelement.EdgeInspectionResults)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChecklistElementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case checklistelement.EdgeChecklist:
+		if id := m.checklist; id != nil {
+			return []ent.Value{*id}
+		}
+	case checklistelement.EdgeElementCatalog:
+		if id := m.element_catalog; id != nil {
+			return []ent.Value{*id}
+		}
+	case checklistelement.EdgeInspectionResults:
+		ids := make([]ent.Value, 0, len(m.inspection_results))
+		for id := range m.inspection_results {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChecklistElementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedinspection_results != nil {
+		edges = append(edges, checklistelement.EdgeInspectionResults)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChecklistElementMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case checklistelement.EdgeInspectionResults:
+		ids := make([]ent.Value, 0, len(m.removedinspection_results))
+		for id := range m.removedinspection_results {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChecklistElementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedchecklist {
+		edges = append(edges, checklistelement.EdgeChecklist)
+	}
+	if m.clearedelement_catalog {
+		edges = append(edges, checklistelement.EdgeElementCatalog)
+	}
+	if m.clearedinspection_results {
+		edges = append(edges, checklistelement.EdgeInspectionResults)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChecklistElementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case checklistelement.EdgeChecklist:
+		return m.clearedchecklist
+	case checklistelement.EdgeElementCatalog:
+		return m.clearedelement_catalog
+	case checklistelement.EdgeInspectionResults:
+		return m.clearedinspection_results
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChecklistElementMutation) ClearEdge(name string) error {
+	switch name {
+	case checklistelement.EdgeChecklist:
+		m.ClearChecklist()
+		return nil
+	case checklistelement.EdgeElementCatalog:
+		m.ClearElementCatalog()
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistElement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChecklistElementMutation) ResetEdge(name string) error {
+	switch name {
+	case checklistelement.EdgeChecklist:
+		m.ResetChecklist()
+		return nil
+	case checklistelement.EdgeElementCatalog:
+		m.ResetElementCatalog()
+		return nil
+	case checklistelement.EdgeInspectionResults:
+		m.ResetInspectionResults()
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistElement edge %s", name)
+}
+
+// DistrictMutation represents an operation that mutates the District nodes in the graph.
+type DistrictMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	description      *string
+	clearedFields    map[string]struct{}
+	jkh_units        map[int]struct{}
+	removedjkh_units map[int]struct{}
+	clearedjkh_units bool
+	buildings        map[int]struct{}
+	removedbuildings map[int]struct{}
+	clearedbuildings bool
+	done             bool
+	oldValue         func(context.Context) (*District, error)
+	predicates       []predicate.District
+}
+
+var _ ent.Mutation = (*DistrictMutation)(nil)
+
+// districtOption allows management of the mutation configuration using functional options.
+type districtOption func(*DistrictMutation)
+
+// newDistrictMutation creates new mutation for the District entity.
+func newDistrictMutation(c config, op Op, opts ...districtOption) *DistrictMutation {
+	m := &DistrictMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDistrict,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDistrictID sets the ID field of the mutation.
+func withDistrictID(id int) districtOption {
+	return func(m *DistrictMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *District
+		)
+		m.oldValue = func(ctx context.Context) (*District, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().District.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDistrict sets the old District of the mutation.
+func withDistrict(node *District) districtOption {
+	return func(m *DistrictMutation) {
+		m.oldValue = func(context.Context) (*District, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DistrictMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DistrictMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DistrictMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DistrictMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().District.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DistrictMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DistrictMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the District entity.
+// If the District object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistrictMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DistrictMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *DistrictMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DistrictMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the District entity.
+// If the District object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DistrictMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DistrictMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[district.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DistrictMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[district.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DistrictMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, district.FieldDescription)
+}
+
+// AddJkhUnitIDs adds the "jkh_units" edge to the JkhUnit entity by ids.
+func (m *DistrictMutation) AddJkhUnitIDs(ids ...int) {
+	if m.jkh_units == nil {
+		m.jkh_units = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.jkh_units[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJkhUnits clears the "jkh_units" edge to the JkhUnit entity.
+func (m *DistrictMutation) ClearJkhUnits() {
+	m.clearedjkh_units = true
+}
+
+// JkhUnitsCleared reports if the "jkh_units" edge to the JkhUnit entity was cleared.
+func (m *DistrictMutation) JkhUnitsCleared() bool {
+	return m.clearedjkh_units
+}
+
+// RemoveJkhUnitIDs removes the "jkh_units" edge to the JkhUnit entity by IDs.
+func (m *DistrictMutation) RemoveJkhUnitIDs(ids ...int) {
+	if m.removedjkh_units == nil {
+		m.removedjkh_units = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.jkh_units, ids[i])
+		m.removedjkh_units[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJkhUnits returns the removed IDs of the "jkh_units" edge to the JkhUnit entity.
+func (m *DistrictMutation) RemovedJkhUnitsIDs() (ids []int) {
+	for id := range m.removedjkh_units {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JkhUnitsIDs returns the "jkh_units" edge IDs in the mutation.
+func (m *DistrictMutation) JkhUnitsIDs() (ids []int) {
+	for id := range m.jkh_units {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJkhUnits resets all changes to the "jkh_units" edge.
+func (m *DistrictMutation) ResetJkhUnits() {
+	m.jkh_units = nil
+	m.clearedjkh_units = false
+	m.removedjkh_units = nil
+}
+
+// AddBuildingIDs adds the "buildings" edge to the Building entity by ids.
+func (m *DistrictMutation) AddBuildingIDs(ids ...int) {
+	if m.buildings == nil {
+		m.buildings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.buildings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBuildings clears the "buildings" edge to the Building entity.
+func (m *DistrictMutation) ClearBuildings() {
+	m.clearedbuildings = true
+}
+
+// BuildingsCleared reports if the "buildings" edge to the Building entity was cleared.
+func (m *DistrictMutation) BuildingsCleared() bool {
+	return m.clearedbuildings
+}
+
+// RemoveBuildingIDs removes the "buildings" edge to the Building entity by IDs.
+func (m *DistrictMutation) RemoveBuildingIDs(ids ...int) {
+	if m.removedbuildings == nil {
+		m.removedbuildings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.buildings, ids[i])
+		m.removedbuildings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuildings returns the removed IDs of the "buildings" edge to the Building entity.
+func (m *DistrictMutation) RemovedBuildingsIDs() (ids []int) {
+	for id := range m.removedbuildings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BuildingsIDs returns the "buildings" edge IDs in the mutation.
+func (m *DistrictMutation) BuildingsIDs() (ids []int) {
+	for id := range m.buildings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBuildings resets all changes to the "buildings" edge.
+func (m *DistrictMutation) ResetBuildings() {
+	m.buildings = nil
+	m.clearedbuildings = false
+	m.removedbuildings = nil
+}
+
+// Where appends a list predicates to the DistrictMutation builder.
+func (m *DistrictMutation) Where(ps ...predicate.District) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DistrictMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DistrictMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.District, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DistrictMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DistrictMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (District).
+func (m *DistrictMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DistrictMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, district.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, district.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DistrictMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case building.FieldDistrictID:
-		return m.OldDistrictID(ctx)
-	case building.FieldJkhUnitID:
-		return m.OldJkhUnitID(ctx)
-	case building.FieldInspectorID:
-		return m.OldInspectorID(ctx)
-	case building.FieldAddress:
-		return m.OldAddress(ctx)
-	case building.FieldConstructionYear:
-		return m.OldConstructionYear(ctx)
-	case building.FieldDescription:
+	case district.FieldName:
+		return m.Name()
+	case district.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DistrictMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case district.FieldName:
+		return m.OldName(ctx)
+	case district.FieldDescription:
 		return m.OldDescription(ctx)
-	case building.FieldPhoto:
-		return m.OldPhoto(ctx)
 	}
-	return nil, fmt.Errorf("unknown Building field %s", name)
+	return nil, fmt.Errorf("unknown District field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BuildingMutation) SetField(name string, value ent.Value) error {
+func (m *DistrictMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case building.FieldDistrictID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDistrictID(v)
-		return nil
-	case building.FieldJkhUnitID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJkhUnitID(v)
-		return nil
-	case building.FieldInspectorID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInspectorID(v)
-		return nil
-	case building.FieldAddress:
+	case district.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAddress(v)
-		return nil
-	case building.FieldConstructionYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConstructionYear(v)
+		m.SetName(v)
 		return nil
-	case building.FieldDescription:
+	case district.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case building.FieldPhoto:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPhoto(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Building field %s", name)
+	return fmt.Errorf("unknown District field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BuildingMutation) AddedFields() []string {
-	var fields []string
-	if m.addconstruction_year != nil {
-		fields = append(fields, building.FieldConstructionYear)
-	}
-	return fields
+func (m *DistrictMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BuildingMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case building.FieldConstructionYear:
-		return m.AddedConstructionYear()
-	}
+func (m *DistrictMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BuildingMutation) AddField(name string, value ent.Value) error {
+func (m *DistrictMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case building.FieldConstructionYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddConstructionYear(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Building numeric field %s", name)
+	return fmt.Errorf("unknown District numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BuildingMutation) ClearedFields() []string {
+func (m *DistrictMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(building.FieldInspectorID) {
-		fields = append(fields, building.FieldInspectorID)
-	}
-	if m.FieldCleared(building.FieldConstructionYear) {
-		fields = append(fields, building.FieldConstructionYear)
-	}
-	if m.FieldCleared(building.FieldDescription) {
-		fields = append(fields, building.FieldDescription)
-	}
-	if m.FieldCleared(building.FieldPhoto) {
-		fields = append(fields, building.FieldPhoto)
+	if m.FieldCleared(district.FieldDescription) {
+		fields = append(fields, district.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BuildingMutation) FieldCleared(name string) bool {
+func (m *DistrictMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BuildingMutation) ClearField(name string) error {
+func (m *DistrictMutation) ClearField(name string) error {
 	switch name {
-	case building.FieldInspectorID:
-		m.ClearInspectorID()
-		return nil
-	case building.FieldConstructionYear:
-		m.ClearConstructionYear()
-		return nil
-	case building.FieldDescription:
+	case district.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case building.FieldPhoto:
-		m.ClearPhoto()
-		return nil
 	}
-	return fmt.Errorf("unknown Building nullable field %s", name)
+	return fmt.Errorf("unknown District nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BuildingMutation) ResetField(name string) error {
+func (m *DistrictMutation) ResetField(name string) error {
 	switch name {
-	case building.FieldDistrictID:
-		m.ResetDistrictID()
-		return nil
-	case building.FieldJkhUnitID:
-		m.ResetJkhUnitID()
-		return nil
-	case building.FieldInspectorID:
-		m.ResetInspectorID()
-		return nil
-	case building.FieldAddress:
-		m.ResetAddress()
-		return nil
-	case building.FieldConstructionYear:
-		m.ResetConstructionYear()
+	case district.FieldName:
+		m.ResetName()
 		return nil
-	case building.FieldDescription:
+	case district.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case building.FieldPhoto:
-		m.ResetPhoto()
-		return nil
 	}
-	return fmt.Errorf("unknown Building field %s", name)
+	return fmt.Errorf("unknown District field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BuildingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.jkh_unit != nil {
-		edges = append(edges, building.EdgeJkhUnit)
-	}
-	if m.district != nil {
-		edges = append(edges, building.EdgeDistrict)
-	}
-	if m.inspector != nil {
-		edges = append(edges, building.EdgeInspector)
+func (m *DistrictMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.jkh_units != nil {
+		edges = append(edges, district.EdgeJkhUnits)
 	}
-	if m.tasks != nil {
-		edges = append(edges, building.EdgeTasks)
+	if m.buildings != nil {
+		edges = append(edges, district.EdgeBuildings)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BuildingMutation) AddedIDs(name string) []ent.Value {
+func (m *DistrictMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case building.EdgeJkhUnit:
-		if id := m.jkh_unit; id != nil {
-			return []ent.Value{*id}
-		}
-	case building.EdgeDistrict:
-		if id := m.district; id != nil {
-			return []ent.Value{*id}
-		}
-	case building.EdgeInspector:
-		if id := m.inspector; id != nil {
-			return []ent.Value{*id}
+	case district.EdgeJkhUnits:
+		ids := make([]ent.Value, 0, len(m.jkh_units))
+		for id := range m.jkh_units {
+			ids = append(ids, id)
 		}
-	case building.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.tasks))
-		for id := range m.tasks {
+		return ids
+	case district.EdgeBuildings:
+		ids := make([]ent.Value, 0, len(m.buildings))
+		for id := range m.buildings {
 			ids = append(ids, id)
 		}
 		return ids
@@ -953,21 +3983,30 @@ func (m *BuildingMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BuildingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedtasks != nil {
-		edges = append(edges, building.EdgeTasks)
+func (m *DistrictMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedjkh_units != nil {
+		edges = append(edges, district.EdgeJkhUnits)
+	}
+	if m.removedbuildings != nil {
+		edges = append(edges, district.EdgeBuildings)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BuildingMutation) RemovedIDs(name string) []ent.Value {
+func (m *DistrictMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case building.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.removedtasks))
-		for id := range m.removedtasks {
+	case district.EdgeJkhUnits:
+		ids := make([]ent.Value, 0, len(m.removedjkh_units))
+		for id := range m.removedjkh_units {
+			ids = append(ids, id)
+		}
+		return ids
+	case district.EdgeBuildings:
+		ids := make([]ent.Value, 0, len(m.removedbuildings))
+		for id := range m.removedbuildings {
 			ids = append(ids, id)
 		}
 		return ids
@@ -976,109 +4015,80 @@ func (m *BuildingMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BuildingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedjkh_unit {
-		edges = append(edges, building.EdgeJkhUnit)
-	}
-	if m.cleareddistrict {
-		edges = append(edges, building.EdgeDistrict)
-	}
-	if m.clearedinspector {
-		edges = append(edges, building.EdgeInspector)
+func (m *DistrictMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedjkh_units {
+		edges = append(edges, district.EdgeJkhUnits)
 	}
-	if m.clearedtasks {
-		edges = append(edges, building.EdgeTasks)
+	if m.clearedbuildings {
+		edges = append(edges, district.EdgeBuildings)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BuildingMutation) EdgeCleared(name string) bool {
+func (m *DistrictMutation) EdgeCleared(name string) bool {
 	switch name {
-	case building.EdgeJkhUnit:
-		return m.clearedjkh_unit
-	case building.EdgeDistrict:
-		return m.cleareddistrict
-	case building.EdgeInspector:
-		return m.clearedinspector
-	case building.EdgeTasks:
-		return m.clearedtasks
+	case district.EdgeJkhUnits:
+		return m.clearedjkh_units
+	case district.EdgeBuildings:
+		return m.clearedbuildings
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BuildingMutation) ClearEdge(name string) error {
+func (m *DistrictMutation) ClearEdge(name string) error {
 	switch name {
-	case building.EdgeJkhUnit:
-		m.ClearJkhUnit()
-		return nil
-	case building.EdgeDistrict:
-		m.ClearDistrict()
-		return nil
-	case building.EdgeInspector:
-		m.ClearInspector()
-		return nil
 	}
-	return fmt.Errorf("unknown Building unique edge %s", name)
+	return fmt.Errorf("unknown District unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BuildingMutation) ResetEdge(name string) error {
+func (m *DistrictMutation) ResetEdge(name string) error {
 	switch name {
-	case building.EdgeJkhUnit:
-		m.ResetJkhUnit()
-		return nil
-	case building.EdgeDistrict:
-		m.ResetDistrict()
-		return nil
-	case building.EdgeInspector:
-		m.ResetInspector()
+	case district.EdgeJkhUnits:
+		m.ResetJkhUnits()
 		return nil
-	case building.EdgeTasks:
-		m.ResetTasks()
+	case district.EdgeBuildings:
+		m.ResetBuildings()
 		return nil
 	}
-	return fmt.Errorf("unknown Building edge %s", name)
+	return fmt.Errorf("unknown District edge %s", name)
 }
 
-// ChecklistMutation represents an operation that mutates the Checklist nodes in the graph.
-type ChecklistMutation struct {
+// ElementCatalogMutation represents an operation that mutates the ElementCatalog nodes in the graph.
+type ElementCatalogMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	title           *string
-	inspection_type *checklist.InspectionType
-	description     *string
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	elements        map[int]struct{}
-	removedelements map[int]struct{}
-	clearedelements bool
-	tasks           map[int]struct{}
-	removedtasks    map[int]struct{}
-	clearedtasks    bool
-	done            bool
-	oldValue        func(context.Context) (*Checklist, error)
-	predicates      []predicate.Checklist
+	op                        Op
+	typ                       string
+	id                        *int
+	name                      *string
+	name_en                   *string
+	category                  *string
+	clearedFields             map[string]struct{}
+	checklist_elements        map[int]struct{}
+	removedchecklist_elements map[int]struct{}
+	clearedchecklist_elements bool
+	done                      bool
+	oldValue                  func(context.Context) (*ElementCatalog, error)
+	predicates                []predicate.ElementCatalog
 }
 
-var _ ent.Mutation = (*ChecklistMutation)(nil)
+var _ ent.Mutation = (*ElementCatalogMutation)(nil)
 
-// checklistOption allows management of the mutation configuration using functional options.
-type checklistOption func(*ChecklistMutation)
+// elementcatalogOption allows management of the mutation configuration using functional options.
+type elementcatalogOption func(*ElementCatalogMutation)
 
-// newChecklistMutation creates new mutation for the Checklist entity.
-func newChecklistMutation(c config, op Op, opts ...checklistOption) *ChecklistMutation {
-	m := &ChecklistMutation{
+// newElementCatalogMutation creates new mutation for the ElementCatalog entity.
+func newElementCatalogMutation(c config, op Op, opts ...elementcatalogOption) *ElementCatalogMutation {
+	m := &ElementCatalogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChecklist,
+		typ:           TypeElementCatalog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1087,20 +4097,20 @@ func newChecklistMutation(c config, op Op, opts ...checklistOption) *ChecklistMu
 	return m
 }
 
-// withChecklistID sets the ID field of the mutation.
-func withChecklistID(id int) checklistOption {
-	return func(m *ChecklistMutation) {
+// withElementCatalogID sets the ID field of the mutation.
+func withElementCatalogID(id int) elementcatalogOption {
+	return func(m *ElementCatalogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Checklist
+			value *ElementCatalog
 		)
-		m.oldValue = func(ctx context.Context) (*Checklist, error) {
+		m.oldValue = func(ctx context.Context) (*ElementCatalog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Checklist.Get(ctx, id)
+					value, err = m.Client().ElementCatalog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1109,10 +4119,10 @@ func withChecklistID(id int) checklistOption {
 	}
 }
 
-// withChecklist sets the old Checklist of the mutation.
-func withChecklist(node *Checklist) checklistOption {
-	return func(m *ChecklistMutation) {
-		m.oldValue = func(context.Context) (*Checklist, error) {
+// withElementCatalog sets the old ElementCatalog of the mutation.
+func withElementCatalog(node *ElementCatalog) elementcatalogOption {
+	return func(m *ElementCatalogMutation) {
+		m.oldValue = func(context.Context) (*ElementCatalog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1121,7 +4131,7 @@ func withChecklist(node *Checklist) checklistOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChecklistMutation) Client() *Client {
+func (m ElementCatalogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1129,7 +4139,7 @@ func (m ChecklistMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChecklistMutation) Tx() (*Tx, error) {
+func (m ElementCatalogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1140,7 +4150,7 @@ func (m ChecklistMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChecklistMutation) ID() (id int, exists bool) {
+func (m *ElementCatalogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1151,7 +4161,7 @@ func (m *ChecklistMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChecklistMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ElementCatalogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1160,286 +4170,209 @@ func (m *ChecklistMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Checklist.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ElementCatalog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *ChecklistMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *ChecklistMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Checklist entity.
-// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChecklistMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *ChecklistMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetInspectionType sets the "inspection_type" field.
-func (m *ChecklistMutation) SetInspectionType(ct checklist.InspectionType) {
-	m.inspection_type = &ct
+// SetName sets the "name" field.
+func (m *ElementCatalogMutation) SetName(s string) {
+	m.name = &s
 }
 
-// InspectionType returns the value of the "inspection_type" field in the mutation.
-func (m *ChecklistMutation) InspectionType() (r checklist.InspectionType, exists bool) {
-	v := m.inspection_type
+// Name returns the value of the "name" field in the mutation.
+func (m *ElementCatalogMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInspectionType returns the old "inspection_type" field's value of the Checklist entity.
-// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ElementCatalog entity.
+// If the ElementCatalog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChecklistMutation) OldInspectionType(ctx context.Context) (v checklist.InspectionType, err error) {
+func (m *ElementCatalogMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInspectionType is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInspectionType requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInspectionType: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.InspectionType, nil
+	return oldValue.Name, nil
 }
 
-// ResetInspectionType resets all changes to the "inspection_type" field.
-func (m *ChecklistMutation) ResetInspectionType() {
-	m.inspection_type = nil
+// ResetName resets all changes to the "name" field.
+func (m *ElementCatalogMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ChecklistMutation) SetDescription(s string) {
-	m.description = &s
+// SetNameEn sets the "name_en" field.
+func (m *ElementCatalogMutation) SetNameEn(s string) {
+	m.name_en = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ChecklistMutation) Description() (r string, exists bool) {
-	v := m.description
+// NameEn returns the value of the "name_en" field in the mutation.
+func (m *ElementCatalogMutation) NameEn() (r string, exists bool) {
+	v := m.name_en
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Checklist entity.
-// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
+// OldNameEn returns the old "name_en" field's value of the ElementCatalog entity.
+// If the ElementCatalog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChecklistMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ElementCatalogMutation) OldNameEn(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldNameEn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldNameEn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldNameEn: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.NameEn, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ChecklistMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[checklist.FieldDescription] = struct{}{}
+// ClearNameEn clears the value of the "name_en" field.
+func (m *ElementCatalogMutation) ClearNameEn() {
+	m.name_en = nil
+	m.clearedFields[elementcatalog.FieldNameEn] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ChecklistMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[checklist.FieldDescription]
+// NameEnCleared returns if the "name_en" field was cleared in this mutation.
+func (m *ElementCatalogMutation) NameEnCleared() bool {
+	_, ok := m.clearedFields[elementcatalog.FieldNameEn]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ChecklistMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, checklist.FieldDescription)
+// ResetNameEn resets all changes to the "name_en" field.
+func (m *ElementCatalogMutation) ResetNameEn() {
+	m.name_en = nil
+	delete(m.clearedFields, elementcatalog.FieldNameEn)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ChecklistMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCategory sets the "category" field.
+func (m *ElementCatalogMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChecklistMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Category returns the value of the "category" field in the mutation.
+func (m *ElementCatalogMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Checklist entity.
-// If the Checklist object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the ElementCatalog entity.
+// If the ElementCatalog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChecklistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ElementCatalogMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChecklistMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// AddElementIDs adds the "elements" edge to the ChecklistElement entity by ids.
-func (m *ChecklistMutation) AddElementIDs(ids ...int) {
-	if m.elements == nil {
-		m.elements = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.elements[ids[i]] = struct{}{}
-	}
-}
-
-// ClearElements clears the "elements" edge to the ChecklistElement entity.
-func (m *ChecklistMutation) ClearElements() {
-	m.clearedelements = true
-}
-
-// ElementsCleared reports if the "elements" edge to the ChecklistElement entity was cleared.
-func (m *ChecklistMutation) ElementsCleared() bool {
-	return m.clearedelements
-}
-
-// RemoveElementIDs removes the "elements" edge to the ChecklistElement entity by IDs.
-func (m *ChecklistMutation) RemoveElementIDs(ids ...int) {
-	if m.removedelements == nil {
-		m.removedelements = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.elements, ids[i])
-		m.removedelements[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
+	return oldValue.Category, nil
 }
 
-// RemovedElements returns the removed IDs of the "elements" edge to the ChecklistElement entity.
-func (m *ChecklistMutation) RemovedElementsIDs() (ids []int) {
-	for id := range m.removedelements {
-		ids = append(ids, id)
-	}
-	return
+// ClearCategory clears the value of the "category" field.
+func (m *ElementCatalogMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[elementcatalog.FieldCategory] = struct{}{}
 }
 
-// ElementsIDs returns the "elements" edge IDs in the mutation.
-func (m *ChecklistMutation) ElementsIDs() (ids []int) {
-	for id := range m.elements {
-		ids = append(ids, id)
-	}
-	return
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *ElementCatalogMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[elementcatalog.FieldCategory]
+	return ok
 }
 
-// ResetElements resets all changes to the "elements" edge.
-func (m *ChecklistMutation) ResetElements() {
-	m.elements = nil
-	m.clearedelements = false
-	m.removedelements = nil
+// ResetCategory resets all changes to the "category" field.
+func (m *ElementCatalogMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, elementcatalog.FieldCategory)
 }
 
-// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
-func (m *ChecklistMutation) AddTaskIDs(ids ...int) {
-	if m.tasks == nil {
-		m.tasks = make(map[int]struct{})
+// AddChecklistElementIDs adds the "checklist_elements" edge to the ChecklistElement entity by ids.
+func (m *ElementCatalogMutation) AddChecklistElementIDs(ids ...int) {
+	if m.checklist_elements == nil {
+		m.checklist_elements = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.tasks[ids[i]] = struct{}{}
+		m.checklist_elements[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTasks clears the "tasks" edge to the Task entity.
-func (m *ChecklistMutation) ClearTasks() {
-	m.clearedtasks = true
+// ClearChecklistElements clears the "checklist_elements" edge to the ChecklistElement entity.
+func (m *ElementCatalogMutation) ClearChecklistElements() {
+	m.clearedchecklist_elements = true
 }
 
-// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
-func (m *ChecklistMutation) TasksCleared() bool {
-	return m.clearedtasks
+// ChecklistElementsCleared reports if the "checklist_elements" edge to the ChecklistElement entity was cleared.
+func (m *ElementCatalogMutation) ChecklistElementsCleared() bool {
+	return m.clearedchecklist_elements
 }
 
-// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
-func (m *ChecklistMutation) RemoveTaskIDs(ids ...int) {
-	if m.removedtasks == nil {
-		m.removedtasks = make(map[int]struct{})
+// RemoveChecklistElementIDs removes the "checklist_elements" edge to the ChecklistElement entity by IDs.
+func (m *ElementCatalogMutation) RemoveChecklistElementIDs(ids ...int) {
+	if m.removedchecklist_elements == nil {
+		m.removedchecklist_elements = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.tasks, ids[i])
-		m.removedtasks[ids[i]] = struct{}{}
+		delete(m.checklist_elements, ids[i])
+		m.removedchecklist_elements[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
-func (m *ChecklistMutation) RemovedTasksIDs() (ids []int) {
-	for id := range m.removedtasks {
+// RemovedChecklistElements returns the removed IDs of the "checklist_elements" edge to the ChecklistElement entity.
+func (m *ElementCatalogMutation) RemovedChecklistElementsIDs() (ids []int) {
+	for id := range m.removedchecklist_elements {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TasksIDs returns the "tasks" edge IDs in the mutation.
-func (m *ChecklistMutation) TasksIDs() (ids []int) {
-	for id := range m.tasks {
+// ChecklistElementsIDs returns the "checklist_elements" edge IDs in the mutation.
+func (m *ElementCatalogMutation) ChecklistElementsIDs() (ids []int) {
+	for id := range m.checklist_elements {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTasks resets all changes to the "tasks" edge.
-func (m *ChecklistMutation) ResetTasks() {
-	m.tasks = nil
-	m.clearedtasks = false
-	m.removedtasks = nil
+// ResetChecklistElements resets all changes to the "checklist_elements" edge.
+func (m *ElementCatalogMutation) ResetChecklistElements() {
+	m.checklist_elements = nil
+	m.clearedchecklist_elements = false
+	m.removedchecklist_elements = nil
 }
 
-// Where appends a list predicates to the ChecklistMutation builder.
-func (m *ChecklistMutation) Where(ps ...predicate.Checklist) {
+// Where appends a list predicates to the ElementCatalogMutation builder.
+func (m *ElementCatalogMutation) Where(ps ...predicate.ElementCatalog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChecklistMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ElementCatalogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChecklistMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Checklist, len(ps))
+func (m *ElementCatalogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ElementCatalog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1447,36 +4380,33 @@ func (m *ChecklistMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChecklistMutation) Op() Op {
+func (m *ElementCatalogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChecklistMutation) SetOp(op Op) {
+func (m *ElementCatalogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Checklist).
-func (m *ChecklistMutation) Type() string {
+// Type returns the node type of this mutation (ElementCatalog).
+func (m *ElementCatalogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChecklistMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.title != nil {
-		fields = append(fields, checklist.FieldTitle)
-	}
-	if m.inspection_type != nil {
-		fields = append(fields, checklist.FieldInspectionType)
+func (m *ElementCatalogMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, elementcatalog.FieldName)
 	}
-	if m.description != nil {
-		fields = append(fields, checklist.FieldDescription)
+	if m.name_en != nil {
+		fields = append(fields, elementcatalog.FieldNameEn)
 	}
-	if m.created_at != nil {
-		fields = append(fields, checklist.FieldCreatedAt)
+	if m.category != nil {
+		fields = append(fields, elementcatalog.FieldCategory)
 	}
 	return fields
 }
@@ -1484,16 +4414,14 @@ func (m *ChecklistMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChecklistMutation) Field(name string) (ent.Value, bool) {
+func (m *ElementCatalogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case checklist.FieldTitle:
-		return m.Title()
-	case checklist.FieldInspectionType:
-		return m.InspectionType()
-	case checklist.FieldDescription:
-		return m.Description()
-	case checklist.FieldCreatedAt:
-		return m.CreatedAt()
+	case elementcatalog.FieldName:
+		return m.Name()
+	case elementcatalog.FieldNameEn:
+		return m.NameEn()
+	case elementcatalog.FieldCategory:
+		return m.Category()
 	}
 	return nil, false
 }
@@ -1501,152 +4429,137 @@ func (m *ChecklistMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChecklistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ElementCatalogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case checklist.FieldTitle:
-		return m.OldTitle(ctx)
-	case checklist.FieldInspectionType:
-		return m.OldInspectionType(ctx)
-	case checklist.FieldDescription:
-		return m.OldDescription(ctx)
-	case checklist.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case elementcatalog.FieldName:
+		return m.OldName(ctx)
+	case elementcatalog.FieldNameEn:
+		return m.OldNameEn(ctx)
+	case elementcatalog.FieldCategory:
+		return m.OldCategory(ctx)
 	}
-	return nil, fmt.Errorf("unknown Checklist field %s", name)
+	return nil, fmt.Errorf("unknown ElementCatalog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChecklistMutation) SetField(name string, value ent.Value) error {
+func (m *ElementCatalogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case checklist.FieldTitle:
+	case elementcatalog.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
-		return nil
-	case checklist.FieldInspectionType:
-		v, ok := value.(checklist.InspectionType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInspectionType(v)
+		m.SetName(v)
 		return nil
-	case checklist.FieldDescription:
+	case elementcatalog.FieldNameEn:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetNameEn(v)
 		return nil
-	case checklist.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case elementcatalog.FieldCategory:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCategory(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Checklist field %s", name)
+	return fmt.Errorf("unknown ElementCatalog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChecklistMutation) AddedFields() []string {
+func (m *ElementCatalogMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChecklistMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ElementCatalogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChecklistMutation) AddField(name string, value ent.Value) error {
+func (m *ElementCatalogMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Checklist numeric field %s", name)
+	return fmt.Errorf("unknown ElementCatalog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChecklistMutation) ClearedFields() []string {
+func (m *ElementCatalogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(checklist.FieldDescription) {
-		fields = append(fields, checklist.FieldDescription)
+	if m.FieldCleared(elementcatalog.FieldNameEn) {
+		fields = append(fields, elementcatalog.FieldNameEn)
+	}
+	if m.FieldCleared(elementcatalog.FieldCategory) {
+		fields = append(fields, elementcatalog.FieldCategory)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChecklistMutation) FieldCleared(name string) bool {
+func (m *ElementCatalogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChecklistMutation) ClearField(name string) error {
+func (m *ElementCatalogMutation) ClearField(name string) error {
 	switch name {
-	case checklist.FieldDescription:
-		m.ClearDescription()
+	case elementcatalog.FieldNameEn:
+		m.ClearNameEn()
+		return nil
+	case elementcatalog.FieldCategory:
+		m.ClearCategory()
 		return nil
 	}
-	return fmt.Errorf("unknown Checklist nullable field %s", name)
+	return fmt.Errorf("unknown ElementCatalog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChecklistMutation) ResetField(name string) error {
+func (m *ElementCatalogMutation) ResetField(name string) error {
 	switch name {
-	case checklist.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case checklist.FieldInspectionType:
-		m.ResetInspectionType()
+	case elementcatalog.FieldName:
+		m.ResetName()
 		return nil
-	case checklist.FieldDescription:
-		m.ResetDescription()
+	case elementcatalog.FieldNameEn:
+		m.ResetNameEn()
 		return nil
-	case checklist.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case elementcatalog.FieldCategory:
+		m.ResetCategory()
 		return nil
 	}
-	return fmt.Errorf("unknown Checklist field %s", name)
+	return fmt.Errorf("unknown ElementCatalog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChecklistMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.elements != nil {
-		edges = append(edges, checklist.EdgeElements)
-	}
-	if m.tasks != nil {
-		edges = append(edges, checklist.EdgeTasks)
+func (m *ElementCatalogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.checklist_elements != nil {
+		edges = append(edges, elementcatalog.EdgeChecklistElements)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChecklistMutation) AddedIDs(name string) []ent.Value {
+func (m *ElementCatalogMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case checklist.EdgeElements:
-		ids := make([]ent.Value, 0, len(m.elements))
-		for id := range m.elements {
-			ids = append(ids, id)
-		}
-		return ids
-	case checklist.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.tasks))
-		for id := range m.tasks {
+	case elementcatalog.EdgeChecklistElements:
+		ids := make([]ent.Value, 0, len(m.checklist_elements))
+		for id := range m.checklist_elements {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1655,30 +4568,21 @@ func (m *ChecklistMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChecklistMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedelements != nil {
-		edges = append(edges, checklist.EdgeElements)
-	}
-	if m.removedtasks != nil {
-		edges = append(edges, checklist.EdgeTasks)
+func (m *ElementCatalogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedchecklist_elements != nil {
+		edges = append(edges, elementcatalog.EdgeChecklistElements)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChecklistMutation) RemovedIDs(name string) []ent.Value {
+func (m *ElementCatalogMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case checklist.EdgeElements:
-		ids := make([]ent.Value, 0, len(m.removedelements))
-		for id := range m.removedelements {
-			ids = append(ids, id)
-		}
-		return ids
-	case checklist.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.removedtasks))
-		for id := range m.removedtasks {
+	case elementcatalog.EdgeChecklistElements:
+		ids := make([]ent.Value, 0, len(m.removedchecklist_elements))
+		for id := range m.removedchecklist_elements {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1687,83 +4591,77 @@ func (m *ChecklistMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChecklistMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedelements {
-		edges = append(edges, checklist.EdgeElements)
-	}
-	if m.clearedtasks {
-		edges = append(edges, checklist.EdgeTasks)
+func (m *ElementCatalogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedchecklist_elements {
+		edges = append(edges, elementcatalog.EdgeChecklistElements)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChecklistMutation) EdgeCleared(name string) bool {
+func (m *ElementCatalogMutation) EdgeCleared(name string) bool {
 	switch name {
-	case checklist.EdgeElements:
-		return m.clearedelements
-	case checklist.EdgeTasks:
-		return m.clearedtasks
+	case elementcatalog.EdgeChecklistElements:
+		return m.clearedchecklist_elements
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChecklistMutation) ClearEdge(name string) error {
+func (m *ElementCatalogMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Checklist unique edge %s", name)
+	return fmt.Errorf("unknown ElementCatalog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChecklistMutation) ResetEdge(name string) error {
+func (m *ElementCatalogMutation) ResetEdge(name string) error {
 	switch name {
-	case checklist.EdgeElements:
-		m.ResetElements()
-		return nil
-	case checklist.EdgeTasks:
-		m.ResetTasks()
+	case elementcatalog.EdgeChecklistElements:
+		m.ResetChecklistElements()
 		return nil
 	}
-	return fmt.Errorf("unknown Checklist edge %s", name)
+	return fmt.Errorf("unknown ElementCatalog edge %s", name)
 }
 
-// ChecklistElementMutation represents an operation that mutates the ChecklistElement nodes in the graph.
-type ChecklistElementMutation struct {
+// InspectionActMutation represents an operation that mutates the InspectionAct nodes in the graph.
+type InspectionActMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *int
-	order_index               *int
-	addorder_index            *int
-	clearedFields             map[string]struct{}
-	checklist                 *int
-	clearedchecklist          bool
-	element_catalog           *int
-	clearedelement_catalog    bool
-	inspection_results        map[int]struct{}
-	removedinspection_results map[int]struct{}
-	clearedinspection_results bool
-	done                      bool
-	oldValue                  func(context.Context) (*ChecklistElement, error)
-	predicates                []predicate.ChecklistElement
+	op               Op
+	typ              string
+	id               *int
+	created_at       *time.Time
+	approved_at      *time.Time
+	status           *string
+	act_number       *string
+	conclusion       *string
+	approval_comment *string
+	document_path    *string
+	language         *inspectionact.Language
+	include_unfilled *bool
+	clearedFields    map[string]struct{}
+	task             *int
+	clearedtask      bool
+	done             bool
+	oldValue         func(context.Context) (*InspectionAct, error)
+	predicates       []predicate.InspectionAct
 }
 
-var _ ent.Mutation = (*ChecklistElementMutation)(nil)
+var _ ent.Mutation = (*InspectionActMutation)(nil)
 
-// checklistelementOption allows management of the mutation configuration using functional options.
-type checklistelementOption func(*ChecklistElementMutation)
+// inspectionactOption allows management of the mutation configuration using functional options.
+type inspectionactOption func(*InspectionActMutation)
 
-// newChecklistElementMutation creates new mutation for the ChecklistElement entity.
-func newChecklistElementMutation(c config, op Op, opts ...checklistelementOption) *ChecklistElementMutation {
-	m := &ChecklistElementMutation{
+// newInspectionActMutation creates new mutation for the InspectionAct entity.
+func newInspectionActMutation(c config, op Op, opts ...inspectionactOption) *InspectionActMutation {
+	m := &InspectionActMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChecklistElement,
+		typ:           TypeInspectionAct,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1771,21 +4669,21 @@ func newChecklistElementMutation(c config, op Op, opts ...checklistelementOption
 	}
 	return m
 }
-
-// withChecklistElementID sets the ID field of the mutation.
-func withChecklistElementID(id int) checklistelementOption {
-	return func(m *ChecklistElementMutation) {
+
+// withInspectionActID sets the ID field of the mutation.
+func withInspectionActID(id int) inspectionactOption {
+	return func(m *InspectionActMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChecklistElement
+			value *InspectionAct
 		)
-		m.oldValue = func(ctx context.Context) (*ChecklistElement, error) {
+		m.oldValue = func(ctx context.Context) (*InspectionAct, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChecklistElement.Get(ctx, id)
+					value, err = m.Client().InspectionAct.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1794,10 +4692,10 @@ func withChecklistElementID(id int) checklistelementOption {
 	}
 }
 
-// withChecklistElement sets the old ChecklistElement of the mutation.
-func withChecklistElement(node *ChecklistElement) checklistelementOption {
-	return func(m *ChecklistElementMutation) {
-		m.oldValue = func(context.Context) (*ChecklistElement, error) {
+// withInspectionAct sets the old InspectionAct of the mutation.
+func withInspectionAct(node *InspectionAct) inspectionactOption {
+	return func(m *InspectionActMutation) {
+		m.oldValue = func(context.Context) (*InspectionAct, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1806,7 +4704,7 @@ func withChecklistElement(node *ChecklistElement) checklistelementOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChecklistElementMutation) Client() *Client {
+func (m InspectionActMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1814,7 +4712,7 @@ func (m ChecklistElementMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChecklistElementMutation) Tx() (*Tx, error) {
+func (m InspectionActMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1825,7 +4723,7 @@ func (m ChecklistElementMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChecklistElementMutation) ID() (id int, exists bool) {
+func (m *InspectionActMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1836,7 +4734,7 @@ func (m *ChecklistElementMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChecklistElementMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *InspectionActMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1845,284 +4743,473 @@ func (m *ChecklistElementMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChecklistElement.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().InspectionAct.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChecklistID sets the "checklist_id" field.
-func (m *ChecklistElementMutation) SetChecklistID(i int) {
-	m.checklist = &i
+// SetTaskID sets the "task_id" field.
+func (m *InspectionActMutation) SetTaskID(i int) {
+	m.task = &i
 }
 
-// ChecklistID returns the value of the "checklist_id" field in the mutation.
-func (m *ChecklistElementMutation) ChecklistID() (r int, exists bool) {
-	v := m.checklist
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *InspectionActMutation) TaskID() (r int, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChecklistID returns the old "checklist_id" field's value of the ChecklistElement entity.
-// If the ChecklistElement object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChecklistElementMutation) OldChecklistID(ctx context.Context) (v int, err error) {
+func (m *InspectionActMutation) OldTaskID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecklistID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecklistID requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecklistID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.ChecklistID, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetChecklistID resets all changes to the "checklist_id" field.
-func (m *ChecklistElementMutation) ResetChecklistID() {
-	m.checklist = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *InspectionActMutation) ResetTaskID() {
+	m.task = nil
 }
 
-// SetElementID sets the "element_id" field.
-func (m *ChecklistElementMutation) SetElementID(i int) {
-	m.element_catalog = &i
+// SetCreatedAt sets the "created_at" field.
+func (m *InspectionActMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ElementID returns the value of the "element_id" field in the mutation.
-func (m *ChecklistElementMutation) ElementID() (r int, exists bool) {
-	v := m.element_catalog
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InspectionActMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldElementID returns the old "element_id" field's value of the ChecklistElement entity.
-// If the ChecklistElement object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChecklistElementMutation) OldElementID(ctx context.Context) (v int, err error) {
+func (m *InspectionActMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldElementID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldElementID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldElementID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ElementID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetElementID resets all changes to the "element_id" field.
-func (m *ChecklistElementMutation) ResetElementID() {
-	m.element_catalog = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InspectionActMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetOrderIndex sets the "order_index" field.
-func (m *ChecklistElementMutation) SetOrderIndex(i int) {
-	m.order_index = &i
-	m.addorder_index = nil
+// SetApprovedAt sets the "approved_at" field.
+func (m *InspectionActMutation) SetApprovedAt(t time.Time) {
+	m.approved_at = &t
+}
+
+// ApprovedAt returns the value of the "approved_at" field in the mutation.
+func (m *InspectionActMutation) ApprovedAt() (r time.Time, exists bool) {
+	v := m.approved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedAt returns the old "approved_at" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionActMutation) OldApprovedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedAt: %w", err)
+	}
+	return oldValue.ApprovedAt, nil
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (m *InspectionActMutation) ClearApprovedAt() {
+	m.approved_at = nil
+	m.clearedFields[inspectionact.FieldApprovedAt] = struct{}{}
+}
+
+// ApprovedAtCleared returns if the "approved_at" field was cleared in this mutation.
+func (m *InspectionActMutation) ApprovedAtCleared() bool {
+	_, ok := m.clearedFields[inspectionact.FieldApprovedAt]
+	return ok
+}
+
+// ResetApprovedAt resets all changes to the "approved_at" field.
+func (m *InspectionActMutation) ResetApprovedAt() {
+	m.approved_at = nil
+	delete(m.clearedFields, inspectionact.FieldApprovedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *InspectionActMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *InspectionActMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionActMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *InspectionActMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetActNumber sets the "act_number" field.
+func (m *InspectionActMutation) SetActNumber(s string) {
+	m.act_number = &s
+}
+
+// ActNumber returns the value of the "act_number" field in the mutation.
+func (m *InspectionActMutation) ActNumber() (r string, exists bool) {
+	v := m.act_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActNumber returns the old "act_number" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionActMutation) OldActNumber(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActNumber: %w", err)
+	}
+	return oldValue.ActNumber, nil
+}
+
+// ClearActNumber clears the value of the "act_number" field.
+func (m *InspectionActMutation) ClearActNumber() {
+	m.act_number = nil
+	m.clearedFields[inspectionact.FieldActNumber] = struct{}{}
+}
+
+// ActNumberCleared returns if the "act_number" field was cleared in this mutation.
+func (m *InspectionActMutation) ActNumberCleared() bool {
+	_, ok := m.clearedFields[inspectionact.FieldActNumber]
+	return ok
+}
+
+// ResetActNumber resets all changes to the "act_number" field.
+func (m *InspectionActMutation) ResetActNumber() {
+	m.act_number = nil
+	delete(m.clearedFields, inspectionact.FieldActNumber)
+}
+
+// SetConclusion sets the "conclusion" field.
+func (m *InspectionActMutation) SetConclusion(s string) {
+	m.conclusion = &s
+}
+
+// Conclusion returns the value of the "conclusion" field in the mutation.
+func (m *InspectionActMutation) Conclusion() (r string, exists bool) {
+	v := m.conclusion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConclusion returns the old "conclusion" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionActMutation) OldConclusion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConclusion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConclusion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConclusion: %w", err)
+	}
+	return oldValue.Conclusion, nil
+}
+
+// ClearConclusion clears the value of the "conclusion" field.
+func (m *InspectionActMutation) ClearConclusion() {
+	m.conclusion = nil
+	m.clearedFields[inspectionact.FieldConclusion] = struct{}{}
+}
+
+// ConclusionCleared returns if the "conclusion" field was cleared in this mutation.
+func (m *InspectionActMutation) ConclusionCleared() bool {
+	_, ok := m.clearedFields[inspectionact.FieldConclusion]
+	return ok
+}
+
+// ResetConclusion resets all changes to the "conclusion" field.
+func (m *InspectionActMutation) ResetConclusion() {
+	m.conclusion = nil
+	delete(m.clearedFields, inspectionact.FieldConclusion)
+}
+
+// SetApprovalComment sets the "approval_comment" field.
+func (m *InspectionActMutation) SetApprovalComment(s string) {
+	m.approval_comment = &s
 }
 
-// OrderIndex returns the value of the "order_index" field in the mutation.
-func (m *ChecklistElementMutation) OrderIndex() (r int, exists bool) {
-	v := m.order_index
+// ApprovalComment returns the value of the "approval_comment" field in the mutation.
+func (m *InspectionActMutation) ApprovalComment() (r string, exists bool) {
+	v := m.approval_comment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrderIndex returns the old "order_index" field's value of the ChecklistElement entity.
-// If the ChecklistElement object wasn't provided to the builder, the object is fetched from the database.
+// OldApprovalComment returns the old "approval_comment" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChecklistElementMutation) OldOrderIndex(ctx context.Context) (v int, err error) {
+func (m *InspectionActMutation) OldApprovalComment(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldApprovalComment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderIndex requires an ID field in the mutation")
+		return v, errors.New("OldApprovalComment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderIndex: %w", err)
-	}
-	return oldValue.OrderIndex, nil
-}
-
-// AddOrderIndex adds i to the "order_index" field.
-func (m *ChecklistElementMutation) AddOrderIndex(i int) {
-	if m.addorder_index != nil {
-		*m.addorder_index += i
-	} else {
-		m.addorder_index = &i
-	}
-}
-
-// AddedOrderIndex returns the value that was added to the "order_index" field in this mutation.
-func (m *ChecklistElementMutation) AddedOrderIndex() (r int, exists bool) {
-	v := m.addorder_index
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldApprovalComment: %w", err)
 	}
-	return *v, true
+	return oldValue.ApprovalComment, nil
 }
 
-// ClearOrderIndex clears the value of the "order_index" field.
-func (m *ChecklistElementMutation) ClearOrderIndex() {
-	m.order_index = nil
-	m.addorder_index = nil
-	m.clearedFields[checklistelement.FieldOrderIndex] = struct{}{}
+// ClearApprovalComment clears the value of the "approval_comment" field.
+func (m *InspectionActMutation) ClearApprovalComment() {
+	m.approval_comment = nil
+	m.clearedFields[inspectionact.FieldApprovalComment] = struct{}{}
 }
 
-// OrderIndexCleared returns if the "order_index" field was cleared in this mutation.
-func (m *ChecklistElementMutation) OrderIndexCleared() bool {
-	_, ok := m.clearedFields[checklistelement.FieldOrderIndex]
+// ApprovalCommentCleared returns if the "approval_comment" field was cleared in this mutation.
+func (m *InspectionActMutation) ApprovalCommentCleared() bool {
+	_, ok := m.clearedFields[inspectionact.FieldApprovalComment]
 	return ok
 }
 
-// ResetOrderIndex resets all changes to the "order_index" field.
-func (m *ChecklistElementMutation) ResetOrderIndex() {
-	m.order_index = nil
-	m.addorder_index = nil
-	delete(m.clearedFields, checklistelement.FieldOrderIndex)
+// ResetApprovalComment resets all changes to the "approval_comment" field.
+func (m *InspectionActMutation) ResetApprovalComment() {
+	m.approval_comment = nil
+	delete(m.clearedFields, inspectionact.FieldApprovalComment)
 }
 
-// ClearChecklist clears the "checklist" edge to the Checklist entity.
-func (m *ChecklistElementMutation) ClearChecklist() {
-	m.clearedchecklist = true
-	m.clearedFields[checklistelement.FieldChecklistID] = struct{}{}
+// SetDocumentPath sets the "document_path" field.
+func (m *InspectionActMutation) SetDocumentPath(s string) {
+	m.document_path = &s
 }
 
-// ChecklistCleared reports if the "checklist" edge to the Checklist entity was cleared.
-func (m *ChecklistElementMutation) ChecklistCleared() bool {
-	return m.clearedchecklist
+// DocumentPath returns the value of the "document_path" field in the mutation.
+func (m *InspectionActMutation) DocumentPath() (r string, exists bool) {
+	v := m.document_path
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ChecklistIDs returns the "checklist" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChecklistID instead. It exists only for internal usage by the builders.
-func (m *ChecklistElementMutation) ChecklistIDs() (ids []int) {
-	if id := m.checklist; id != nil {
-		ids = append(ids, *id)
+// OldDocumentPath returns the old "document_path" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionActMutation) OldDocumentPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentPath is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentPath: %w", err)
+	}
+	return oldValue.DocumentPath, nil
 }
 
-// ResetChecklist resets all changes to the "checklist" edge.
-func (m *ChecklistElementMutation) ResetChecklist() {
-	m.checklist = nil
-	m.clearedchecklist = false
+// ClearDocumentPath clears the value of the "document_path" field.
+func (m *InspectionActMutation) ClearDocumentPath() {
+	m.document_path = nil
+	m.clearedFields[inspectionact.FieldDocumentPath] = struct{}{}
 }
 
-// SetElementCatalogID sets the "element_catalog" edge to the ElementCatalog entity by id.
-func (m *ChecklistElementMutation) SetElementCatalogID(id int) {
-	m.element_catalog = &id
+// DocumentPathCleared returns if the "document_path" field was cleared in this mutation.
+func (m *InspectionActMutation) DocumentPathCleared() bool {
+	_, ok := m.clearedFields[inspectionact.FieldDocumentPath]
+	return ok
 }
 
-// ClearElementCatalog clears the "element_catalog" edge to the ElementCatalog entity.
-func (m *ChecklistElementMutation) ClearElementCatalog() {
-	m.clearedelement_catalog = true
-	m.clearedFields[checklistelement.FieldElementID] = struct{}{}
+// ResetDocumentPath resets all changes to the "document_path" field.
+func (m *InspectionActMutation) ResetDocumentPath() {
+	m.document_path = nil
+	delete(m.clearedFields, inspectionact.FieldDocumentPath)
 }
 
-// ElementCatalogCleared reports if the "element_catalog" edge to the ElementCatalog entity was cleared.
-func (m *ChecklistElementMutation) ElementCatalogCleared() bool {
-	return m.clearedelement_catalog
+// SetLanguage sets the "language" field.
+func (m *InspectionActMutation) SetLanguage(i inspectionact.Language) {
+	m.language = &i
 }
 
-// ElementCatalogID returns the "element_catalog" edge ID in the mutation.
-func (m *ChecklistElementMutation) ElementCatalogID() (id int, exists bool) {
-	if m.element_catalog != nil {
-		return *m.element_catalog, true
+// Language returns the value of the "language" field in the mutation.
+func (m *InspectionActMutation) Language() (r inspectionact.Language, exists bool) {
+	v := m.language
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ElementCatalogIDs returns the "element_catalog" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ElementCatalogID instead. It exists only for internal usage by the builders.
-func (m *ChecklistElementMutation) ElementCatalogIDs() (ids []int) {
-	if id := m.element_catalog; id != nil {
-		ids = append(ids, *id)
+// OldLanguage returns the old "language" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionActMutation) OldLanguage(ctx context.Context) (v inspectionact.Language, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
 }
 
-// ResetElementCatalog resets all changes to the "element_catalog" edge.
-func (m *ChecklistElementMutation) ResetElementCatalog() {
-	m.element_catalog = nil
-	m.clearedelement_catalog = false
+// ResetLanguage resets all changes to the "language" field.
+func (m *InspectionActMutation) ResetLanguage() {
+	m.language = nil
 }
 
-// AddInspectionResultIDs adds the "inspection_results" edge to the InspectionResult entity by ids.
-func (m *ChecklistElementMutation) AddInspectionResultIDs(ids ...int) {
-	if m.inspection_results == nil {
-		m.inspection_results = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.inspection_results[ids[i]] = struct{}{}
+// SetIncludeUnfilled sets the "include_unfilled" field.
+func (m *InspectionActMutation) SetIncludeUnfilled(b bool) {
+	m.include_unfilled = &b
+}
+
+// IncludeUnfilled returns the value of the "include_unfilled" field in the mutation.
+func (m *InspectionActMutation) IncludeUnfilled() (r bool, exists bool) {
+	v := m.include_unfilled
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearInspectionResults clears the "inspection_results" edge to the InspectionResult entity.
-func (m *ChecklistElementMutation) ClearInspectionResults() {
-	m.clearedinspection_results = true
+// OldIncludeUnfilled returns the old "include_unfilled" field's value of the InspectionAct entity.
+// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionActMutation) OldIncludeUnfilled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncludeUnfilled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncludeUnfilled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncludeUnfilled: %w", err)
+	}
+	return oldValue.IncludeUnfilled, nil
 }
 
-// InspectionResultsCleared reports if the "inspection_results" edge to the InspectionResult entity was cleared.
-func (m *ChecklistElementMutation) InspectionResultsCleared() bool {
-	return m.clearedinspection_results
+// ResetIncludeUnfilled resets all changes to the "include_unfilled" field.
+func (m *InspectionActMutation) ResetIncludeUnfilled() {
+	m.include_unfilled = nil
 }
 
-// RemoveInspectionResultIDs removes the "inspection_results" edge to the InspectionResult entity by IDs.
-func (m *ChecklistElementMutation) RemoveInspectionResultIDs(ids ...int) {
-	if m.removedinspection_results == nil {
-		m.removedinspection_results = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.inspection_results, ids[i])
-		m.removedinspection_results[ids[i]] = struct{}{}
-	}
+// ClearTask clears the "task" edge to the Task entity.
+func (m *InspectionActMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[inspectionact.FieldTaskID] = struct{}{}
 }
 
-// RemovedInspectionResults returns the removed IDs of the "inspection_results" edge to the InspectionResult entity.
-func (m *ChecklistElementMutation) RemovedInspectionResultsIDs() (ids []int) {
-	for id := range m.removedinspection_results {
-		ids = append(ids, id)
-	}
-	return
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *InspectionActMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
-// InspectionResultsIDs returns the "inspection_results" edge IDs in the mutation.
-func (m *ChecklistElementMutation) InspectionResultsIDs() (ids []int) {
-	for id := range m.inspection_results {
-		ids = append(ids, id)
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *InspectionActMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetInspectionResults resets all changes to the "inspection_results" edge.
-func (m *ChecklistElementMutation) ResetInspectionResults() {
-	m.inspection_results = nil
-	m.clearedinspection_results = false
-	m.removedinspection_results = nil
+// ResetTask resets all changes to the "task" edge.
+func (m *InspectionActMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// Where appends a list predicates to the ChecklistElementMutation builder.
-func (m *ChecklistElementMutation) Where(ps ...predicate.ChecklistElement) {
+// Where appends a list predicates to the InspectionActMutation builder.
+func (m *InspectionActMutation) Where(ps ...predicate.InspectionAct) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChecklistElementMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InspectionActMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChecklistElementMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChecklistElement, len(ps))
+func (m *InspectionActMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InspectionAct, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2130,33 +5217,54 @@ func (m *ChecklistElementMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChecklistElementMutation) Op() Op {
+func (m *InspectionActMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChecklistElementMutation) SetOp(op Op) {
+func (m *InspectionActMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChecklistElement).
-func (m *ChecklistElementMutation) Type() string {
+// Type returns the node type of this mutation (InspectionAct).
+func (m *InspectionActMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChecklistElementMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.checklist != nil {
-		fields = append(fields, checklistelement.FieldChecklistID)
+func (m *InspectionActMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.task != nil {
+		fields = append(fields, inspectionact.FieldTaskID)
 	}
-	if m.element_catalog != nil {
-		fields = append(fields, checklistelement.FieldElementID)
+	if m.created_at != nil {
+		fields = append(fields, inspectionact.FieldCreatedAt)
 	}
-	if m.order_index != nil {
-		fields = append(fields, checklistelement.FieldOrderIndex)
+	if m.approved_at != nil {
+		fields = append(fields, inspectionact.FieldApprovedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, inspectionact.FieldStatus)
+	}
+	if m.act_number != nil {
+		fields = append(fields, inspectionact.FieldActNumber)
+	}
+	if m.conclusion != nil {
+		fields = append(fields, inspectionact.FieldConclusion)
+	}
+	if m.approval_comment != nil {
+		fields = append(fields, inspectionact.FieldApprovalComment)
+	}
+	if m.document_path != nil {
+		fields = append(fields, inspectionact.FieldDocumentPath)
+	}
+	if m.language != nil {
+		fields = append(fields, inspectionact.FieldLanguage)
+	}
+	if m.include_unfilled != nil {
+		fields = append(fields, inspectionact.FieldIncludeUnfilled)
 	}
 	return fields
 }
@@ -2164,14 +5272,28 @@ func (m *ChecklistElementMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChecklistElementMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case checklistelement.FieldChecklistID:
-		return m.ChecklistID()
-	case checklistelement.FieldElementID:
-		return m.ElementID()
-	case checklistelement.FieldOrderIndex:
-		return m.OrderIndex()
+func (m *InspectionActMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case inspectionact.FieldTaskID:
+		return m.TaskID()
+	case inspectionact.FieldCreatedAt:
+		return m.CreatedAt()
+	case inspectionact.FieldApprovedAt:
+		return m.ApprovedAt()
+	case inspectionact.FieldStatus:
+		return m.Status()
+	case inspectionact.FieldActNumber:
+		return m.ActNumber()
+	case inspectionact.FieldConclusion:
+		return m.Conclusion()
+	case inspectionact.FieldApprovalComment:
+		return m.ApprovalComment()
+	case inspectionact.FieldDocumentPath:
+		return m.DocumentPath()
+	case inspectionact.FieldLanguage:
+		return m.Language()
+	case inspectionact.FieldIncludeUnfilled:
+		return m.IncludeUnfilled()
 	}
 	return nil, false
 }
@@ -2179,65 +5301,123 @@ func (m *ChecklistElementMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChecklistElementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InspectionActMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case checklistelement.FieldChecklistID:
-		return m.OldChecklistID(ctx)
-	case checklistelement.FieldElementID:
-		return m.OldElementID(ctx)
-	case checklistelement.FieldOrderIndex:
-		return m.OldOrderIndex(ctx)
+	case inspectionact.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case inspectionact.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case inspectionact.FieldApprovedAt:
+		return m.OldApprovedAt(ctx)
+	case inspectionact.FieldStatus:
+		return m.OldStatus(ctx)
+	case inspectionact.FieldActNumber:
+		return m.OldActNumber(ctx)
+	case inspectionact.FieldConclusion:
+		return m.OldConclusion(ctx)
+	case inspectionact.FieldApprovalComment:
+		return m.OldApprovalComment(ctx)
+	case inspectionact.FieldDocumentPath:
+		return m.OldDocumentPath(ctx)
+	case inspectionact.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case inspectionact.FieldIncludeUnfilled:
+		return m.OldIncludeUnfilled(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChecklistElement field %s", name)
+	return nil, fmt.Errorf("unknown InspectionAct field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChecklistElementMutation) SetField(name string, value ent.Value) error {
+func (m *InspectionActMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case checklistelement.FieldChecklistID:
+	case inspectionact.FieldTaskID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecklistID(v)
+		m.SetTaskID(v)
 		return nil
-	case checklistelement.FieldElementID:
-		v, ok := value.(int)
+	case inspectionact.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetElementID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case checklistelement.FieldOrderIndex:
-		v, ok := value.(int)
+	case inspectionact.FieldApprovedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrderIndex(v)
+		m.SetApprovedAt(v)
+		return nil
+	case inspectionact.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case inspectionact.FieldActNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActNumber(v)
+		return nil
+	case inspectionact.FieldConclusion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConclusion(v)
+		return nil
+	case inspectionact.FieldApprovalComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovalComment(v)
+		return nil
+	case inspectionact.FieldDocumentPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentPath(v)
+		return nil
+	case inspectionact.FieldLanguage:
+		v, ok := value.(inspectionact.Language)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case inspectionact.FieldIncludeUnfilled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncludeUnfilled(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChecklistElement field %s", name)
+	return fmt.Errorf("unknown InspectionAct field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChecklistElementMutation) AddedFields() []string {
+func (m *InspectionActMutation) AddedFields() []string {
 	var fields []string
-	if m.addorder_index != nil {
-		fields = append(fields, checklistelement.FieldOrderIndex)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChecklistElementMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InspectionActMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case checklistelement.FieldOrderIndex:
-		return m.AddedOrderIndex()
 	}
 	return nil, false
 }
@@ -2245,214 +5425,210 @@ func (m *ChecklistElementMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChecklistElementMutation) AddField(name string, value ent.Value) error {
+func (m *InspectionActMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case checklistelement.FieldOrderIndex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOrderIndex(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ChecklistElement numeric field %s", name)
+	return fmt.Errorf("unknown InspectionAct numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChecklistElementMutation) ClearedFields() []string {
+func (m *InspectionActMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(checklistelement.FieldOrderIndex) {
-		fields = append(fields, checklistelement.FieldOrderIndex)
+	if m.FieldCleared(inspectionact.FieldApprovedAt) {
+		fields = append(fields, inspectionact.FieldApprovedAt)
+	}
+	if m.FieldCleared(inspectionact.FieldActNumber) {
+		fields = append(fields, inspectionact.FieldActNumber)
+	}
+	if m.FieldCleared(inspectionact.FieldConclusion) {
+		fields = append(fields, inspectionact.FieldConclusion)
+	}
+	if m.FieldCleared(inspectionact.FieldApprovalComment) {
+		fields = append(fields, inspectionact.FieldApprovalComment)
+	}
+	if m.FieldCleared(inspectionact.FieldDocumentPath) {
+		fields = append(fields, inspectionact.FieldDocumentPath)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChecklistElementMutation) FieldCleared(name string) bool {
+func (m *InspectionActMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChecklistElementMutation) ClearField(name string) error {
+func (m *InspectionActMutation) ClearField(name string) error {
 	switch name {
-	case checklistelement.FieldOrderIndex:
-		m.ClearOrderIndex()
+	case inspectionact.FieldApprovedAt:
+		m.ClearApprovedAt()
+		return nil
+	case inspectionact.FieldActNumber:
+		m.ClearActNumber()
+		return nil
+	case inspectionact.FieldConclusion:
+		m.ClearConclusion()
+		return nil
+	case inspectionact.FieldApprovalComment:
+		m.ClearApprovalComment()
+		return nil
+	case inspectionact.FieldDocumentPath:
+		m.ClearDocumentPath()
 		return nil
 	}
-	return fmt.Errorf("unknown ChecklistElement nullable field %s", name)
+	return fmt.Errorf("unknown InspectionAct nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChecklistElementMutation) ResetField(name string) error {
+func (m *InspectionActMutation) ResetField(name string) error {
 	switch name {
-	case checklistelement.FieldChecklistID:
-		m.ResetChecklistID()
+	case inspectionact.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case checklistelement.FieldElementID:
-		m.ResetElementID()
+	case inspectionact.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case checklistelement.FieldOrderIndex:
-		m.ResetOrderIndex()
+	case inspectionact.FieldApprovedAt:
+		m.ResetApprovedAt()
+		return nil
+	case inspectionact.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case inspectionact.FieldActNumber:
+		m.ResetActNumber()
+		return nil
+	case inspectionact.FieldConclusion:
+		m.ResetConclusion()
+		return nil
+	case inspectionact.FieldApprovalComment:
+		m.ResetApprovalComment()
+		return nil
+	case inspectionact.FieldDocumentPath:
+		m.ResetDocumentPath()
+		return nil
+	case inspectionact.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case inspectionact.FieldIncludeUnfilled:
+		m.ResetIncludeUnfilled()
 		return nil
 	}
-	return fmt.Errorf("unknown ChecklistElement field %s", name)
+	return fmt.Errorf("unknown InspectionAct field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChecklistElementMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.checklist != nil {
-		edges = append(edges, checklistelement.EdgeChecklist)
-	}
-	if m.element_catalog != nil {
-		edges = append(edges, checklistelement.EdgeElementCatalog)
-	}
-	if m.inspection_results != nil {
-		edges = append(edges, checklistelement.EdgeInspectionResults)
+func (m *InspectionActMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, inspectionact.EdgeTask)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChecklistElementMutation) AddedIDs(name string) []ent.Value {
+func (m *InspectionActMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case checklistelement.EdgeChecklist:
-		if id := m.checklist; id != nil {
-			return []ent.Value{*id}
-		}
-	case checklistelement.EdgeElementCatalog:
-		if id := m.element_catalog; id != nil {
+	case inspectionact.EdgeTask:
+		if id := m.task; id != nil {
 			return []ent.Value{*id}
 		}
-	case checklistelement.EdgeInspectionResults:
-		ids := make([]ent.Value, 0, len(m.inspection_results))
-		for id := range m.inspection_results {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChecklistElementMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedinspection_results != nil {
-		edges = append(edges, checklistelement.EdgeInspectionResults)
-	}
+func (m *InspectionActMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChecklistElementMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case checklistelement.EdgeInspectionResults:
-		ids := make([]ent.Value, 0, len(m.removedinspection_results))
-		for id := range m.removedinspection_results {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *InspectionActMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChecklistElementMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedchecklist {
-		edges = append(edges, checklistelement.EdgeChecklist)
-	}
-	if m.clearedelement_catalog {
-		edges = append(edges, checklistelement.EdgeElementCatalog)
-	}
-	if m.clearedinspection_results {
-		edges = append(edges, checklistelement.EdgeInspectionResults)
+func (m *InspectionActMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, inspectionact.EdgeTask)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChecklistElementMutation) EdgeCleared(name string) bool {
+func (m *InspectionActMutation) EdgeCleared(name string) bool {
 	switch name {
-	case checklistelement.EdgeChecklist:
-		return m.clearedchecklist
-	case checklistelement.EdgeElementCatalog:
-		return m.clearedelement_catalog
-	case checklistelement.EdgeInspectionResults:
-		return m.clearedinspection_results
+	case inspectionact.EdgeTask:
+		return m.clearedtask
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChecklistElementMutation) ClearEdge(name string) error {
+func (m *InspectionActMutation) ClearEdge(name string) error {
 	switch name {
-	case checklistelement.EdgeChecklist:
-		m.ClearChecklist()
-		return nil
-	case checklistelement.EdgeElementCatalog:
-		m.ClearElementCatalog()
+	case inspectionact.EdgeTask:
+		m.ClearTask()
 		return nil
 	}
-	return fmt.Errorf("unknown ChecklistElement unique edge %s", name)
+	return fmt.Errorf("unknown InspectionAct unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChecklistElementMutation) ResetEdge(name string) error {
+func (m *InspectionActMutation) ResetEdge(name string) error {
 	switch name {
-	case checklistelement.EdgeChecklist:
-		m.ResetChecklist()
-		return nil
-	case checklistelement.EdgeElementCatalog:
-		m.ResetElementCatalog()
-		return nil
-	case checklistelement.EdgeInspectionResults:
-		m.ResetInspectionResults()
+	case inspectionact.EdgeTask:
+		m.ResetTask()
 		return nil
 	}
-	return fmt.Errorf("unknown ChecklistElement edge %s", name)
+	return fmt.Errorf("unknown InspectionAct edge %s", name)
 }
 
-// DistrictMutation represents an operation that mutates the District nodes in the graph.
-type DistrictMutation struct {
+// InspectionResultMutation represents an operation that mutates the InspectionResult nodes in the graph.
+type InspectionResultMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	name             *string
-	clearedFields    map[string]struct{}
-	jkh_units        map[int]struct{}
-	removedjkh_units map[int]struct{}
-	clearedjkh_units bool
-	buildings        map[int]struct{}
-	removedbuildings map[int]struct{}
-	clearedbuildings bool
-	done             bool
-	oldValue         func(context.Context) (*District, error)
-	predicates       []predicate.District
+	op                       Op
+	typ                      string
+	id                       *int
+	condition_status         *inspectionresult.ConditionStatus
+	comment                  *string
+	internal_note            *string
+	photo_paths              *[]string
+	appendphoto_paths        []string
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	task                     *int
+	clearedtask              bool
+	checklist_element        *int
+	clearedchecklist_element bool
+	done                     bool
+	oldValue                 func(context.Context) (*InspectionResult, error)
+	predicates               []predicate.InspectionResult
 }
 
-var _ ent.Mutation = (*DistrictMutation)(nil)
+var _ ent.Mutation = (*InspectionResultMutation)(nil)
 
-// districtOption allows management of the mutation configuration using functional options.
-type districtOption func(*DistrictMutation)
+// inspectionresultOption allows management of the mutation configuration using functional options.
+type inspectionresultOption func(*InspectionResultMutation)
 
-// newDistrictMutation creates new mutation for the District entity.
-func newDistrictMutation(c config, op Op, opts ...districtOption) *DistrictMutation {
-	m := &DistrictMutation{
+// newInspectionResultMutation creates new mutation for the InspectionResult entity.
+func newInspectionResultMutation(c config, op Op, opts ...inspectionresultOption) *InspectionResultMutation {
+	m := &InspectionResultMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDistrict,
+		typ:           TypeInspectionResult,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2461,20 +5637,20 @@ func newDistrictMutation(c config, op Op, opts ...districtOption) *DistrictMutat
 	return m
 }
 
-// withDistrictID sets the ID field of the mutation.
-func withDistrictID(id int) districtOption {
-	return func(m *DistrictMutation) {
+// withInspectionResultID sets the ID field of the mutation.
+func withInspectionResultID(id int) inspectionresultOption {
+	return func(m *InspectionResultMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *District
+			value *InspectionResult
 		)
-		m.oldValue = func(ctx context.Context) (*District, error) {
+		m.oldValue = func(ctx context.Context) (*InspectionResult, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().District.Get(ctx, id)
+					value, err = m.Client().InspectionResult.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2483,10 +5659,10 @@ func withDistrictID(id int) districtOption {
 	}
 }
 
-// withDistrict sets the old District of the mutation.
-func withDistrict(node *District) districtOption {
-	return func(m *DistrictMutation) {
-		m.oldValue = func(context.Context) (*District, error) {
+// withInspectionResult sets the old InspectionResult of the mutation.
+func withInspectionResult(node *InspectionResult) inspectionresultOption {
+	return func(m *InspectionResultMutation) {
+		m.oldValue = func(context.Context) (*InspectionResult, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2495,7 +5671,7 @@ func withDistrict(node *District) districtOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DistrictMutation) Client() *Client {
+func (m InspectionResultMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2503,7 +5679,7 @@ func (m DistrictMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DistrictMutation) Tx() (*Tx, error) {
+func (m InspectionResultMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2514,7 +5690,7 @@ func (m DistrictMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DistrictMutation) ID() (id int, exists bool) {
+func (m *InspectionResultMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2525,669 +5701,427 @@ func (m *DistrictMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DistrictMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *InspectionResultMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().District.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetName sets the "name" field.
-func (m *DistrictMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *DistrictMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the District entity.
-// If the District object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DistrictMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *DistrictMutation) ResetName() {
-	m.name = nil
-}
-
-// AddJkhUnitIDs adds the "jkh_units" edge to the JkhUnit entity by ids.
-func (m *DistrictMutation) AddJkhUnitIDs(ids ...int) {
-	if m.jkh_units == nil {
-		m.jkh_units = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.jkh_units[ids[i]] = struct{}{}
-	}
-}
-
-// ClearJkhUnits clears the "jkh_units" edge to the JkhUnit entity.
-func (m *DistrictMutation) ClearJkhUnits() {
-	m.clearedjkh_units = true
-}
-
-// JkhUnitsCleared reports if the "jkh_units" edge to the JkhUnit entity was cleared.
-func (m *DistrictMutation) JkhUnitsCleared() bool {
-	return m.clearedjkh_units
-}
-
-// RemoveJkhUnitIDs removes the "jkh_units" edge to the JkhUnit entity by IDs.
-func (m *DistrictMutation) RemoveJkhUnitIDs(ids ...int) {
-	if m.removedjkh_units == nil {
-		m.removedjkh_units = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.jkh_units, ids[i])
-		m.removedjkh_units[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedJkhUnits returns the removed IDs of the "jkh_units" edge to the JkhUnit entity.
-func (m *DistrictMutation) RemovedJkhUnitsIDs() (ids []int) {
-	for id := range m.removedjkh_units {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// JkhUnitsIDs returns the "jkh_units" edge IDs in the mutation.
-func (m *DistrictMutation) JkhUnitsIDs() (ids []int) {
-	for id := range m.jkh_units {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetJkhUnits resets all changes to the "jkh_units" edge.
-func (m *DistrictMutation) ResetJkhUnits() {
-	m.jkh_units = nil
-	m.clearedjkh_units = false
-	m.removedjkh_units = nil
-}
-
-// AddBuildingIDs adds the "buildings" edge to the Building entity by ids.
-func (m *DistrictMutation) AddBuildingIDs(ids ...int) {
-	if m.buildings == nil {
-		m.buildings = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.buildings[ids[i]] = struct{}{}
-	}
-}
-
-// ClearBuildings clears the "buildings" edge to the Building entity.
-func (m *DistrictMutation) ClearBuildings() {
-	m.clearedbuildings = true
-}
-
-// BuildingsCleared reports if the "buildings" edge to the Building entity was cleared.
-func (m *DistrictMutation) BuildingsCleared() bool {
-	return m.clearedbuildings
-}
-
-// RemoveBuildingIDs removes the "buildings" edge to the Building entity by IDs.
-func (m *DistrictMutation) RemoveBuildingIDs(ids ...int) {
-	if m.removedbuildings == nil {
-		m.removedbuildings = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.buildings, ids[i])
-		m.removedbuildings[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedBuildings returns the removed IDs of the "buildings" edge to the Building entity.
-func (m *DistrictMutation) RemovedBuildingsIDs() (ids []int) {
-	for id := range m.removedbuildings {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// BuildingsIDs returns the "buildings" edge IDs in the mutation.
-func (m *DistrictMutation) BuildingsIDs() (ids []int) {
-	for id := range m.buildings {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetBuildings resets all changes to the "buildings" edge.
-func (m *DistrictMutation) ResetBuildings() {
-	m.buildings = nil
-	m.clearedbuildings = false
-	m.removedbuildings = nil
-}
-
-// Where appends a list predicates to the DistrictMutation builder.
-func (m *DistrictMutation) Where(ps ...predicate.District) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DistrictMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DistrictMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.District, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InspectionResult.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *DistrictMutation) Op() Op {
-	return m.op
+// SetTaskID sets the "task_id" field.
+func (m *InspectionResultMutation) SetTaskID(i int) {
+	m.task = &i
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DistrictMutation) SetOp(op Op) {
-	m.op = op
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *InspectionResultMutation) TaskID() (r int, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Type returns the node type of this mutation (District).
-func (m *DistrictMutation) Type() string {
-	return m.typ
+// OldTaskID returns the old "task_id" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionResultMutation) OldTaskID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DistrictMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, district.FieldName)
-	}
-	return fields
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *InspectionResultMutation) ResetTaskID() {
+	m.task = nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *DistrictMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case district.FieldName:
-		return m.Name()
-	}
-	return nil, false
+// SetChecklistElementID sets the "checklist_element_id" field.
+func (m *InspectionResultMutation) SetChecklistElementID(i int) {
+	m.checklist_element = &i
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *DistrictMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case district.FieldName:
-		return m.OldName(ctx)
+// ChecklistElementID returns the value of the "checklist_element_id" field in the mutation.
+func (m *InspectionResultMutation) ChecklistElementID() (r int, exists bool) {
+	v := m.checklist_element
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown District field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DistrictMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case district.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
+// OldChecklistElementID returns the old "checklist_element_id" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionResultMutation) OldChecklistElementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecklistElementID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown District field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecklistElementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecklistElementID: %w", err)
+	}
+	return oldValue.ChecklistElementID, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DistrictMutation) AddedFields() []string {
-	return nil
+// ResetChecklistElementID resets all changes to the "checklist_element_id" field.
+func (m *InspectionResultMutation) ResetChecklistElementID() {
+	m.checklist_element = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DistrictMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetConditionStatus sets the "condition_status" field.
+func (m *InspectionResultMutation) SetConditionStatus(is inspectionresult.ConditionStatus) {
+	m.condition_status = &is
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DistrictMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ConditionStatus returns the value of the "condition_status" field in the mutation.
+func (m *InspectionResultMutation) ConditionStatus() (r inspectionresult.ConditionStatus, exists bool) {
+	v := m.condition_status
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown District numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *DistrictMutation) ClearedFields() []string {
-	return nil
+// OldConditionStatus returns the old "condition_status" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionResultMutation) OldConditionStatus(ctx context.Context) (v inspectionresult.ConditionStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConditionStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConditionStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConditionStatus: %w", err)
+	}
+	return oldValue.ConditionStatus, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DistrictMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetConditionStatus resets all changes to the "condition_status" field.
+func (m *InspectionResultMutation) ResetConditionStatus() {
+	m.condition_status = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DistrictMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown District nullable field %s", name)
+// SetComment sets the "comment" field.
+func (m *InspectionResultMutation) SetComment(s string) {
+	m.comment = &s
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DistrictMutation) ResetField(name string) error {
-	switch name {
-	case district.FieldName:
-		m.ResetName()
-		return nil
+// Comment returns the value of the "comment" field in the mutation.
+func (m *InspectionResultMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown District field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DistrictMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.jkh_units != nil {
-		edges = append(edges, district.EdgeJkhUnits)
+// OldComment returns the old "comment" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionResultMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
 	}
-	if m.buildings != nil {
-		edges = append(edges, district.EdgeBuildings)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *DistrictMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case district.EdgeJkhUnits:
-		ids := make([]ent.Value, 0, len(m.jkh_units))
-		for id := range m.jkh_units {
-			ids = append(ids, id)
-		}
-		return ids
-	case district.EdgeBuildings:
-		ids := make([]ent.Value, 0, len(m.buildings))
-		for id := range m.buildings {
-			ids = append(ids, id)
-		}
-		return ids
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
 	}
-	return nil
+	return oldValue.Comment, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DistrictMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedjkh_units != nil {
-		edges = append(edges, district.EdgeJkhUnits)
-	}
-	if m.removedbuildings != nil {
-		edges = append(edges, district.EdgeBuildings)
-	}
-	return edges
+// ClearComment clears the value of the "comment" field.
+func (m *InspectionResultMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[inspectionresult.FieldComment] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DistrictMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case district.EdgeJkhUnits:
-		ids := make([]ent.Value, 0, len(m.removedjkh_units))
-		for id := range m.removedjkh_units {
-			ids = append(ids, id)
-		}
-		return ids
-	case district.EdgeBuildings:
-		ids := make([]ent.Value, 0, len(m.removedbuildings))
-		for id := range m.removedbuildings {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *InspectionResultMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[inspectionresult.FieldComment]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DistrictMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedjkh_units {
-		edges = append(edges, district.EdgeJkhUnits)
-	}
-	if m.clearedbuildings {
-		edges = append(edges, district.EdgeBuildings)
-	}
-	return edges
+// ResetComment resets all changes to the "comment" field.
+func (m *InspectionResultMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, inspectionresult.FieldComment)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *DistrictMutation) EdgeCleared(name string) bool {
-	switch name {
-	case district.EdgeJkhUnits:
-		return m.clearedjkh_units
-	case district.EdgeBuildings:
-		return m.clearedbuildings
-	}
-	return false
+// SetInternalNote sets the "internal_note" field.
+func (m *InspectionResultMutation) SetInternalNote(s string) {
+	m.internal_note = &s
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DistrictMutation) ClearEdge(name string) error {
-	switch name {
+// InternalNote returns the value of the "internal_note" field in the mutation.
+func (m *InspectionResultMutation) InternalNote() (r string, exists bool) {
+	v := m.internal_note
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown District unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DistrictMutation) ResetEdge(name string) error {
-	switch name {
-	case district.EdgeJkhUnits:
-		m.ResetJkhUnits()
-		return nil
-	case district.EdgeBuildings:
-		m.ResetBuildings()
-		return nil
+// OldInternalNote returns the old "internal_note" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionResultMutation) OldInternalNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternalNote is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown District edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternalNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternalNote: %w", err)
+	}
+	return oldValue.InternalNote, nil
 }
 
-// ElementCatalogMutation represents an operation that mutates the ElementCatalog nodes in the graph.
-type ElementCatalogMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *int
-	name                      *string
-	category                  *string
-	clearedFields             map[string]struct{}
-	checklist_elements        map[int]struct{}
-	removedchecklist_elements map[int]struct{}
-	clearedchecklist_elements bool
-	done                      bool
-	oldValue                  func(context.Context) (*ElementCatalog, error)
-	predicates                []predicate.ElementCatalog
+// ClearInternalNote clears the value of the "internal_note" field.
+func (m *InspectionResultMutation) ClearInternalNote() {
+	m.internal_note = nil
+	m.clearedFields[inspectionresult.FieldInternalNote] = struct{}{}
 }
 
-var _ ent.Mutation = (*ElementCatalogMutation)(nil)
+// InternalNoteCleared returns if the "internal_note" field was cleared in this mutation.
+func (m *InspectionResultMutation) InternalNoteCleared() bool {
+	_, ok := m.clearedFields[inspectionresult.FieldInternalNote]
+	return ok
+}
 
-// elementcatalogOption allows management of the mutation configuration using functional options.
-type elementcatalogOption func(*ElementCatalogMutation)
+// ResetInternalNote resets all changes to the "internal_note" field.
+func (m *InspectionResultMutation) ResetInternalNote() {
+	m.internal_note = nil
+	delete(m.clearedFields, inspectionresult.FieldInternalNote)
+}
 
-// newElementCatalogMutation creates new mutation for the ElementCatalog entity.
-func newElementCatalogMutation(c config, op Op, opts ...elementcatalogOption) *ElementCatalogMutation {
-	m := &ElementCatalogMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeElementCatalog,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetPhotoPaths sets the "photo_paths" field.
+func (m *InspectionResultMutation) SetPhotoPaths(s []string) {
+	m.photo_paths = &s
+	m.appendphoto_paths = nil
 }
 
-// withElementCatalogID sets the ID field of the mutation.
-func withElementCatalogID(id int) elementcatalogOption {
-	return func(m *ElementCatalogMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ElementCatalog
-		)
-		m.oldValue = func(ctx context.Context) (*ElementCatalog, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ElementCatalog.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// PhotoPaths returns the value of the "photo_paths" field in the mutation.
+func (m *InspectionResultMutation) PhotoPaths() (r []string, exists bool) {
+	v := m.photo_paths
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withElementCatalog sets the old ElementCatalog of the mutation.
-func withElementCatalog(node *ElementCatalog) elementcatalogOption {
-	return func(m *ElementCatalogMutation) {
-		m.oldValue = func(context.Context) (*ElementCatalog, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldPhotoPaths returns the old "photo_paths" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InspectionResultMutation) OldPhotoPaths(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhotoPaths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhotoPaths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhotoPaths: %w", err)
 	}
+	return oldValue.PhotoPaths, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ElementCatalogMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AppendPhotoPaths adds s to the "photo_paths" field.
+func (m *InspectionResultMutation) AppendPhotoPaths(s []string) {
+	m.appendphoto_paths = append(m.appendphoto_paths, s...)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ElementCatalogMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AppendedPhotoPaths returns the list of values that were appended to the "photo_paths" field in this mutation.
+func (m *InspectionResultMutation) AppendedPhotoPaths() ([]string, bool) {
+	if len(m.appendphoto_paths) == 0 {
+		return nil, false
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return m.appendphoto_paths, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ElementCatalogMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ClearPhotoPaths clears the value of the "photo_paths" field.
+func (m *InspectionResultMutation) ClearPhotoPaths() {
+	m.photo_paths = nil
+	m.appendphoto_paths = nil
+	m.clearedFields[inspectionresult.FieldPhotoPaths] = struct{}{}
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ElementCatalogMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ElementCatalog.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// PhotoPathsCleared returns if the "photo_paths" field was cleared in this mutation.
+func (m *InspectionResultMutation) PhotoPathsCleared() bool {
+	_, ok := m.clearedFields[inspectionresult.FieldPhotoPaths]
+	return ok
 }
 
-// SetName sets the "name" field.
-func (m *ElementCatalogMutation) SetName(s string) {
-	m.name = &s
+// ResetPhotoPaths resets all changes to the "photo_paths" field.
+func (m *InspectionResultMutation) ResetPhotoPaths() {
+	m.photo_paths = nil
+	m.appendphoto_paths = nil
+	delete(m.clearedFields, inspectionresult.FieldPhotoPaths)
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ElementCatalogMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetCreatedAt sets the "created_at" field.
+func (m *InspectionResultMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InspectionResultMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ElementCatalog entity.
-// If the ElementCatalog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ElementCatalogMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *InspectionResultMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ElementCatalogMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InspectionResultMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCategory sets the "category" field.
-func (m *ElementCatalogMutation) SetCategory(s string) {
-	m.category = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *InspectionResultMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *ElementCatalogMutation) Category() (r string, exists bool) {
-	v := m.category
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *InspectionResultMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the ElementCatalog entity.
-// If the ElementCatalog object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the InspectionResult entity.
+// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ElementCatalogMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *InspectionResultMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearCategory clears the value of the "category" field.
-func (m *ElementCatalogMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[elementcatalog.FieldCategory] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *InspectionResultMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *ElementCatalogMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[elementcatalog.FieldCategory]
-	return ok
+// ClearTask clears the "task" edge to the Task entity.
+func (m *InspectionResultMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[inspectionresult.FieldTaskID] = struct{}{}
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *ElementCatalogMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, elementcatalog.FieldCategory)
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *InspectionResultMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
-// AddChecklistElementIDs adds the "checklist_elements" edge to the ChecklistElement entity by ids.
-func (m *ElementCatalogMutation) AddChecklistElementIDs(ids ...int) {
-	if m.checklist_elements == nil {
-		m.checklist_elements = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.checklist_elements[ids[i]] = struct{}{}
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *InspectionResultMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearChecklistElements clears the "checklist_elements" edge to the ChecklistElement entity.
-func (m *ElementCatalogMutation) ClearChecklistElements() {
-	m.clearedchecklist_elements = true
-}
-
-// ChecklistElementsCleared reports if the "checklist_elements" edge to the ChecklistElement entity was cleared.
-func (m *ElementCatalogMutation) ChecklistElementsCleared() bool {
-	return m.clearedchecklist_elements
+// ResetTask resets all changes to the "task" edge.
+func (m *InspectionResultMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// RemoveChecklistElementIDs removes the "checklist_elements" edge to the ChecklistElement entity by IDs.
-func (m *ElementCatalogMutation) RemoveChecklistElementIDs(ids ...int) {
-	if m.removedchecklist_elements == nil {
-		m.removedchecklist_elements = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.checklist_elements, ids[i])
-		m.removedchecklist_elements[ids[i]] = struct{}{}
-	}
+// ClearChecklistElement clears the "checklist_element" edge to the ChecklistElement entity.
+func (m *InspectionResultMutation) ClearChecklistElement() {
+	m.clearedchecklist_element = true
+	m.clearedFields[inspectionresult.FieldChecklistElementID] = struct{}{}
 }
 
-// RemovedChecklistElements returns the removed IDs of the "checklist_elements" edge to the ChecklistElement entity.
-func (m *ElementCatalogMutation) RemovedChecklistElementsIDs() (ids []int) {
-	for id := range m.removedchecklist_elements {
-		ids = append(ids, id)
-	}
-	return
+// ChecklistElementCleared reports if the "checklist_element" edge to the ChecklistElement entity was cleared.
+func (m *InspectionResultMutation) ChecklistElementCleared() bool {
+	return m.clearedchecklist_element
 }
 
-// ChecklistElementsIDs returns the "checklist_elements" edge IDs in the mutation.
-func (m *ElementCatalogMutation) ChecklistElementsIDs() (ids []int) {
-	for id := range m.checklist_elements {
-		ids = append(ids, id)
+// ChecklistElementIDs returns the "checklist_element" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChecklistElementID instead. It exists only for internal usage by the builders.
+func (m *InspectionResultMutation) ChecklistElementIDs() (ids []int) {
+	if id := m.checklist_element; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChecklistElements resets all changes to the "checklist_elements" edge.
-func (m *ElementCatalogMutation) ResetChecklistElements() {
-	m.checklist_elements = nil
-	m.clearedchecklist_elements = false
-	m.removedchecklist_elements = nil
+// ResetChecklistElement resets all changes to the "checklist_element" edge.
+func (m *InspectionResultMutation) ResetChecklistElement() {
+	m.checklist_element = nil
+	m.clearedchecklist_element = false
 }
 
-// Where appends a list predicates to the ElementCatalogMutation builder.
-func (m *ElementCatalogMutation) Where(ps ...predicate.ElementCatalog) {
+// Where appends a list predicates to the InspectionResultMutation builder.
+func (m *InspectionResultMutation) Where(ps ...predicate.InspectionResult) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ElementCatalogMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InspectionResultMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ElementCatalogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ElementCatalog, len(ps))
+func (m *InspectionResultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InspectionResult, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3195,30 +6129,48 @@ func (m *ElementCatalogMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ElementCatalogMutation) Op() Op {
+func (m *InspectionResultMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ElementCatalogMutation) SetOp(op Op) {
+func (m *InspectionResultMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ElementCatalog).
-func (m *ElementCatalogMutation) Type() string {
+// Type returns the node type of this mutation (InspectionResult).
+func (m *InspectionResultMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ElementCatalogMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, elementcatalog.FieldName)
+func (m *InspectionResultMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.task != nil {
+		fields = append(fields, inspectionresult.FieldTaskID)
 	}
-	if m.category != nil {
-		fields = append(fields, elementcatalog.FieldCategory)
+	if m.checklist_element != nil {
+		fields = append(fields, inspectionresult.FieldChecklistElementID)
+	}
+	if m.condition_status != nil {
+		fields = append(fields, inspectionresult.FieldConditionStatus)
+	}
+	if m.comment != nil {
+		fields = append(fields, inspectionresult.FieldComment)
+	}
+	if m.internal_note != nil {
+		fields = append(fields, inspectionresult.FieldInternalNote)
+	}
+	if m.photo_paths != nil {
+		fields = append(fields, inspectionresult.FieldPhotoPaths)
+	}
+	if m.created_at != nil {
+		fields = append(fields, inspectionresult.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, inspectionresult.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3226,12 +6178,24 @@ func (m *ElementCatalogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ElementCatalogMutation) Field(name string) (ent.Value, bool) {
+func (m *InspectionResultMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case elementcatalog.FieldName:
-		return m.Name()
-	case elementcatalog.FieldCategory:
-		return m.Category()
+	case inspectionresult.FieldTaskID:
+		return m.TaskID()
+	case inspectionresult.FieldChecklistElementID:
+		return m.ChecklistElementID()
+	case inspectionresult.FieldConditionStatus:
+		return m.ConditionStatus()
+	case inspectionresult.FieldComment:
+		return m.Comment()
+	case inspectionresult.FieldInternalNote:
+		return m.InternalNote()
+	case inspectionresult.FieldPhotoPaths:
+		return m.PhotoPaths()
+	case inspectionresult.FieldCreatedAt:
+		return m.CreatedAt()
+	case inspectionresult.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3239,217 +6203,309 @@ func (m *ElementCatalogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ElementCatalogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InspectionResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case elementcatalog.FieldName:
-		return m.OldName(ctx)
-	case elementcatalog.FieldCategory:
-		return m.OldCategory(ctx)
+	case inspectionresult.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case inspectionresult.FieldChecklistElementID:
+		return m.OldChecklistElementID(ctx)
+	case inspectionresult.FieldConditionStatus:
+		return m.OldConditionStatus(ctx)
+	case inspectionresult.FieldComment:
+		return m.OldComment(ctx)
+	case inspectionresult.FieldInternalNote:
+		return m.OldInternalNote(ctx)
+	case inspectionresult.FieldPhotoPaths:
+		return m.OldPhotoPaths(ctx)
+	case inspectionresult.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case inspectionresult.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ElementCatalog field %s", name)
+	return nil, fmt.Errorf("unknown InspectionResult field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ElementCatalogMutation) SetField(name string, value ent.Value) error {
+func (m *InspectionResultMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case elementcatalog.FieldName:
+	case inspectionresult.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case inspectionresult.FieldChecklistElementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecklistElementID(v)
+		return nil
+	case inspectionresult.FieldConditionStatus:
+		v, ok := value.(inspectionresult.ConditionStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConditionStatus(v)
+		return nil
+	case inspectionresult.FieldComment:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetComment(v)
 		return nil
-	case elementcatalog.FieldCategory:
+	case inspectionresult.FieldInternalNote:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetInternalNote(v)
+		return nil
+	case inspectionresult.FieldPhotoPaths:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhotoPaths(v)
+		return nil
+	case inspectionresult.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case inspectionresult.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ElementCatalog field %s", name)
+	return fmt.Errorf("unknown InspectionResult field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ElementCatalogMutation) AddedFields() []string {
-	return nil
+func (m *InspectionResultMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ElementCatalogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InspectionResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ElementCatalogMutation) AddField(name string, value ent.Value) error {
+func (m *InspectionResultMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ElementCatalog numeric field %s", name)
+	return fmt.Errorf("unknown InspectionResult numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ElementCatalogMutation) ClearedFields() []string {
+func (m *InspectionResultMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(elementcatalog.FieldCategory) {
-		fields = append(fields, elementcatalog.FieldCategory)
+	if m.FieldCleared(inspectionresult.FieldComment) {
+		fields = append(fields, inspectionresult.FieldComment)
+	}
+	if m.FieldCleared(inspectionresult.FieldInternalNote) {
+		fields = append(fields, inspectionresult.FieldInternalNote)
+	}
+	if m.FieldCleared(inspectionresult.FieldPhotoPaths) {
+		fields = append(fields, inspectionresult.FieldPhotoPaths)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ElementCatalogMutation) FieldCleared(name string) bool {
+func (m *InspectionResultMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ElementCatalogMutation) ClearField(name string) error {
+func (m *InspectionResultMutation) ClearField(name string) error {
 	switch name {
-	case elementcatalog.FieldCategory:
-		m.ClearCategory()
+	case inspectionresult.FieldComment:
+		m.ClearComment()
+		return nil
+	case inspectionresult.FieldInternalNote:
+		m.ClearInternalNote()
+		return nil
+	case inspectionresult.FieldPhotoPaths:
+		m.ClearPhotoPaths()
 		return nil
 	}
-	return fmt.Errorf("unknown ElementCatalog nullable field %s", name)
+	return fmt.Errorf("unknown InspectionResult nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ElementCatalogMutation) ResetField(name string) error {
+func (m *InspectionResultMutation) ResetField(name string) error {
 	switch name {
-	case elementcatalog.FieldName:
-		m.ResetName()
+	case inspectionresult.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case elementcatalog.FieldCategory:
-		m.ResetCategory()
+	case inspectionresult.FieldChecklistElementID:
+		m.ResetChecklistElementID()
+		return nil
+	case inspectionresult.FieldConditionStatus:
+		m.ResetConditionStatus()
+		return nil
+	case inspectionresult.FieldComment:
+		m.ResetComment()
+		return nil
+	case inspectionresult.FieldInternalNote:
+		m.ResetInternalNote()
+		return nil
+	case inspectionresult.FieldPhotoPaths:
+		m.ResetPhotoPaths()
+		return nil
+	case inspectionresult.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case inspectionresult.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ElementCatalog field %s", name)
+	return fmt.Errorf("unknown InspectionResult field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ElementCatalogMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.checklist_elements != nil {
-		edges = append(edges, elementcatalog.EdgeChecklistElements)
+func (m *InspectionResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, inspectionresult.EdgeTask)
+	}
+	if m.checklist_element != nil {
+		edges = append(edges, inspectionresult.EdgeChecklistElement)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ElementCatalogMutation) AddedIDs(name string) []ent.Value {
+func (m *InspectionResultMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case elementcatalog.EdgeChecklistElements:
-		ids := make([]ent.Value, 0, len(m.checklist_elements))
-		for id := range m.checklist_elements {
-			ids = append(ids, id)
+	case inspectionresult.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case inspectionresult.EdgeChecklistElement:
+		if id := m.checklist_element; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ElementCatalogMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedchecklist_elements != nil {
-		edges = append(edges, elementcatalog.EdgeChecklistElements)
-	}
+func (m *InspectionResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ElementCatalogMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case elementcatalog.EdgeChecklistElements:
-		ids := make([]ent.Value, 0, len(m.removedchecklist_elements))
-		for id := range m.removedchecklist_elements {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *InspectionResultMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ElementCatalogMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedchecklist_elements {
-		edges = append(edges, elementcatalog.EdgeChecklistElements)
+func (m *InspectionResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, inspectionresult.EdgeTask)
+	}
+	if m.clearedchecklist_element {
+		edges = append(edges, inspectionresult.EdgeChecklistElement)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ElementCatalogMutation) EdgeCleared(name string) bool {
+func (m *InspectionResultMutation) EdgeCleared(name string) bool {
 	switch name {
-	case elementcatalog.EdgeChecklistElements:
-		return m.clearedchecklist_elements
+	case inspectionresult.EdgeTask:
+		return m.clearedtask
+	case inspectionresult.EdgeChecklistElement:
+		return m.clearedchecklist_element
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ElementCatalogMutation) ClearEdge(name string) error {
+func (m *InspectionResultMutation) ClearEdge(name string) error {
 	switch name {
+	case inspectionresult.EdgeTask:
+		m.ClearTask()
+		return nil
+	case inspectionresult.EdgeChecklistElement:
+		m.ClearChecklistElement()
+		return nil
 	}
-	return fmt.Errorf("unknown ElementCatalog unique edge %s", name)
+	return fmt.Errorf("unknown InspectionResult unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ElementCatalogMutation) ResetEdge(name string) error {
+func (m *InspectionResultMutation) ResetEdge(name string) error {
 	switch name {
-	case elementcatalog.EdgeChecklistElements:
-		m.ResetChecklistElements()
+	case inspectionresult.EdgeTask:
+		m.ResetTask()
+		return nil
+	case inspectionresult.EdgeChecklistElement:
+		m.ResetChecklistElement()
 		return nil
 	}
-	return fmt.Errorf("unknown ElementCatalog edge %s", name)
+	return fmt.Errorf("unknown InspectionResult edge %s", name)
 }
 
-// InspectionActMutation represents an operation that mutates the InspectionAct nodes in the graph.
-type InspectionActMutation struct {
+// InspectorUnitMutation represents an operation that mutates the InspectorUnit nodes in the graph.
+type InspectorUnitMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	approved_at   *time.Time
-	status        *string
-	conclusion    *string
-	document_path *string
-	clearedFields map[string]struct{}
-	task          *int
-	clearedtask   bool
-	done          bool
-	oldValue      func(context.Context) (*InspectionAct, error)
-	predicates    []predicate.InspectionAct
+	op               Op
+	typ              string
+	id               *int
+	clearedFields    map[string]struct{}
+	inspector        *int
+	clearedinspector bool
+	jkh_unit         *int
+	clearedjkh_unit  bool
+	done             bool
+	oldValue         func(context.Context) (*InspectorUnit, error)
+	predicates       []predicate.InspectorUnit
 }
 
-var _ ent.Mutation = (*InspectionActMutation)(nil)
+var _ ent.Mutation = (*InspectorUnitMutation)(nil)
 
-// inspectionactOption allows management of the mutation configuration using functional options.
-type inspectionactOption func(*InspectionActMutation)
+// inspectorunitOption allows management of the mutation configuration using functional options.
+type inspectorunitOption func(*InspectorUnitMutation)
 
-// newInspectionActMutation creates new mutation for the InspectionAct entity.
-func newInspectionActMutation(c config, op Op, opts ...inspectionactOption) *InspectionActMutation {
-	m := &InspectionActMutation{
+// newInspectorUnitMutation creates new mutation for the InspectorUnit entity.
+func newInspectorUnitMutation(c config, op Op, opts ...inspectorunitOption) *InspectorUnitMutation {
+	m := &InspectorUnitMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInspectionAct,
+		typ:           TypeInspectorUnit,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3458,20 +6514,20 @@ func newInspectionActMutation(c config, op Op, opts ...inspectionactOption) *Ins
 	return m
 }
 
-// withInspectionActID sets the ID field of the mutation.
-func withInspectionActID(id int) inspectionactOption {
-	return func(m *InspectionActMutation) {
+// withInspectorUnitID sets the ID field of the mutation.
+func withInspectorUnitID(id int) inspectorunitOption {
+	return func(m *InspectorUnitMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *InspectionAct
+			value *InspectorUnit
 		)
-		m.oldValue = func(ctx context.Context) (*InspectionAct, error) {
+		m.oldValue = func(ctx context.Context) (*InspectorUnit, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().InspectionAct.Get(ctx, id)
+					value, err = m.Client().InspectorUnit.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3480,354 +6536,211 @@ func withInspectionActID(id int) inspectionactOption {
 	}
 }
 
-// withInspectionAct sets the old InspectionAct of the mutation.
-func withInspectionAct(node *InspectionAct) inspectionactOption {
-	return func(m *InspectionActMutation) {
-		m.oldValue = func(context.Context) (*InspectionAct, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InspectionActMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m InspectionActMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *InspectionActMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *InspectionActMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().InspectionAct.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetTaskID sets the "task_id" field.
-func (m *InspectionActMutation) SetTaskID(i int) {
-	m.task = &i
-}
-
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *InspectionActMutation) TaskID() (r int, exists bool) {
-	v := m.task
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTaskID returns the old "task_id" field's value of the InspectionAct entity.
-// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionActMutation) OldTaskID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
-	}
-	return oldValue.TaskID, nil
-}
-
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *InspectionActMutation) ResetTaskID() {
-	m.task = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *InspectionActMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *InspectionActMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the InspectionAct entity.
-// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionActMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *InspectionActMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetApprovedAt sets the "approved_at" field.
-func (m *InspectionActMutation) SetApprovedAt(t time.Time) {
-	m.approved_at = &t
-}
-
-// ApprovedAt returns the value of the "approved_at" field in the mutation.
-func (m *InspectionActMutation) ApprovedAt() (r time.Time, exists bool) {
-	v := m.approved_at
-	if v == nil {
-		return
+// withInspectorUnit sets the old InspectorUnit of the mutation.
+func withInspectorUnit(node *InspectorUnit) inspectorunitOption {
+	return func(m *InspectorUnitMutation) {
+		m.oldValue = func(context.Context) (*InspectorUnit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldApprovedAt returns the old "approved_at" field's value of the InspectionAct entity.
-// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionActMutation) OldApprovedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApprovedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApprovedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApprovedAt: %w", err)
-	}
-	return oldValue.ApprovedAt, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InspectorUnitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClearApprovedAt clears the value of the "approved_at" field.
-func (m *InspectionActMutation) ClearApprovedAt() {
-	m.approved_at = nil
-	m.clearedFields[inspectionact.FieldApprovedAt] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InspectorUnitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ApprovedAtCleared returns if the "approved_at" field was cleared in this mutation.
-func (m *InspectionActMutation) ApprovedAtCleared() bool {
-	_, ok := m.clearedFields[inspectionact.FieldApprovedAt]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InspectorUnitMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetApprovedAt resets all changes to the "approved_at" field.
-func (m *InspectionActMutation) ResetApprovedAt() {
-	m.approved_at = nil
-	delete(m.clearedFields, inspectionact.FieldApprovedAt)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InspectorUnitMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InspectorUnit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetStatus sets the "status" field.
-func (m *InspectionActMutation) SetStatus(s string) {
-	m.status = &s
+// SetUserID sets the "user_id" field.
+func (m *InspectorUnitMutation) SetUserID(i int) {
+	m.inspector = &i
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *InspectionActMutation) Status() (r string, exists bool) {
-	v := m.status
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *InspectorUnitMutation) UserID() (r int, exists bool) {
+	v := m.inspector
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the InspectionAct entity.
-// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the InspectorUnit entity.
+// If the InspectorUnit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionActMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *InspectorUnitMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UserID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *InspectionActMutation) ResetStatus() {
-	m.status = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *InspectorUnitMutation) ResetUserID() {
+	m.inspector = nil
 }
 
-// SetConclusion sets the "conclusion" field.
-func (m *InspectionActMutation) SetConclusion(s string) {
-	m.conclusion = &s
+// SetJkhUnitID sets the "jkh_unit_id" field.
+func (m *InspectorUnitMutation) SetJkhUnitID(i int) {
+	m.jkh_unit = &i
 }
 
-// Conclusion returns the value of the "conclusion" field in the mutation.
-func (m *InspectionActMutation) Conclusion() (r string, exists bool) {
-	v := m.conclusion
+// JkhUnitID returns the value of the "jkh_unit_id" field in the mutation.
+func (m *InspectorUnitMutation) JkhUnitID() (r int, exists bool) {
+	v := m.jkh_unit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConclusion returns the old "conclusion" field's value of the InspectionAct entity.
-// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
+// OldJkhUnitID returns the old "jkh_unit_id" field's value of the InspectorUnit entity.
+// If the InspectorUnit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionActMutation) OldConclusion(ctx context.Context) (v string, err error) {
+func (m *InspectorUnitMutation) OldJkhUnitID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConclusion is only allowed on UpdateOne operations")
+		return v, errors.New("OldJkhUnitID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConclusion requires an ID field in the mutation")
+		return v, errors.New("OldJkhUnitID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConclusion: %w", err)
+		return v, fmt.Errorf("querying old value for OldJkhUnitID: %w", err)
 	}
-	return oldValue.Conclusion, nil
+	return oldValue.JkhUnitID, nil
 }
 
-// ClearConclusion clears the value of the "conclusion" field.
-func (m *InspectionActMutation) ClearConclusion() {
-	m.conclusion = nil
-	m.clearedFields[inspectionact.FieldConclusion] = struct{}{}
+// ResetJkhUnitID resets all changes to the "jkh_unit_id" field.
+func (m *InspectorUnitMutation) ResetJkhUnitID() {
+	m.jkh_unit = nil
 }
 
-// ConclusionCleared returns if the "conclusion" field was cleared in this mutation.
-func (m *InspectionActMutation) ConclusionCleared() bool {
-	_, ok := m.clearedFields[inspectionact.FieldConclusion]
-	return ok
+// SetInspectorID sets the "inspector" edge to the User entity by id.
+func (m *InspectorUnitMutation) SetInspectorID(id int) {
+	m.inspector = &id
 }
 
-// ResetConclusion resets all changes to the "conclusion" field.
-func (m *InspectionActMutation) ResetConclusion() {
-	m.conclusion = nil
-	delete(m.clearedFields, inspectionact.FieldConclusion)
+// ClearInspector clears the "inspector" edge to the User entity.
+func (m *InspectorUnitMutation) ClearInspector() {
+	m.clearedinspector = true
+	m.clearedFields[inspectorunit.FieldUserID] = struct{}{}
 }
 
-// SetDocumentPath sets the "document_path" field.
-func (m *InspectionActMutation) SetDocumentPath(s string) {
-	m.document_path = &s
+// InspectorCleared reports if the "inspector" edge to the User entity was cleared.
+func (m *InspectorUnitMutation) InspectorCleared() bool {
+	return m.clearedinspector
 }
 
-// DocumentPath returns the value of the "document_path" field in the mutation.
-func (m *InspectionActMutation) DocumentPath() (r string, exists bool) {
-	v := m.document_path
-	if v == nil {
-		return
+// InspectorID returns the "inspector" edge ID in the mutation.
+func (m *InspectorUnitMutation) InspectorID() (id int, exists bool) {
+	if m.inspector != nil {
+		return *m.inspector, true
 	}
-	return *v, true
+	return
 }
 
-// OldDocumentPath returns the old "document_path" field's value of the InspectionAct entity.
-// If the InspectionAct object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionActMutation) OldDocumentPath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDocumentPath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDocumentPath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDocumentPath: %w", err)
+// InspectorIDs returns the "inspector" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InspectorID instead. It exists only for internal usage by the builders.
+func (m *InspectorUnitMutation) InspectorIDs() (ids []int) {
+	if id := m.inspector; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.DocumentPath, nil
-}
-
-// ClearDocumentPath clears the value of the "document_path" field.
-func (m *InspectionActMutation) ClearDocumentPath() {
-	m.document_path = nil
-	m.clearedFields[inspectionact.FieldDocumentPath] = struct{}{}
-}
-
-// DocumentPathCleared returns if the "document_path" field was cleared in this mutation.
-func (m *InspectionActMutation) DocumentPathCleared() bool {
-	_, ok := m.clearedFields[inspectionact.FieldDocumentPath]
-	return ok
+	return
 }
 
-// ResetDocumentPath resets all changes to the "document_path" field.
-func (m *InspectionActMutation) ResetDocumentPath() {
-	m.document_path = nil
-	delete(m.clearedFields, inspectionact.FieldDocumentPath)
+// ResetInspector resets all changes to the "inspector" edge.
+func (m *InspectorUnitMutation) ResetInspector() {
+	m.inspector = nil
+	m.clearedinspector = false
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *InspectionActMutation) ClearTask() {
-	m.clearedtask = true
-	m.clearedFields[inspectionact.FieldTaskID] = struct{}{}
+// ClearJkhUnit clears the "jkh_unit" edge to the JkhUnit entity.
+func (m *InspectorUnitMutation) ClearJkhUnit() {
+	m.clearedjkh_unit = true
+	m.clearedFields[inspectorunit.FieldJkhUnitID] = struct{}{}
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *InspectionActMutation) TaskCleared() bool {
-	return m.clearedtask
+// JkhUnitCleared reports if the "jkh_unit" edge to the JkhUnit entity was cleared.
+func (m *InspectorUnitMutation) JkhUnitCleared() bool {
+	return m.clearedjkh_unit
 }
 
-// TaskIDs returns the "task" edge IDs in the mutation.
+// JkhUnitIDs returns the "jkh_unit" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *InspectionActMutation) TaskIDs() (ids []int) {
-	if id := m.task; id != nil {
+// JkhUnitID instead. It exists only for internal usage by the builders.
+func (m *InspectorUnitMutation) JkhUnitIDs() (ids []int) {
+	if id := m.jkh_unit; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTask resets all changes to the "task" edge.
-func (m *InspectionActMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
+// ResetJkhUnit resets all changes to the "jkh_unit" edge.
+func (m *InspectorUnitMutation) ResetJkhUnit() {
+	m.jkh_unit = nil
+	m.clearedjkh_unit = false
 }
 
-// Where appends a list predicates to the InspectionActMutation builder.
-func (m *InspectionActMutation) Where(ps ...predicate.InspectionAct) {
+// Where appends a list predicates to the InspectorUnitMutation builder.
+func (m *InspectorUnitMutation) Where(ps ...predicate.InspectorUnit) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the InspectionActMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InspectorUnitMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InspectionActMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.InspectionAct, len(ps))
+func (m *InspectorUnitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InspectorUnit, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3835,42 +6748,30 @@ func (m *InspectionActMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *InspectionActMutation) Op() Op {
+func (m *InspectorUnitMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *InspectionActMutation) SetOp(op Op) {
+func (m *InspectorUnitMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (InspectionAct).
-func (m *InspectionActMutation) Type() string {
+// Type returns the node type of this mutation (InspectorUnit).
+func (m *InspectorUnitMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InspectionActMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.task != nil {
-		fields = append(fields, inspectionact.FieldTaskID)
-	}
-	if m.created_at != nil {
-		fields = append(fields, inspectionact.FieldCreatedAt)
-	}
-	if m.approved_at != nil {
-		fields = append(fields, inspectionact.FieldApprovedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, inspectionact.FieldStatus)
-	}
-	if m.conclusion != nil {
-		fields = append(fields, inspectionact.FieldConclusion)
+func (m *InspectorUnitMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.inspector != nil {
+		fields = append(fields, inspectorunit.FieldUserID)
 	}
-	if m.document_path != nil {
-		fields = append(fields, inspectionact.FieldDocumentPath)
+	if m.jkh_unit != nil {
+		fields = append(fields, inspectorunit.FieldJkhUnitID)
 	}
 	return fields
 }
@@ -3878,20 +6779,12 @@ func (m *InspectionActMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InspectionActMutation) Field(name string) (ent.Value, bool) {
+func (m *InspectorUnitMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case inspectionact.FieldTaskID:
-		return m.TaskID()
-	case inspectionact.FieldCreatedAt:
-		return m.CreatedAt()
-	case inspectionact.FieldApprovedAt:
-		return m.ApprovedAt()
-	case inspectionact.FieldStatus:
-		return m.Status()
-	case inspectionact.FieldConclusion:
-		return m.Conclusion()
-	case inspectionact.FieldDocumentPath:
-		return m.DocumentPath()
+	case inspectorunit.FieldUserID:
+		return m.UserID()
+	case inspectorunit.FieldJkhUnitID:
+		return m.JkhUnitID()
 	}
 	return nil, false
 }
@@ -3899,78 +6792,42 @@ func (m *InspectionActMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InspectionActMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InspectorUnitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case inspectionact.FieldTaskID:
-		return m.OldTaskID(ctx)
-	case inspectionact.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case inspectionact.FieldApprovedAt:
-		return m.OldApprovedAt(ctx)
-	case inspectionact.FieldStatus:
-		return m.OldStatus(ctx)
-	case inspectionact.FieldConclusion:
-		return m.OldConclusion(ctx)
-	case inspectionact.FieldDocumentPath:
-		return m.OldDocumentPath(ctx)
+	case inspectorunit.FieldUserID:
+		return m.OldUserID(ctx)
+	case inspectorunit.FieldJkhUnitID:
+		return m.OldJkhUnitID(ctx)
 	}
-	return nil, fmt.Errorf("unknown InspectionAct field %s", name)
+	return nil, fmt.Errorf("unknown InspectorUnit field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InspectionActMutation) SetField(name string, value ent.Value) error {
+func (m *InspectorUnitMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case inspectionact.FieldTaskID:
+	case inspectorunit.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTaskID(v)
-		return nil
-	case inspectionact.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case inspectionact.FieldApprovedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetApprovedAt(v)
-		return nil
-	case inspectionact.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case inspectionact.FieldConclusion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConclusion(v)
+		m.SetUserID(v)
 		return nil
-	case inspectionact.FieldDocumentPath:
-		v, ok := value.(string)
+	case inspectorunit.FieldJkhUnitID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDocumentPath(v)
+		m.SetJkhUnitID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionAct field %s", name)
+	return fmt.Errorf("unknown InspectorUnit field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InspectionActMutation) AddedFields() []string {
+func (m *InspectorUnitMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -3978,7 +6835,7 @@ func (m *InspectionActMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InspectionActMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InspectorUnitMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -3987,93 +6844,67 @@ func (m *InspectionActMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InspectionActMutation) AddField(name string, value ent.Value) error {
+func (m *InspectorUnitMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown InspectionAct numeric field %s", name)
+	return fmt.Errorf("unknown InspectorUnit numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InspectionActMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(inspectionact.FieldApprovedAt) {
-		fields = append(fields, inspectionact.FieldApprovedAt)
-	}
-	if m.FieldCleared(inspectionact.FieldConclusion) {
-		fields = append(fields, inspectionact.FieldConclusion)
-	}
-	if m.FieldCleared(inspectionact.FieldDocumentPath) {
-		fields = append(fields, inspectionact.FieldDocumentPath)
-	}
-	return fields
+func (m *InspectorUnitMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InspectionActMutation) FieldCleared(name string) bool {
+func (m *InspectorUnitMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InspectionActMutation) ClearField(name string) error {
-	switch name {
-	case inspectionact.FieldApprovedAt:
-		m.ClearApprovedAt()
-		return nil
-	case inspectionact.FieldConclusion:
-		m.ClearConclusion()
-		return nil
-	case inspectionact.FieldDocumentPath:
-		m.ClearDocumentPath()
-		return nil
-	}
-	return fmt.Errorf("unknown InspectionAct nullable field %s", name)
+func (m *InspectorUnitMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown InspectorUnit nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *InspectionActMutation) ResetField(name string) error {
+func (m *InspectorUnitMutation) ResetField(name string) error {
 	switch name {
-	case inspectionact.FieldTaskID:
-		m.ResetTaskID()
-		return nil
-	case inspectionact.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case inspectionact.FieldApprovedAt:
-		m.ResetApprovedAt()
-		return nil
-	case inspectionact.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case inspectionact.FieldConclusion:
-		m.ResetConclusion()
+	case inspectorunit.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case inspectionact.FieldDocumentPath:
-		m.ResetDocumentPath()
+	case inspectorunit.FieldJkhUnitID:
+		m.ResetJkhUnitID()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionAct field %s", name)
+	return fmt.Errorf("unknown InspectorUnit field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InspectionActMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.task != nil {
-		edges = append(edges, inspectionact.EdgeTask)
+func (m *InspectorUnitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.inspector != nil {
+		edges = append(edges, inspectorunit.EdgeInspector)
+	}
+	if m.jkh_unit != nil {
+		edges = append(edges, inspectorunit.EdgeJkhUnit)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InspectionActMutation) AddedIDs(name string) []ent.Value {
+func (m *InspectorUnitMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case inspectionact.EdgeTask:
-		if id := m.task; id != nil {
+	case inspectorunit.EdgeInspector:
+		if id := m.inspector; id != nil {
+			return []ent.Value{*id}
+		}
+	case inspectorunit.EdgeJkhUnit:
+		if id := m.jkh_unit; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4081,89 +6912,102 @@ func (m *InspectionActMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InspectionActMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *InspectorUnitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InspectionActMutation) RemovedIDs(name string) []ent.Value {
+func (m *InspectorUnitMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InspectionActMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtask {
-		edges = append(edges, inspectionact.EdgeTask)
+func (m *InspectorUnitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedinspector {
+		edges = append(edges, inspectorunit.EdgeInspector)
+	}
+	if m.clearedjkh_unit {
+		edges = append(edges, inspectorunit.EdgeJkhUnit)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InspectionActMutation) EdgeCleared(name string) bool {
+func (m *InspectorUnitMutation) EdgeCleared(name string) bool {
 	switch name {
-	case inspectionact.EdgeTask:
-		return m.clearedtask
+	case inspectorunit.EdgeInspector:
+		return m.clearedinspector
+	case inspectorunit.EdgeJkhUnit:
+		return m.clearedjkh_unit
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InspectionActMutation) ClearEdge(name string) error {
+func (m *InspectorUnitMutation) ClearEdge(name string) error {
 	switch name {
-	case inspectionact.EdgeTask:
-		m.ClearTask()
+	case inspectorunit.EdgeInspector:
+		m.ClearInspector()
+		return nil
+	case inspectorunit.EdgeJkhUnit:
+		m.ClearJkhUnit()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionAct unique edge %s", name)
+	return fmt.Errorf("unknown InspectorUnit unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InspectionActMutation) ResetEdge(name string) error {
+func (m *InspectorUnitMutation) ResetEdge(name string) error {
 	switch name {
-	case inspectionact.EdgeTask:
-		m.ResetTask()
+	case inspectorunit.EdgeInspector:
+		m.ResetInspector()
 		return nil
-	}
-	return fmt.Errorf("unknown InspectionAct edge %s", name)
-}
-
-// InspectionResultMutation represents an operation that mutates the InspectionResult nodes in the graph.
-type InspectionResultMutation struct {
-	config
-	op                       Op
-	typ                      string
-	id                       *int
-	condition_status         *inspectionresult.ConditionStatus
-	comment                  *string
-	created_at               *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	task                     *int
-	clearedtask              bool
-	checklist_element        *int
-	clearedchecklist_element bool
-	done                     bool
-	oldValue                 func(context.Context) (*InspectionResult, error)
-	predicates               []predicate.InspectionResult
+	case inspectorunit.EdgeJkhUnit:
+		m.ResetJkhUnit()
+		return nil
+	}
+	return fmt.Errorf("unknown InspectorUnit edge %s", name)
 }
 
-var _ ent.Mutation = (*InspectionResultMutation)(nil)
+// JkhUnitMutation represents an operation that mutates the JkhUnit nodes in the graph.
+type JkhUnitMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	name                       *string
+	description                *string
+	clearedFields              map[string]struct{}
+	district                   *int
+	cleareddistrict            bool
+	buildings                  map[int]struct{}
+	removedbuildings           map[int]struct{}
+	clearedbuildings           bool
+	assigned_inspectors        map[int]struct{}
+	removedassigned_inspectors map[int]struct{}
+	clearedassigned_inspectors bool
+	done                       bool
+	oldValue                   func(context.Context) (*JkhUnit, error)
+	predicates                 []predicate.JkhUnit
+}
 
-// inspectionresultOption allows management of the mutation configuration using functional options.
-type inspectionresultOption func(*InspectionResultMutation)
+var _ ent.Mutation = (*JkhUnitMutation)(nil)
 
-// newInspectionResultMutation creates new mutation for the InspectionResult entity.
-func newInspectionResultMutation(c config, op Op, opts ...inspectionresultOption) *InspectionResultMutation {
-	m := &InspectionResultMutation{
+// jkhunitOption allows management of the mutation configuration using functional options.
+type jkhunitOption func(*JkhUnitMutation)
+
+// newJkhUnitMutation creates new mutation for the JkhUnit entity.
+func newJkhUnitMutation(c config, op Op, opts ...jkhunitOption) *JkhUnitMutation {
+	m := &JkhUnitMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInspectionResult,
+		typ:           TypeJkhUnit,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4172,20 +7016,20 @@ func newInspectionResultMutation(c config, op Op, opts ...inspectionresultOption
 	return m
 }
 
-// withInspectionResultID sets the ID field of the mutation.
-func withInspectionResultID(id int) inspectionresultOption {
-	return func(m *InspectionResultMutation) {
+// withJkhUnitID sets the ID field of the mutation.
+func withJkhUnitID(id int) jkhunitOption {
+	return func(m *JkhUnitMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *InspectionResult
+			value *JkhUnit
 		)
-		m.oldValue = func(ctx context.Context) (*InspectionResult, error) {
+		m.oldValue = func(ctx context.Context) (*JkhUnit, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().InspectionResult.Get(ctx, id)
+					value, err = m.Client().JkhUnit.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4194,10 +7038,10 @@ func withInspectionResultID(id int) inspectionresultOption {
 	}
 }
 
-// withInspectionResult sets the old InspectionResult of the mutation.
-func withInspectionResult(node *InspectionResult) inspectionresultOption {
-	return func(m *InspectionResultMutation) {
-		m.oldValue = func(context.Context) (*InspectionResult, error) {
+// withJkhUnit sets the old JkhUnit of the mutation.
+func withJkhUnit(node *JkhUnit) jkhunitOption {
+	return func(m *JkhUnitMutation) {
+		m.oldValue = func(context.Context) (*JkhUnit, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4206,7 +7050,7 @@ func withInspectionResult(node *InspectionResult) inspectionresultOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InspectionResultMutation) Client() *Client {
+func (m JkhUnitMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4214,7 +7058,7 @@ func (m InspectionResultMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m InspectionResultMutation) Tx() (*Tx, error) {
+func (m JkhUnitMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4225,7 +7069,7 @@ func (m InspectionResultMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *InspectionResultMutation) ID() (id int, exists bool) {
+func (m *JkhUnitMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4236,7 +7080,7 @@ func (m *InspectionResultMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *InspectionResultMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *JkhUnitMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4245,304 +7089,277 @@ func (m *InspectionResultMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().InspectionResult.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().JkhUnit.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTaskID sets the "task_id" field.
-func (m *InspectionResultMutation) SetTaskID(i int) {
-	m.task = &i
-}
-
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *InspectionResultMutation) TaskID() (r int, exists bool) {
-	v := m.task
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTaskID returns the old "task_id" field's value of the InspectionResult entity.
-// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionResultMutation) OldTaskID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
-	}
-	return oldValue.TaskID, nil
-}
-
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *InspectionResultMutation) ResetTaskID() {
-	m.task = nil
-}
-
-// SetChecklistElementID sets the "checklist_element_id" field.
-func (m *InspectionResultMutation) SetChecklistElementID(i int) {
-	m.checklist_element = &i
-}
-
-// ChecklistElementID returns the value of the "checklist_element_id" field in the mutation.
-func (m *InspectionResultMutation) ChecklistElementID() (r int, exists bool) {
-	v := m.checklist_element
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChecklistElementID returns the old "checklist_element_id" field's value of the InspectionResult entity.
-// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionResultMutation) OldChecklistElementID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecklistElementID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecklistElementID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecklistElementID: %w", err)
-	}
-	return oldValue.ChecklistElementID, nil
-}
-
-// ResetChecklistElementID resets all changes to the "checklist_element_id" field.
-func (m *InspectionResultMutation) ResetChecklistElementID() {
-	m.checklist_element = nil
-}
-
-// SetConditionStatus sets the "condition_status" field.
-func (m *InspectionResultMutation) SetConditionStatus(is inspectionresult.ConditionStatus) {
-	m.condition_status = &is
-}
-
-// ConditionStatus returns the value of the "condition_status" field in the mutation.
-func (m *InspectionResultMutation) ConditionStatus() (r inspectionresult.ConditionStatus, exists bool) {
-	v := m.condition_status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldConditionStatus returns the old "condition_status" field's value of the InspectionResult entity.
-// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionResultMutation) OldConditionStatus(ctx context.Context) (v inspectionresult.ConditionStatus, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConditionStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConditionStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConditionStatus: %w", err)
-	}
-	return oldValue.ConditionStatus, nil
-}
-
-// ResetConditionStatus resets all changes to the "condition_status" field.
-func (m *InspectionResultMutation) ResetConditionStatus() {
-	m.condition_status = nil
-}
-
-// SetComment sets the "comment" field.
-func (m *InspectionResultMutation) SetComment(s string) {
-	m.comment = &s
+// SetDistrictID sets the "district_id" field.
+func (m *JkhUnitMutation) SetDistrictID(i int) {
+	m.district = &i
 }
 
-// Comment returns the value of the "comment" field in the mutation.
-func (m *InspectionResultMutation) Comment() (r string, exists bool) {
-	v := m.comment
+// DistrictID returns the value of the "district_id" field in the mutation.
+func (m *JkhUnitMutation) DistrictID() (r int, exists bool) {
+	v := m.district
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComment returns the old "comment" field's value of the InspectionResult entity.
-// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// OldDistrictID returns the old "district_id" field's value of the JkhUnit entity.
+// If the JkhUnit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionResultMutation) OldComment(ctx context.Context) (v string, err error) {
+func (m *JkhUnitMutation) OldDistrictID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldDistrictID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComment requires an ID field in the mutation")
+		return v, errors.New("OldDistrictID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldDistrictID: %w", err)
 	}
-	return oldValue.Comment, nil
-}
-
-// ClearComment clears the value of the "comment" field.
-func (m *InspectionResultMutation) ClearComment() {
-	m.comment = nil
-	m.clearedFields[inspectionresult.FieldComment] = struct{}{}
-}
-
-// CommentCleared returns if the "comment" field was cleared in this mutation.
-func (m *InspectionResultMutation) CommentCleared() bool {
-	_, ok := m.clearedFields[inspectionresult.FieldComment]
-	return ok
+	return oldValue.DistrictID, nil
 }
 
-// ResetComment resets all changes to the "comment" field.
-func (m *InspectionResultMutation) ResetComment() {
-	m.comment = nil
-	delete(m.clearedFields, inspectionresult.FieldComment)
+// ResetDistrictID resets all changes to the "district_id" field.
+func (m *JkhUnitMutation) ResetDistrictID() {
+	m.district = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *InspectionResultMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetName sets the "name" field.
+func (m *JkhUnitMutation) SetName(s string) {
+	m.name = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *InspectionResultMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Name returns the value of the "name" field in the mutation.
+func (m *JkhUnitMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the InspectionResult entity.
-// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the JkhUnit entity.
+// If the JkhUnit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionResultMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JkhUnitMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Name, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *InspectionResultMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetName resets all changes to the "name" field.
+func (m *JkhUnitMutation) ResetName() {
+	m.name = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *InspectionResultMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetDescription sets the "description" field.
+func (m *JkhUnitMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *InspectionResultMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Description returns the value of the "description" field in the mutation.
+func (m *JkhUnitMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the InspectionResult entity.
-// If the InspectionResult object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the JkhUnit entity.
+// If the JkhUnit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectionResultMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JkhUnitMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Description, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *InspectionResultMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearDescription clears the value of the "description" field.
+func (m *JkhUnitMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[jkhunit.FieldDescription] = struct{}{}
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *InspectionResultMutation) ClearTask() {
-	m.clearedtask = true
-	m.clearedFields[inspectionresult.FieldTaskID] = struct{}{}
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *JkhUnitMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[jkhunit.FieldDescription]
+	return ok
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *InspectionResultMutation) TaskCleared() bool {
-	return m.clearedtask
+// ResetDescription resets all changes to the "description" field.
+func (m *JkhUnitMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, jkhunit.FieldDescription)
 }
 
-// TaskIDs returns the "task" edge IDs in the mutation.
+// ClearDistrict clears the "district" edge to the District entity.
+func (m *JkhUnitMutation) ClearDistrict() {
+	m.cleareddistrict = true
+	m.clearedFields[jkhunit.FieldDistrictID] = struct{}{}
+}
+
+// DistrictCleared reports if the "district" edge to the District entity was cleared.
+func (m *JkhUnitMutation) DistrictCleared() bool {
+	return m.cleareddistrict
+}
+
+// DistrictIDs returns the "district" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *InspectionResultMutation) TaskIDs() (ids []int) {
-	if id := m.task; id != nil {
+// DistrictID instead. It exists only for internal usage by the builders.
+func (m *JkhUnitMutation) DistrictIDs() (ids []int) {
+	if id := m.district; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTask resets all changes to the "task" edge.
-func (m *InspectionResultMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
+// ResetDistrict resets all changes to the "district" edge.
+func (m *JkhUnitMutation) ResetDistrict() {
+	m.district = nil
+	m.cleareddistrict = false
 }
 
-// ClearChecklistElement clears the "checklist_element" edge to the ChecklistElement entity.
-func (m *InspectionResultMutation) ClearChecklistElement() {
-	m.clearedchecklist_element = true
-	m.clearedFields[inspectionresult.FieldChecklistElementID] = struct{}{}
+// AddBuildingIDs adds the "buildings" edge to the Building entity by ids.
+func (m *JkhUnitMutation) AddBuildingIDs(ids ...int) {
+	if m.buildings == nil {
+		m.buildings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.buildings[ids[i]] = struct{}{}
+	}
 }
 
-// ChecklistElementCleared reports if the "checklist_element" edge to the ChecklistElement entity was cleared.
-func (m *InspectionResultMutation) ChecklistElementCleared() bool {
-	return m.clearedchecklist_element
+// ClearBuildings clears the "buildings" edge to the Building entity.
+func (m *JkhUnitMutation) ClearBuildings() {
+	m.clearedbuildings = true
 }
 
-// ChecklistElementIDs returns the "checklist_element" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChecklistElementID instead. It exists only for internal usage by the builders.
-func (m *InspectionResultMutation) ChecklistElementIDs() (ids []int) {
-	if id := m.checklist_element; id != nil {
-		ids = append(ids, *id)
+// BuildingsCleared reports if the "buildings" edge to the Building entity was cleared.
+func (m *JkhUnitMutation) BuildingsCleared() bool {
+	return m.clearedbuildings
+}
+
+// RemoveBuildingIDs removes the "buildings" edge to the Building entity by IDs.
+func (m *JkhUnitMutation) RemoveBuildingIDs(ids ...int) {
+	if m.removedbuildings == nil {
+		m.removedbuildings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.buildings, ids[i])
+		m.removedbuildings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuildings returns the removed IDs of the "buildings" edge to the Building entity.
+func (m *JkhUnitMutation) RemovedBuildingsIDs() (ids []int) {
+	for id := range m.removedbuildings {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetChecklistElement resets all changes to the "checklist_element" edge.
-func (m *InspectionResultMutation) ResetChecklistElement() {
-	m.checklist_element = nil
-	m.clearedchecklist_element = false
+// BuildingsIDs returns the "buildings" edge IDs in the mutation.
+func (m *JkhUnitMutation) BuildingsIDs() (ids []int) {
+	for id := range m.buildings {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the InspectionResultMutation builder.
-func (m *InspectionResultMutation) Where(ps ...predicate.InspectionResult) {
+// ResetBuildings resets all changes to the "buildings" edge.
+func (m *JkhUnitMutation) ResetBuildings() {
+	m.buildings = nil
+	m.clearedbuildings = false
+	m.removedbuildings = nil
+}
+
+// AddAssignedInspectorIDs adds the "assigned_inspectors" edge to the InspectorUnit entity by ids.
+func (m *JkhUnitMutation) AddAssignedInspectorIDs(ids ...int) {
+	if m.assigned_inspectors == nil {
+		m.assigned_inspectors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.assigned_inspectors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignedInspectors clears the "assigned_inspectors" edge to the InspectorUnit entity.
+func (m *JkhUnitMutation) ClearAssignedInspectors() {
+	m.clearedassigned_inspectors = true
+}
+
+// AssignedInspectorsCleared reports if the "assigned_inspectors" edge to the InspectorUnit entity was cleared.
+func (m *JkhUnitMutation) AssignedInspectorsCleared() bool {
+	return m.clearedassigned_inspectors
+}
+
+// RemoveAssignedInspectorIDs removes the "assigned_inspectors" edge to the InspectorUnit entity by IDs.
+func (m *JkhUnitMutation) RemoveAssignedInspectorIDs(ids ...int) {
+	if m.removedassigned_inspectors == nil {
+		m.removedassigned_inspectors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.assigned_inspectors, ids[i])
+		m.removedassigned_inspectors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignedInspectors returns the removed IDs of the "assigned_inspectors" edge to the InspectorUnit entity.
+func (m *JkhUnitMutation) RemovedAssignedInspectorsIDs() (ids []int) {
+	for id := range m.removedassigned_inspectors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignedInspectorsIDs returns the "assigned_inspectors" edge IDs in the mutation.
+func (m *JkhUnitMutation) AssignedInspectorsIDs() (ids []int) {
+	for id := range m.assigned_inspectors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignedInspectors resets all changes to the "assigned_inspectors" edge.
+func (m *JkhUnitMutation) ResetAssignedInspectors() {
+	m.assigned_inspectors = nil
+	m.clearedassigned_inspectors = false
+	m.removedassigned_inspectors = nil
+}
+
+// Where appends a list predicates to the JkhUnitMutation builder.
+func (m *JkhUnitMutation) Where(ps ...predicate.JkhUnit) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the InspectionResultMutation builder. Using this method,
+// WhereP appends storage-level predicates to the JkhUnitMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InspectionResultMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.InspectionResult, len(ps))
+func (m *JkhUnitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JkhUnit, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4550,42 +7367,33 @@ func (m *InspectionResultMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *InspectionResultMutation) Op() Op {
+func (m *JkhUnitMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *InspectionResultMutation) SetOp(op Op) {
+func (m *JkhUnitMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (InspectionResult).
-func (m *InspectionResultMutation) Type() string {
+// Type returns the node type of this mutation (JkhUnit).
+func (m *JkhUnitMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InspectionResultMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.task != nil {
-		fields = append(fields, inspectionresult.FieldTaskID)
-	}
-	if m.checklist_element != nil {
-		fields = append(fields, inspectionresult.FieldChecklistElementID)
-	}
-	if m.condition_status != nil {
-		fields = append(fields, inspectionresult.FieldConditionStatus)
-	}
-	if m.comment != nil {
-		fields = append(fields, inspectionresult.FieldComment)
+func (m *JkhUnitMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.district != nil {
+		fields = append(fields, jkhunit.FieldDistrictID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, inspectionresult.FieldCreatedAt)
+	if m.name != nil {
+		fields = append(fields, jkhunit.FieldName)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, inspectionresult.FieldUpdatedAt)
+	if m.description != nil {
+		fields = append(fields, jkhunit.FieldDescription)
 	}
 	return fields
 }
@@ -4593,20 +7401,14 @@ func (m *InspectionResultMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InspectionResultMutation) Field(name string) (ent.Value, bool) {
+func (m *JkhUnitMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case inspectionresult.FieldTaskID:
-		return m.TaskID()
-	case inspectionresult.FieldChecklistElementID:
-		return m.ChecklistElementID()
-	case inspectionresult.FieldConditionStatus:
-		return m.ConditionStatus()
-	case inspectionresult.FieldComment:
-		return m.Comment()
-	case inspectionresult.FieldCreatedAt:
-		return m.CreatedAt()
-	case inspectionresult.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case jkhunit.FieldDistrictID:
+		return m.DistrictID()
+	case jkhunit.FieldName:
+		return m.Name()
+	case jkhunit.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -4614,78 +7416,51 @@ func (m *InspectionResultMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InspectionResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *JkhUnitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case inspectionresult.FieldTaskID:
-		return m.OldTaskID(ctx)
-	case inspectionresult.FieldChecklistElementID:
-		return m.OldChecklistElementID(ctx)
-	case inspectionresult.FieldConditionStatus:
-		return m.OldConditionStatus(ctx)
-	case inspectionresult.FieldComment:
-		return m.OldComment(ctx)
-	case inspectionresult.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case inspectionresult.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case jkhunit.FieldDistrictID:
+		return m.OldDistrictID(ctx)
+	case jkhunit.FieldName:
+		return m.OldName(ctx)
+	case jkhunit.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown InspectionResult field %s", name)
+	return nil, fmt.Errorf("unknown JkhUnit field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InspectionResultMutation) SetField(name string, value ent.Value) error {
+func (m *JkhUnitMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case inspectionresult.FieldTaskID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTaskID(v)
-		return nil
-	case inspectionresult.FieldChecklistElementID:
+	case jkhunit.FieldDistrictID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecklistElementID(v)
-		return nil
-	case inspectionresult.FieldConditionStatus:
-		v, ok := value.(inspectionresult.ConditionStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConditionStatus(v)
+		m.SetDistrictID(v)
 		return nil
-	case inspectionresult.FieldComment:
+	case jkhunit.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComment(v)
-		return nil
-	case inspectionresult.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+		m.SetName(v)
 		return nil
-	case inspectionresult.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case jkhunit.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionResult field %s", name)
+	return fmt.Errorf("unknown JkhUnit field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InspectionResultMutation) AddedFields() []string {
+func (m *JkhUnitMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -4693,7 +7468,7 @@ func (m *InspectionResultMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InspectionResultMutation) AddedField(name string) (ent.Value, bool) {
+func (m *JkhUnitMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -4702,185 +7477,212 @@ func (m *InspectionResultMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InspectionResultMutation) AddField(name string, value ent.Value) error {
+func (m *JkhUnitMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown InspectionResult numeric field %s", name)
+	return fmt.Errorf("unknown JkhUnit numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InspectionResultMutation) ClearedFields() []string {
+func (m *JkhUnitMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(inspectionresult.FieldComment) {
-		fields = append(fields, inspectionresult.FieldComment)
+	if m.FieldCleared(jkhunit.FieldDescription) {
+		fields = append(fields, jkhunit.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InspectionResultMutation) FieldCleared(name string) bool {
+func (m *JkhUnitMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InspectionResultMutation) ClearField(name string) error {
+func (m *JkhUnitMutation) ClearField(name string) error {
 	switch name {
-	case inspectionresult.FieldComment:
-		m.ClearComment()
+	case jkhunit.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionResult nullable field %s", name)
+	return fmt.Errorf("unknown JkhUnit nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *InspectionResultMutation) ResetField(name string) error {
+func (m *JkhUnitMutation) ResetField(name string) error {
 	switch name {
-	case inspectionresult.FieldTaskID:
-		m.ResetTaskID()
-		return nil
-	case inspectionresult.FieldChecklistElementID:
-		m.ResetChecklistElementID()
-		return nil
-	case inspectionresult.FieldConditionStatus:
-		m.ResetConditionStatus()
-		return nil
-	case inspectionresult.FieldComment:
-		m.ResetComment()
+	case jkhunit.FieldDistrictID:
+		m.ResetDistrictID()
 		return nil
-	case inspectionresult.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case jkhunit.FieldName:
+		m.ResetName()
 		return nil
-	case inspectionresult.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case jkhunit.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionResult field %s", name)
+	return fmt.Errorf("unknown JkhUnit field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InspectionResultMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.task != nil {
-		edges = append(edges, inspectionresult.EdgeTask)
+func (m *JkhUnitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.district != nil {
+		edges = append(edges, jkhunit.EdgeDistrict)
 	}
-	if m.checklist_element != nil {
-		edges = append(edges, inspectionresult.EdgeChecklistElement)
+	if m.buildings != nil {
+		edges = append(edges, jkhunit.EdgeBuildings)
+	}
+	if m.assigned_inspectors != nil {
+		edges = append(edges, jkhunit.EdgeAssignedInspectors)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InspectionResultMutation) AddedIDs(name string) []ent.Value {
+func (m *JkhUnitMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case inspectionresult.EdgeTask:
-		if id := m.task; id != nil {
+	case jkhunit.EdgeDistrict:
+		if id := m.district; id != nil {
 			return []ent.Value{*id}
 		}
-	case inspectionresult.EdgeChecklistElement:
-		if id := m.checklist_element; id != nil {
-			return []ent.Value{*id}
+	case jkhunit.EdgeBuildings:
+		ids := make([]ent.Value, 0, len(m.buildings))
+		for id := range m.buildings {
+			ids = append(ids, id)
 		}
+		return ids
+	case jkhunit.EdgeAssignedInspectors:
+		ids := make([]ent.Value, 0, len(m.assigned_inspectors))
+		for id := range m.assigned_inspectors {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InspectionResultMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *JkhUnitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedbuildings != nil {
+		edges = append(edges, jkhunit.EdgeBuildings)
+	}
+	if m.removedassigned_inspectors != nil {
+		edges = append(edges, jkhunit.EdgeAssignedInspectors)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InspectionResultMutation) RemovedIDs(name string) []ent.Value {
+func (m *JkhUnitMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case jkhunit.EdgeBuildings:
+		ids := make([]ent.Value, 0, len(m.removedbuildings))
+		for id := range m.removedbuildings {
+			ids = append(ids, id)
+		}
+		return ids
+	case jkhunit.EdgeAssignedInspectors:
+		ids := make([]ent.Value, 0, len(m.removedassigned_inspectors))
+		for id := range m.removedassigned_inspectors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InspectionResultMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtask {
-		edges = append(edges, inspectionresult.EdgeTask)
+func (m *JkhUnitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareddistrict {
+		edges = append(edges, jkhunit.EdgeDistrict)
 	}
-	if m.clearedchecklist_element {
-		edges = append(edges, inspectionresult.EdgeChecklistElement)
+	if m.clearedbuildings {
+		edges = append(edges, jkhunit.EdgeBuildings)
+	}
+	if m.clearedassigned_inspectors {
+		edges = append(edges, jkhunit.EdgeAssignedInspectors)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InspectionResultMutation) EdgeCleared(name string) bool {
+func (m *JkhUnitMutation) EdgeCleared(name string) bool {
 	switch name {
-	case inspectionresult.EdgeTask:
-		return m.clearedtask
-	case inspectionresult.EdgeChecklistElement:
-		return m.clearedchecklist_element
+	case jkhunit.EdgeDistrict:
+		return m.cleareddistrict
+	case jkhunit.EdgeBuildings:
+		return m.clearedbuildings
+	case jkhunit.EdgeAssignedInspectors:
+		return m.clearedassigned_inspectors
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InspectionResultMutation) ClearEdge(name string) error {
+func (m *JkhUnitMutation) ClearEdge(name string) error {
 	switch name {
-	case inspectionresult.EdgeTask:
-		m.ClearTask()
-		return nil
-	case inspectionresult.EdgeChecklistElement:
-		m.ClearChecklistElement()
+	case jkhunit.EdgeDistrict:
+		m.ClearDistrict()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionResult unique edge %s", name)
+	return fmt.Errorf("unknown JkhUnit unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InspectionResultMutation) ResetEdge(name string) error {
+func (m *JkhUnitMutation) ResetEdge(name string) error {
 	switch name {
-	case inspectionresult.EdgeTask:
-		m.ResetTask()
+	case jkhunit.EdgeDistrict:
+		m.ResetDistrict()
 		return nil
-	case inspectionresult.EdgeChecklistElement:
-		m.ResetChecklistElement()
+	case jkhunit.EdgeBuildings:
+		m.ResetBuildings()
+		return nil
+	case jkhunit.EdgeAssignedInspectors:
+		m.ResetAssignedInspectors()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectionResult edge %s", name)
+	return fmt.Errorf("unknown JkhUnit edge %s", name)
 }
 
-// InspectorUnitMutation represents an operation that mutates the InspectorUnit nodes in the graph.
-type InspectorUnitMutation struct {
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	clearedFields    map[string]struct{}
-	inspector        *int
-	clearedinspector bool
-	jkh_unit         *int
-	clearedjkh_unit  bool
-	done             bool
-	oldValue         func(context.Context) (*InspectorUnit, error)
-	predicates       []predicate.InspectorUnit
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	users         map[int]struct{}
+	removedusers  map[int]struct{}
+	clearedusers  bool
+	done          bool
+	oldValue      func(context.Context) (*Role, error)
+	predicates    []predicate.Role
 }
 
-var _ ent.Mutation = (*InspectorUnitMutation)(nil)
+var _ ent.Mutation = (*RoleMutation)(nil)
 
-// inspectorunitOption allows management of the mutation configuration using functional options.
-type inspectorunitOption func(*InspectorUnitMutation)
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
 
-// newInspectorUnitMutation creates new mutation for the InspectorUnit entity.
-func newInspectorUnitMutation(c config, op Op, opts ...inspectorunitOption) *InspectorUnitMutation {
-	m := &InspectorUnitMutation{
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInspectorUnit,
+		typ:           TypeRole,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4889,32 +7691,32 @@ func newInspectorUnitMutation(c config, op Op, opts ...inspectorunitOption) *Ins
 	return m
 }
 
-// withInspectorUnitID sets the ID field of the mutation.
-func withInspectorUnitID(id int) inspectorunitOption {
-	return func(m *InspectorUnitMutation) {
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int) roleOption {
+	return func(m *RoleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *InspectorUnit
+			value *Role
 		)
-		m.oldValue = func(ctx context.Context) (*InspectorUnit, error) {
+		m.oldValue = func(ctx context.Context) (*Role, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().InspectorUnit.Get(ctx, id)
+					value, err = m.Client().Role.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
 	}
-}
-
-// withInspectorUnit sets the old InspectorUnit of the mutation.
-func withInspectorUnit(node *InspectorUnit) inspectorunitOption {
-	return func(m *InspectorUnitMutation) {
-		m.oldValue = func(context.Context) (*InspectorUnit, error) {
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4923,7 +7725,7 @@ func withInspectorUnit(node *InspectorUnit) inspectorunitOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InspectorUnitMutation) Client() *Client {
+func (m RoleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4931,7 +7733,7 @@ func (m InspectorUnitMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m InspectorUnitMutation) Tx() (*Tx, error) {
+func (m RoleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4942,7 +7744,7 @@ func (m InspectorUnitMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *InspectorUnitMutation) ID() (id int, exists bool) {
+func (m *RoleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4953,7 +7755,7 @@ func (m *InspectorUnitMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *InspectorUnitMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4962,160 +7764,111 @@ func (m *InspectorUnitMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().InspectorUnit.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *InspectorUnitMutation) SetUserID(i int) {
-	m.inspector = &i
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *InspectorUnitMutation) UserID() (r int, exists bool) {
-	v := m.inspector
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the InspectorUnit entity.
-// If the InspectorUnit object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectorUnitMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *InspectorUnitMutation) ResetUserID() {
-	m.inspector = nil
-}
-
-// SetJkhUnitID sets the "jkh_unit_id" field.
-func (m *InspectorUnitMutation) SetJkhUnitID(i int) {
-	m.jkh_unit = &i
+	return oldValue.Name, nil
 }
 
-// JkhUnitID returns the value of the "jkh_unit_id" field in the mutation.
-func (m *InspectorUnitMutation) JkhUnitID() (r int, exists bool) {
-	v := m.jkh_unit
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
 }
 
-// OldJkhUnitID returns the old "jkh_unit_id" field's value of the InspectorUnit entity.
-// If the InspectorUnit object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InspectorUnitMutation) OldJkhUnitID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJkhUnitID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJkhUnitID requires an ID field in the mutation")
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *RoleMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJkhUnitID: %w", err)
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
 	}
-	return oldValue.JkhUnitID, nil
-}
-
-// ResetJkhUnitID resets all changes to the "jkh_unit_id" field.
-func (m *InspectorUnitMutation) ResetJkhUnitID() {
-	m.jkh_unit = nil
-}
-
-// SetInspectorID sets the "inspector" edge to the User entity by id.
-func (m *InspectorUnitMutation) SetInspectorID(id int) {
-	m.inspector = &id
 }
 
-// ClearInspector clears the "inspector" edge to the User entity.
-func (m *InspectorUnitMutation) ClearInspector() {
-	m.clearedinspector = true
-	m.clearedFields[inspectorunit.FieldUserID] = struct{}{}
+// ClearUsers clears the "users" edge to the User entity.
+func (m *RoleMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// InspectorCleared reports if the "inspector" edge to the User entity was cleared.
-func (m *InspectorUnitMutation) InspectorCleared() bool {
-	return m.clearedinspector
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *RoleMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// InspectorID returns the "inspector" edge ID in the mutation.
-func (m *InspectorUnitMutation) InspectorID() (id int, exists bool) {
-	if m.inspector != nil {
-		return *m.inspector, true
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *RoleMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// InspectorIDs returns the "inspector" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// InspectorID instead. It exists only for internal usage by the builders.
-func (m *InspectorUnitMutation) InspectorIDs() (ids []int) {
-	if id := m.inspector; id != nil {
-		ids = append(ids, *id)
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *RoleMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetInspector resets all changes to the "inspector" edge.
-func (m *InspectorUnitMutation) ResetInspector() {
-	m.inspector = nil
-	m.clearedinspector = false
-}
-
-// ClearJkhUnit clears the "jkh_unit" edge to the JkhUnit entity.
-func (m *InspectorUnitMutation) ClearJkhUnit() {
-	m.clearedjkh_unit = true
-	m.clearedFields[inspectorunit.FieldJkhUnitID] = struct{}{}
-}
-
-// JkhUnitCleared reports if the "jkh_unit" edge to the JkhUnit entity was cleared.
-func (m *InspectorUnitMutation) JkhUnitCleared() bool {
-	return m.clearedjkh_unit
-}
-
-// JkhUnitIDs returns the "jkh_unit" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// JkhUnitID instead. It exists only for internal usage by the builders.
-func (m *InspectorUnitMutation) JkhUnitIDs() (ids []int) {
-	if id := m.jkh_unit; id != nil {
-		ids = append(ids, *id)
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *RoleMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetJkhUnit resets all changes to the "jkh_unit" edge.
-func (m *InspectorUnitMutation) ResetJkhUnit() {
-	m.jkh_unit = nil
-	m.clearedjkh_unit = false
+// ResetUsers resets all changes to the "users" edge.
+func (m *RoleMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// Where appends a list predicates to the InspectorUnitMutation builder.
-func (m *InspectorUnitMutation) Where(ps ...predicate.InspectorUnit) {
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the InspectorUnitMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InspectorUnitMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.InspectorUnit, len(ps))
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5123,30 +7876,27 @@ func (m *InspectorUnitMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *InspectorUnitMutation) Op() Op {
+func (m *RoleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *InspectorUnitMutation) SetOp(op Op) {
+func (m *RoleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (InspectorUnit).
-func (m *InspectorUnitMutation) Type() string {
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InspectorUnitMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.inspector != nil {
-		fields = append(fields, inspectorunit.FieldUserID)
-	}
-	if m.jkh_unit != nil {
-		fields = append(fields, inspectorunit.FieldJkhUnitID)
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
 	}
 	return fields
 }
@@ -5154,12 +7904,10 @@ func (m *InspectorUnitMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InspectorUnitMutation) Field(name string) (ent.Value, bool) {
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case inspectorunit.FieldUserID:
-		return m.UserID()
-	case inspectorunit.FieldJkhUnitID:
-		return m.JkhUnitID()
+	case role.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -5167,221 +7915,213 @@ func (m *InspectorUnitMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InspectorUnitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case inspectorunit.FieldUserID:
-		return m.OldUserID(ctx)
-	case inspectorunit.FieldJkhUnitID:
-		return m.OldJkhUnitID(ctx)
+	case role.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown InspectorUnit field %s", name)
+	return nil, fmt.Errorf("unknown Role field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InspectorUnitMutation) SetField(name string, value ent.Value) error {
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case inspectorunit.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case inspectorunit.FieldJkhUnitID:
-		v, ok := value.(int)
+	case role.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJkhUnitID(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown InspectorUnit field %s", name)
+	return fmt.Errorf("unknown Role field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InspectorUnitMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *RoleMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InspectorUnitMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InspectorUnitMutation) AddField(name string, value ent.Value) error {
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown InspectorUnit numeric field %s", name)
+	return fmt.Errorf("unknown Role numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InspectorUnitMutation) ClearedFields() []string {
+func (m *RoleMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InspectorUnitMutation) FieldCleared(name string) bool {
+func (m *RoleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InspectorUnitMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown InspectorUnit nullable field %s", name)
+func (m *RoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Role nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *InspectorUnitMutation) ResetField(name string) error {
-	switch name {
-	case inspectorunit.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case inspectorunit.FieldJkhUnitID:
-		m.ResetJkhUnitID()
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectorUnit field %s", name)
+	return fmt.Errorf("unknown Role field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InspectorUnitMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.inspector != nil {
-		edges = append(edges, inspectorunit.EdgeInspector)
-	}
-	if m.jkh_unit != nil {
-		edges = append(edges, inspectorunit.EdgeJkhUnit)
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.users != nil {
+		edges = append(edges, role.EdgeUsers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InspectorUnitMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case inspectorunit.EdgeInspector:
-		if id := m.inspector; id != nil {
-			return []ent.Value{*id}
-		}
-	case inspectorunit.EdgeJkhUnit:
-		if id := m.jkh_unit; id != nil {
-			return []ent.Value{*id}
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InspectorUnitMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedusers != nil {
+		edges = append(edges, role.EdgeUsers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InspectorUnitMutation) RemovedIDs(name string) []ent.Value {
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InspectorUnitMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedinspector {
-		edges = append(edges, inspectorunit.EdgeInspector)
-	}
-	if m.clearedjkh_unit {
-		edges = append(edges, inspectorunit.EdgeJkhUnit)
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedusers {
+		edges = append(edges, role.EdgeUsers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InspectorUnitMutation) EdgeCleared(name string) bool {
+func (m *RoleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case inspectorunit.EdgeInspector:
-		return m.clearedinspector
-	case inspectorunit.EdgeJkhUnit:
-		return m.clearedjkh_unit
+	case role.EdgeUsers:
+		return m.clearedusers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InspectorUnitMutation) ClearEdge(name string) error {
+func (m *RoleMutation) ClearEdge(name string) error {
 	switch name {
-	case inspectorunit.EdgeInspector:
-		m.ClearInspector()
-		return nil
-	case inspectorunit.EdgeJkhUnit:
-		m.ClearJkhUnit()
-		return nil
 	}
-	return fmt.Errorf("unknown InspectorUnit unique edge %s", name)
+	return fmt.Errorf("unknown Role unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InspectorUnitMutation) ResetEdge(name string) error {
+func (m *RoleMutation) ResetEdge(name string) error {
 	switch name {
-	case inspectorunit.EdgeInspector:
-		m.ResetInspector()
-		return nil
-	case inspectorunit.EdgeJkhUnit:
-		m.ResetJkhUnit()
+	case role.EdgeUsers:
+		m.ResetUsers()
 		return nil
 	}
-	return fmt.Errorf("unknown InspectorUnit edge %s", name)
+	return fmt.Errorf("unknown Role edge %s", name)
 }
 
-// JkhUnitMutation represents an operation that mutates the JkhUnit nodes in the graph.
-type JkhUnitMutation struct {
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	name                       *string
-	clearedFields              map[string]struct{}
-	district                   *int
-	cleareddistrict            bool
-	buildings                  map[int]struct{}
-	removedbuildings           map[int]struct{}
-	clearedbuildings           bool
-	assigned_inspectors        map[int]struct{}
-	removedassigned_inspectors map[int]struct{}
-	clearedassigned_inspectors bool
-	done                       bool
-	oldValue                   func(context.Context) (*JkhUnit, error)
-	predicates                 []predicate.JkhUnit
+	op                      Op
+	typ                     string
+	id                      *int
+	title                   *string
+	priority                *string
+	status                  *task.Status
+	description             *string
+	scheduled_date          *time.Time
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	inspector               *int
+	clearedinspector        bool
+	building                *int
+	clearedbuilding         bool
+	checklist               *int
+	clearedchecklist        bool
+	results                 map[int]struct{}
+	removedresults          map[int]struct{}
+	clearedresults          bool
+	act                     *int
+	clearedact              bool
+	status_history          map[int]struct{}
+	removedstatus_history   map[int]struct{}
+	clearedstatus_history   bool
+	extra_checklists        map[int]struct{}
+	removedextra_checklists map[int]struct{}
+	clearedextra_checklists bool
+	done                    bool
+	oldValue                func(context.Context) (*Task, error)
+	predicates              []predicate.Task
 }
 
-var _ ent.Mutation = (*JkhUnitMutation)(nil)
+var _ ent.Mutation = (*TaskMutation)(nil)
 
-// jkhunitOption allows management of the mutation configuration using functional options.
-type jkhunitOption func(*JkhUnitMutation)
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
 
-// newJkhUnitMutation creates new mutation for the JkhUnit entity.
-func newJkhUnitMutation(c config, op Op, opts ...jkhunitOption) *JkhUnitMutation {
-	m := &JkhUnitMutation{
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJkhUnit,
+		typ:           TypeTask,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5390,20 +8130,20 @@ func newJkhUnitMutation(c config, op Op, opts ...jkhunitOption) *JkhUnitMutation
 	return m
 }
 
-// withJkhUnitID sets the ID field of the mutation.
-func withJkhUnitID(id int) jkhunitOption {
-	return func(m *JkhUnitMutation) {
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id int) taskOption {
+	return func(m *TaskMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *JkhUnit
+			value *Task
 		)
-		m.oldValue = func(ctx context.Context) (*JkhUnit, error) {
+		m.oldValue = func(ctx context.Context) (*Task, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().JkhUnit.Get(ctx, id)
+					value, err = m.Client().Task.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5412,762 +8152,727 @@ func withJkhUnitID(id int) jkhunitOption {
 	}
 }
 
-// withJkhUnit sets the old JkhUnit of the mutation.
-func withJkhUnit(node *JkhUnit) jkhunitOption {
-	return func(m *JkhUnitMutation) {
-		m.oldValue = func(context.Context) (*JkhUnit, error) {
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JkhUnitMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBuildingID sets the "building_id" field.
+func (m *TaskMutation) SetBuildingID(i int) {
+	m.building = &i
+}
+
+// BuildingID returns the value of the "building_id" field in the mutation.
+func (m *TaskMutation) BuildingID() (r int, exists bool) {
+	v := m.building
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildingID returns the old "building_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldBuildingID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildingID: %w", err)
+	}
+	return oldValue.BuildingID, nil
+}
+
+// ResetBuildingID resets all changes to the "building_id" field.
+func (m *TaskMutation) ResetBuildingID() {
+	m.building = nil
+}
+
+// SetChecklistID sets the "checklist_id" field.
+func (m *TaskMutation) SetChecklistID(i int) {
+	m.checklist = &i
+}
+
+// ChecklistID returns the value of the "checklist_id" field in the mutation.
+func (m *TaskMutation) ChecklistID() (r int, exists bool) {
+	v := m.checklist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecklistID returns the old "checklist_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldChecklistID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecklistID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecklistID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecklistID: %w", err)
+	}
+	return oldValue.ChecklistID, nil
+}
+
+// ResetChecklistID resets all changes to the "checklist_id" field.
+func (m *TaskMutation) ResetChecklistID() {
+	m.checklist = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m JkhUnitMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetInspectorID sets the "inspector_id" field.
+func (m *TaskMutation) SetInspectorID(i int) {
+	m.inspector = &i
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *JkhUnitMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// InspectorID returns the value of the "inspector_id" field in the mutation.
+func (m *TaskMutation) InspectorID() (r int, exists bool) {
+	v := m.inspector
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *JkhUnitMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().JkhUnit.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldInspectorID returns the old "inspector_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldInspectorID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInspectorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInspectorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInspectorID: %w", err)
 	}
+	return oldValue.InspectorID, nil
 }
 
-// SetDistrictID sets the "district_id" field.
-func (m *JkhUnitMutation) SetDistrictID(i int) {
-	m.district = &i
+// ResetInspectorID resets all changes to the "inspector_id" field.
+func (m *TaskMutation) ResetInspectorID() {
+	m.inspector = nil
 }
 
-// DistrictID returns the value of the "district_id" field in the mutation.
-func (m *JkhUnitMutation) DistrictID() (r int, exists bool) {
-	v := m.district
+// SetTitle sets the "title" field.
+func (m *TaskMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaskMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDistrictID returns the old "district_id" field's value of the JkhUnit entity.
-// If the JkhUnit object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JkhUnitMutation) OldDistrictID(ctx context.Context) (v int, err error) {
+func (m *TaskMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDistrictID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDistrictID requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDistrictID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.DistrictID, nil
+	return oldValue.Title, nil
 }
 
-// ResetDistrictID resets all changes to the "district_id" field.
-func (m *JkhUnitMutation) ResetDistrictID() {
-	m.district = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *TaskMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetName sets the "name" field.
-func (m *JkhUnitMutation) SetName(s string) {
-	m.name = &s
+// SetPriority sets the "priority" field.
+func (m *TaskMutation) SetPriority(s string) {
+	m.priority = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *JkhUnitMutation) Name() (r string, exists bool) {
-	v := m.name
+// Priority returns the value of the "priority" field in the mutation.
+func (m *TaskMutation) Priority() (r string, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the JkhUnit entity.
-// If the JkhUnit object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JkhUnitMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TaskMutation) OldPriority(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *JkhUnitMutation) ResetName() {
-	m.name = nil
+	return oldValue.Priority, nil
 }
 
-// ClearDistrict clears the "district" edge to the District entity.
-func (m *JkhUnitMutation) ClearDistrict() {
-	m.cleareddistrict = true
-	m.clearedFields[jkhunit.FieldDistrictID] = struct{}{}
+// ResetPriority resets all changes to the "priority" field.
+func (m *TaskMutation) ResetPriority() {
+	m.priority = nil
 }
 
-// DistrictCleared reports if the "district" edge to the District entity was cleared.
-func (m *JkhUnitMutation) DistrictCleared() bool {
-	return m.cleareddistrict
+// SetStatus sets the "status" field.
+func (m *TaskMutation) SetStatus(t task.Status) {
+	m.status = &t
 }
 
-// DistrictIDs returns the "district" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DistrictID instead. It exists only for internal usage by the builders.
-func (m *JkhUnitMutation) DistrictIDs() (ids []int) {
-	if id := m.district; id != nil {
-		ids = append(ids, *id)
+// Status returns the value of the "status" field in the mutation.
+func (m *TaskMutation) Status() (r task.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetDistrict resets all changes to the "district" edge.
-func (m *JkhUnitMutation) ResetDistrict() {
-	m.district = nil
-	m.cleareddistrict = false
+	return *v, true
 }
 
-// AddBuildingIDs adds the "buildings" edge to the Building entity by ids.
-func (m *JkhUnitMutation) AddBuildingIDs(ids ...int) {
-	if m.buildings == nil {
-		m.buildings = make(map[int]struct{})
+// OldStatus returns the old "status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.buildings[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ClearBuildings clears the "buildings" edge to the Building entity.
-func (m *JkhUnitMutation) ClearBuildings() {
-	m.clearedbuildings = true
+// ResetStatus resets all changes to the "status" field.
+func (m *TaskMutation) ResetStatus() {
+	m.status = nil
 }
 
-// BuildingsCleared reports if the "buildings" edge to the Building entity was cleared.
-func (m *JkhUnitMutation) BuildingsCleared() bool {
-	return m.clearedbuildings
+// SetDescription sets the "description" field.
+func (m *TaskMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// RemoveBuildingIDs removes the "buildings" edge to the Building entity by IDs.
-func (m *JkhUnitMutation) RemoveBuildingIDs(ids ...int) {
-	if m.removedbuildings == nil {
-		m.removedbuildings = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.buildings, ids[i])
-		m.removedbuildings[ids[i]] = struct{}{}
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedBuildings returns the removed IDs of the "buildings" edge to the Building entity.
-func (m *JkhUnitMutation) RemovedBuildingsIDs() (ids []int) {
-	for id := range m.removedbuildings {
-		ids = append(ids, id)
+// OldDescription returns the old "description" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// BuildingsIDs returns the "buildings" edge IDs in the mutation.
-func (m *JkhUnitMutation) BuildingsIDs() (ids []int) {
-	for id := range m.buildings {
-		ids = append(ids, id)
-	}
-	return
+// ClearDescription clears the value of the "description" field.
+func (m *TaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[task.FieldDescription] = struct{}{}
 }
 
-// ResetBuildings resets all changes to the "buildings" edge.
-func (m *JkhUnitMutation) ResetBuildings() {
-	m.buildings = nil
-	m.clearedbuildings = false
-	m.removedbuildings = nil
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[task.FieldDescription]
+	return ok
 }
 
-// AddAssignedInspectorIDs adds the "assigned_inspectors" edge to the InspectorUnit entity by ids.
-func (m *JkhUnitMutation) AddAssignedInspectorIDs(ids ...int) {
-	if m.assigned_inspectors == nil {
-		m.assigned_inspectors = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.assigned_inspectors[ids[i]] = struct{}{}
-	}
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, task.FieldDescription)
 }
 
-// ClearAssignedInspectors clears the "assigned_inspectors" edge to the InspectorUnit entity.
-func (m *JkhUnitMutation) ClearAssignedInspectors() {
-	m.clearedassigned_inspectors = true
+// SetScheduledDate sets the "scheduled_date" field.
+func (m *TaskMutation) SetScheduledDate(t time.Time) {
+	m.scheduled_date = &t
 }
 
-// AssignedInspectorsCleared reports if the "assigned_inspectors" edge to the InspectorUnit entity was cleared.
-func (m *JkhUnitMutation) AssignedInspectorsCleared() bool {
-	return m.clearedassigned_inspectors
+// ScheduledDate returns the value of the "scheduled_date" field in the mutation.
+func (m *TaskMutation) ScheduledDate() (r time.Time, exists bool) {
+	v := m.scheduled_date
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveAssignedInspectorIDs removes the "assigned_inspectors" edge to the InspectorUnit entity by IDs.
-func (m *JkhUnitMutation) RemoveAssignedInspectorIDs(ids ...int) {
-	if m.removedassigned_inspectors == nil {
-		m.removedassigned_inspectors = make(map[int]struct{})
+// OldScheduledDate returns the old "scheduled_date" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldScheduledDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledDate is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.assigned_inspectors, ids[i])
-		m.removedassigned_inspectors[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledDate requires an ID field in the mutation")
 	}
-}
-
-// RemovedAssignedInspectors returns the removed IDs of the "assigned_inspectors" edge to the InspectorUnit entity.
-func (m *JkhUnitMutation) RemovedAssignedInspectorsIDs() (ids []int) {
-	for id := range m.removedassigned_inspectors {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledDate: %w", err)
 	}
-	return
+	return oldValue.ScheduledDate, nil
 }
 
-// AssignedInspectorsIDs returns the "assigned_inspectors" edge IDs in the mutation.
-func (m *JkhUnitMutation) AssignedInspectorsIDs() (ids []int) {
-	for id := range m.assigned_inspectors {
-		ids = append(ids, id)
-	}
-	return
+// ResetScheduledDate resets all changes to the "scheduled_date" field.
+func (m *TaskMutation) ResetScheduledDate() {
+	m.scheduled_date = nil
 }
 
-// ResetAssignedInspectors resets all changes to the "assigned_inspectors" edge.
-func (m *JkhUnitMutation) ResetAssignedInspectors() {
-	m.assigned_inspectors = nil
-	m.clearedassigned_inspectors = false
-	m.removedassigned_inspectors = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Where appends a list predicates to the JkhUnitMutation builder.
-func (m *JkhUnitMutation) Where(ps ...predicate.JkhUnit) {
-	m.predicates = append(m.predicates, ps...)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the JkhUnitMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JkhUnitMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.JkhUnit, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldCreatedAt returns the old "created_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// Op returns the operation name.
-func (m *JkhUnitMutation) Op() Op {
-	return m.op
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *JkhUnitMutation) SetOp(op Op) {
-	m.op = op
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Type returns the node type of this mutation (JkhUnit).
-func (m *JkhUnitMutation) Type() string {
-	return m.typ
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *JkhUnitMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.district != nil {
-		fields = append(fields, jkhunit.FieldDistrictID)
+// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.name != nil {
-		fields = append(fields, jkhunit.FieldName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *JkhUnitMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case jkhunit.FieldDistrictID:
-		return m.DistrictID()
-	case jkhunit.FieldName:
-		return m.Name()
-	}
-	return nil, false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *JkhUnitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case jkhunit.FieldDistrictID:
-		return m.OldDistrictID(ctx)
-	case jkhunit.FieldName:
-		return m.OldName(ctx)
-	}
-	return nil, fmt.Errorf("unknown JkhUnit field %s", name)
+// ClearInspector clears the "inspector" edge to the User entity.
+func (m *TaskMutation) ClearInspector() {
+	m.clearedinspector = true
+	m.clearedFields[task.FieldInspectorID] = struct{}{}
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *JkhUnitMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case jkhunit.FieldDistrictID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDistrictID(v)
-		return nil
-	case jkhunit.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
+// InspectorCleared reports if the "inspector" edge to the User entity was cleared.
+func (m *TaskMutation) InspectorCleared() bool {
+	return m.clearedinspector
+}
+
+// InspectorIDs returns the "inspector" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InspectorID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) InspectorIDs() (ids []int) {
+	if id := m.inspector; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown JkhUnit field %s", name)
+	return
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *JkhUnitMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// ResetInspector resets all changes to the "inspector" edge.
+func (m *TaskMutation) ResetInspector() {
+	m.inspector = nil
+	m.clearedinspector = false
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *JkhUnitMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
-	return nil, false
+// ClearBuilding clears the "building" edge to the Building entity.
+func (m *TaskMutation) ClearBuilding() {
+	m.clearedbuilding = true
+	m.clearedFields[task.FieldBuildingID] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *JkhUnitMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown JkhUnit numeric field %s", name)
+// BuildingCleared reports if the "building" edge to the Building entity was cleared.
+func (m *TaskMutation) BuildingCleared() bool {
+	return m.clearedbuilding
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *JkhUnitMutation) ClearedFields() []string {
-	return nil
+// BuildingIDs returns the "building" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuildingID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) BuildingIDs() (ids []int) {
+	if id := m.building; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *JkhUnitMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetBuilding resets all changes to the "building" edge.
+func (m *TaskMutation) ResetBuilding() {
+	m.building = nil
+	m.clearedbuilding = false
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *JkhUnitMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown JkhUnit nullable field %s", name)
+// ClearChecklist clears the "checklist" edge to the Checklist entity.
+func (m *TaskMutation) ClearChecklist() {
+	m.clearedchecklist = true
+	m.clearedFields[task.FieldChecklistID] = struct{}{}
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *JkhUnitMutation) ResetField(name string) error {
-	switch name {
-	case jkhunit.FieldDistrictID:
-		m.ResetDistrictID()
-		return nil
-	case jkhunit.FieldName:
-		m.ResetName()
-		return nil
-	}
-	return fmt.Errorf("unknown JkhUnit field %s", name)
+// ChecklistCleared reports if the "checklist" edge to the Checklist entity was cleared.
+func (m *TaskMutation) ChecklistCleared() bool {
+	return m.clearedchecklist
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JkhUnitMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.district != nil {
-		edges = append(edges, jkhunit.EdgeDistrict)
-	}
-	if m.buildings != nil {
-		edges = append(edges, jkhunit.EdgeBuildings)
-	}
-	if m.assigned_inspectors != nil {
-		edges = append(edges, jkhunit.EdgeAssignedInspectors)
+// ChecklistIDs returns the "checklist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChecklistID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) ChecklistIDs() (ids []int) {
+	if id := m.checklist; id != nil {
+		ids = append(ids, *id)
 	}
-	return edges
+	return
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *JkhUnitMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case jkhunit.EdgeDistrict:
-		if id := m.district; id != nil {
-			return []ent.Value{*id}
-		}
-	case jkhunit.EdgeBuildings:
-		ids := make([]ent.Value, 0, len(m.buildings))
-		for id := range m.buildings {
-			ids = append(ids, id)
-		}
-		return ids
-	case jkhunit.EdgeAssignedInspectors:
-		ids := make([]ent.Value, 0, len(m.assigned_inspectors))
-		for id := range m.assigned_inspectors {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetChecklist resets all changes to the "checklist" edge.
+func (m *TaskMutation) ResetChecklist() {
+	m.checklist = nil
+	m.clearedchecklist = false
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JkhUnitMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedbuildings != nil {
-		edges = append(edges, jkhunit.EdgeBuildings)
+// AddResultIDs adds the "results" edge to the InspectionResult entity by ids.
+func (m *TaskMutation) AddResultIDs(ids ...int) {
+	if m.results == nil {
+		m.results = make(map[int]struct{})
 	}
-	if m.removedassigned_inspectors != nil {
-		edges = append(edges, jkhunit.EdgeAssignedInspectors)
+	for i := range ids {
+		m.results[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *JkhUnitMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case jkhunit.EdgeBuildings:
-		ids := make([]ent.Value, 0, len(m.removedbuildings))
-		for id := range m.removedbuildings {
-			ids = append(ids, id)
-		}
-		return ids
-	case jkhunit.EdgeAssignedInspectors:
-		ids := make([]ent.Value, 0, len(m.removedassigned_inspectors))
-		for id := range m.removedassigned_inspectors {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearResults clears the "results" edge to the InspectionResult entity.
+func (m *TaskMutation) ClearResults() {
+	m.clearedresults = true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JkhUnitMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareddistrict {
-		edges = append(edges, jkhunit.EdgeDistrict)
-	}
-	if m.clearedbuildings {
-		edges = append(edges, jkhunit.EdgeBuildings)
-	}
-	if m.clearedassigned_inspectors {
-		edges = append(edges, jkhunit.EdgeAssignedInspectors)
-	}
-	return edges
+// ResultsCleared reports if the "results" edge to the InspectionResult entity was cleared.
+func (m *TaskMutation) ResultsCleared() bool {
+	return m.clearedresults
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *JkhUnitMutation) EdgeCleared(name string) bool {
-	switch name {
-	case jkhunit.EdgeDistrict:
-		return m.cleareddistrict
-	case jkhunit.EdgeBuildings:
-		return m.clearedbuildings
-	case jkhunit.EdgeAssignedInspectors:
-		return m.clearedassigned_inspectors
+// RemoveResultIDs removes the "results" edge to the InspectionResult entity by IDs.
+func (m *TaskMutation) RemoveResultIDs(ids ...int) {
+	if m.removedresults == nil {
+		m.removedresults = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.results, ids[i])
+		m.removedresults[ids[i]] = struct{}{}
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *JkhUnitMutation) ClearEdge(name string) error {
-	switch name {
-	case jkhunit.EdgeDistrict:
-		m.ClearDistrict()
-		return nil
+// RemovedResults returns the removed IDs of the "results" edge to the InspectionResult entity.
+func (m *TaskMutation) RemovedResultsIDs() (ids []int) {
+	for id := range m.removedresults {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown JkhUnit unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *JkhUnitMutation) ResetEdge(name string) error {
-	switch name {
-	case jkhunit.EdgeDistrict:
-		m.ResetDistrict()
-		return nil
-	case jkhunit.EdgeBuildings:
-		m.ResetBuildings()
-		return nil
-	case jkhunit.EdgeAssignedInspectors:
-		m.ResetAssignedInspectors()
-		return nil
+// ResultsIDs returns the "results" edge IDs in the mutation.
+func (m *TaskMutation) ResultsIDs() (ids []int) {
+	for id := range m.results {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown JkhUnit edge %s", name)
+	return
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	clearedFields map[string]struct{}
-	users         map[int]struct{}
-	removedusers  map[int]struct{}
-	clearedusers  bool
-	done          bool
-	oldValue      func(context.Context) (*Role, error)
-	predicates    []predicate.Role
+// ResetResults resets all changes to the "results" edge.
+func (m *TaskMutation) ResetResults() {
+	m.results = nil
+	m.clearedresults = false
+	m.removedresults = nil
 }
 
-var _ ent.Mutation = (*RoleMutation)(nil)
-
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
-
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRole,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetActID sets the "act" edge to the InspectionAct entity by id.
+func (m *TaskMutation) SetActID(id int) {
+	m.act = &id
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id int) roleOption {
-	return func(m *RoleMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Role
-		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Role.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearAct clears the "act" edge to the InspectionAct entity.
+func (m *TaskMutation) ClearAct() {
+	m.clearedact = true
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ActCleared reports if the "act" edge to the InspectionAct entity was cleared.
+func (m *TaskMutation) ActCleared() bool {
+	return m.clearedact
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ActID returns the "act" edge ID in the mutation.
+func (m *TaskMutation) ActID() (id int, exists bool) {
+	if m.act != nil {
+		return *m.act, true
+	}
+	return
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// ActIDs returns the "act" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) ActIDs() (ids []int) {
+	if id := m.act; id != nil {
+		ids = append(ids, *id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetAct resets all changes to the "act" edge.
+func (m *TaskMutation) ResetAct() {
+	m.act = nil
+	m.clearedact = false
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddStatusHistoryIDs adds the "status_history" edge to the TaskStatusHistory entity by ids.
+func (m *TaskMutation) AddStatusHistoryIDs(ids ...int) {
+	if m.status_history == nil {
+		m.status_history = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.status_history[ids[i]] = struct{}{}
 	}
 }
 
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
+// ClearStatusHistory clears the "status_history" edge to the TaskStatusHistory entity.
+func (m *TaskMutation) ClearStatusHistory() {
+	m.clearedstatus_history = true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
+// StatusHistoryCleared reports if the "status_history" edge to the TaskStatusHistory entity was cleared.
+func (m *TaskMutation) StatusHistoryCleared() bool {
+	return m.clearedstatus_history
 }
 
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+// RemoveStatusHistoryIDs removes the "status_history" edge to the TaskStatusHistory entity by IDs.
+func (m *TaskMutation) RemoveStatusHistoryIDs(ids ...int) {
+	if m.removedstatus_history == nil {
+		m.removedstatus_history = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.status_history, ids[i])
+		m.removedstatus_history[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+}
+
+// RemovedStatusHistory returns the removed IDs of the "status_history" edge to the TaskStatusHistory entity.
+func (m *TaskMutation) RemovedStatusHistoryIDs() (ids []int) {
+	for id := range m.removedstatus_history {
+		ids = append(ids, id)
 	}
-	return oldValue.Name, nil
+	return
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
+// StatusHistoryIDs returns the "status_history" edge IDs in the mutation.
+func (m *TaskMutation) StatusHistoryIDs() (ids []int) {
+	for id := range m.status_history {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *RoleMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
+// ResetStatusHistory resets all changes to the "status_history" edge.
+func (m *TaskMutation) ResetStatusHistory() {
+	m.status_history = nil
+	m.clearedstatus_history = false
+	m.removedstatus_history = nil
+}
+
+// AddExtraChecklistIDs adds the "extra_checklists" edge to the TaskChecklist entity by ids.
+func (m *TaskMutation) AddExtraChecklistIDs(ids ...int) {
+	if m.extra_checklists == nil {
+		m.extra_checklists = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+		m.extra_checklists[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *RoleMutation) ClearUsers() {
-	m.clearedusers = true
+// ClearExtraChecklists clears the "extra_checklists" edge to the TaskChecklist entity.
+func (m *TaskMutation) ClearExtraChecklists() {
+	m.clearedextra_checklists = true
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *RoleMutation) UsersCleared() bool {
-	return m.clearedusers
+// ExtraChecklistsCleared reports if the "extra_checklists" edge to the TaskChecklist entity was cleared.
+func (m *TaskMutation) ExtraChecklistsCleared() bool {
+	return m.clearedextra_checklists
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *RoleMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
+// RemoveExtraChecklistIDs removes the "extra_checklists" edge to the TaskChecklist entity by IDs.
+func (m *TaskMutation) RemoveExtraChecklistIDs(ids ...int) {
+	if m.removedextra_checklists == nil {
+		m.removedextra_checklists = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+		delete(m.extra_checklists, ids[i])
+		m.removedextra_checklists[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *RoleMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
+// RemovedExtraChecklists returns the removed IDs of the "extra_checklists" edge to the TaskChecklist entity.
+func (m *TaskMutation) RemovedExtraChecklistsIDs() (ids []int) {
+	for id := range m.removedextra_checklists {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *RoleMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
+// ExtraChecklistsIDs returns the "extra_checklists" edge IDs in the mutation.
+func (m *TaskMutation) ExtraChecklistsIDs() (ids []int) {
+	for id := range m.extra_checklists {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *RoleMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetExtraChecklists resets all changes to the "extra_checklists" edge.
+func (m *TaskMutation) ResetExtraChecklists() {
+	m.extra_checklists = nil
+	m.clearedextra_checklists = false
+	m.removedextra_checklists = nil
 }
 
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6175,27 +8880,54 @@ func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleMutation) Op() Op {
+func (m *TaskMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
+func (m *TaskMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.building != nil {
+		fields = append(fields, task.FieldBuildingID)
+	}
+	if m.checklist != nil {
+		fields = append(fields, task.FieldChecklistID)
+	}
+	if m.inspector != nil {
+		fields = append(fields, task.FieldInspectorID)
+	}
+	if m.title != nil {
+		fields = append(fields, task.FieldTitle)
+	}
+	if m.priority != nil {
+		fields = append(fields, task.FieldPriority)
+	}
+	if m.status != nil {
+		fields = append(fields, task.FieldStatus)
+	}
+	if m.description != nil {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.scheduled_date != nil {
+		fields = append(fields, task.FieldScheduledDate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, task.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, task.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6203,10 +8935,28 @@ func (m *RoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldName:
-		return m.Name()
+	case task.FieldBuildingID:
+		return m.BuildingID()
+	case task.FieldChecklistID:
+		return m.ChecklistID()
+	case task.FieldInspectorID:
+		return m.InspectorID()
+	case task.FieldTitle:
+		return m.Title()
+	case task.FieldPriority:
+		return m.Priority()
+	case task.FieldStatus:
+		return m.Status()
+	case task.FieldDescription:
+		return m.Description()
+	case task.FieldScheduledDate:
+		return m.ScheduledDate()
+	case task.FieldCreatedAt:
+		return m.CreatedAt()
+	case task.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6214,98 +8964,264 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldName:
-		return m.OldName(ctx)
+	case task.FieldBuildingID:
+		return m.OldBuildingID(ctx)
+	case task.FieldChecklistID:
+		return m.OldChecklistID(ctx)
+	case task.FieldInspectorID:
+		return m.OldInspectorID(ctx)
+	case task.FieldTitle:
+		return m.OldTitle(ctx)
+	case task.FieldPriority:
+		return m.OldPriority(ctx)
+	case task.FieldStatus:
+		return m.OldStatus(ctx)
+	case task.FieldDescription:
+		return m.OldDescription(ctx)
+	case task.FieldScheduledDate:
+		return m.OldScheduledDate(ctx)
+	case task.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case task.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown Task field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldName:
+	case task.FieldBuildingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildingID(v)
+		return nil
+	case task.FieldChecklistID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecklistID(v)
+		return nil
+	case task.FieldInspectorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInspectorID(v)
+		return nil
+	case task.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case task.FieldPriority:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case task.FieldStatus:
+		v, ok := value.(task.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case task.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDescription(v)
+		return nil
+	case task.FieldScheduledDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledDate(v)
+		return nil
+	case task.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case task.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
-	return nil
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown Task numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
-	return nil
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(task.FieldDescription) {
+		fields = append(fields, task.FieldDescription)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *TaskMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Role nullable field %s", name)
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Task nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *TaskMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldName:
-		m.ResetName()
+	case task.FieldBuildingID:
+		m.ResetBuildingID()
+		return nil
+	case task.FieldChecklistID:
+		m.ResetChecklistID()
+		return nil
+	case task.FieldInspectorID:
+		m.ResetInspectorID()
+		return nil
+	case task.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case task.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case task.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case task.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case task.FieldScheduledDate:
+		m.ResetScheduledDate()
+		return nil
+	case task.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case task.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.users != nil {
-		edges = append(edges, role.EdgeUsers)
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.inspector != nil {
+		edges = append(edges, task.EdgeInspector)
+	}
+	if m.building != nil {
+		edges = append(edges, task.EdgeBuilding)
+	}
+	if m.checklist != nil {
+		edges = append(edges, task.EdgeChecklist)
+	}
+	if m.results != nil {
+		edges = append(edges, task.EdgeResults)
+	}
+	if m.act != nil {
+		edges = append(edges, task.EdgeAct)
+	}
+	if m.status_history != nil {
+		edges = append(edges, task.EdgeStatusHistory)
+	}
+	if m.extra_checklists != nil {
+		edges = append(edges, task.EdgeExtraChecklists)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
+	case task.EdgeInspector:
+		if id := m.inspector; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeBuilding:
+		if id := m.building; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeChecklist:
+		if id := m.checklist; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeResults:
+		ids := make([]ent.Value, 0, len(m.results))
+		for id := range m.results {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeAct:
+		if id := m.act; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeStatusHistory:
+		ids := make([]ent.Value, 0, len(m.status_history))
+		for id := range m.status_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeExtraChecklists:
+		ids := make([]ent.Value, 0, len(m.extra_checklists))
+		for id := range m.extra_checklists {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6314,21 +9230,39 @@ func (m *RoleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedusers != nil {
-		edges = append(edges, role.EdgeUsers)
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedresults != nil {
+		edges = append(edges, task.EdgeResults)
+	}
+	if m.removedstatus_history != nil {
+		edges = append(edges, task.EdgeStatusHistory)
+	}
+	if m.removedextra_checklists != nil {
+		edges = append(edges, task.EdgeExtraChecklists)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
+	case task.EdgeResults:
+		ids := make([]ent.Value, 0, len(m.removedresults))
+		for id := range m.removedresults {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeStatusHistory:
+		ids := make([]ent.Value, 0, len(m.removedstatus_history))
+		for id := range m.removedstatus_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeExtraChecklists:
+		ids := make([]ent.Value, 0, len(m.removedextra_checklists))
+		for id := range m.removedextra_checklists {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6337,84 +9271,130 @@ func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedusers {
-		edges = append(edges, role.EdgeUsers)
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedinspector {
+		edges = append(edges, task.EdgeInspector)
+	}
+	if m.clearedbuilding {
+		edges = append(edges, task.EdgeBuilding)
+	}
+	if m.clearedchecklist {
+		edges = append(edges, task.EdgeChecklist)
+	}
+	if m.clearedresults {
+		edges = append(edges, task.EdgeResults)
+	}
+	if m.clearedact {
+		edges = append(edges, task.EdgeAct)
+	}
+	if m.clearedstatus_history {
+		edges = append(edges, task.EdgeStatusHistory)
+	}
+	if m.clearedextra_checklists {
+		edges = append(edges, task.EdgeExtraChecklists)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
+func (m *TaskMutation) EdgeCleared(name string) bool {
 	switch name {
-	case role.EdgeUsers:
-		return m.clearedusers
+	case task.EdgeInspector:
+		return m.clearedinspector
+	case task.EdgeBuilding:
+		return m.clearedbuilding
+	case task.EdgeChecklist:
+		return m.clearedchecklist
+	case task.EdgeResults:
+		return m.clearedresults
+	case task.EdgeAct:
+		return m.clearedact
+	case task.EdgeStatusHistory:
+		return m.clearedstatus_history
+	case task.EdgeExtraChecklists:
+		return m.clearedextra_checklists
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
+func (m *TaskMutation) ClearEdge(name string) error {
 	switch name {
+	case task.EdgeInspector:
+		m.ClearInspector()
+		return nil
+	case task.EdgeBuilding:
+		m.ClearBuilding()
+		return nil
+	case task.EdgeChecklist:
+		m.ClearChecklist()
+		return nil
+	case task.EdgeAct:
+		m.ClearAct()
+		return nil
 	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
+	return fmt.Errorf("unknown Task unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
+func (m *TaskMutation) ResetEdge(name string) error {
 	switch name {
-	case role.EdgeUsers:
-		m.ResetUsers()
+	case task.EdgeInspector:
+		m.ResetInspector()
+		return nil
+	case task.EdgeBuilding:
+		m.ResetBuilding()
+		return nil
+	case task.EdgeChecklist:
+		m.ResetChecklist()
+		return nil
+	case task.EdgeResults:
+		m.ResetResults()
+		return nil
+	case task.EdgeAct:
+		m.ResetAct()
+		return nil
+	case task.EdgeStatusHistory:
+		m.ResetStatusHistory()
+		return nil
+	case task.EdgeExtraChecklists:
+		m.ResetExtraChecklists()
 		return nil
 	}
-	return fmt.Errorf("unknown Role edge %s", name)
+	return fmt.Errorf("unknown Task edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// TaskChecklistMutation represents an operation that mutates the TaskChecklist nodes in the graph.
+type TaskChecklistMutation struct {
 	config
 	op               Op
 	typ              string
 	id               *int
-	title            *string
-	priority         *string
-	status           *task.Status
-	description      *string
-	scheduled_date   *time.Time
-	created_at       *time.Time
-	updated_at       *time.Time
 	clearedFields    map[string]struct{}
-	inspector        *int
-	clearedinspector bool
-	building         *int
-	clearedbuilding  bool
+	task             *int
+	clearedtask      bool
 	checklist        *int
 	clearedchecklist bool
-	results          map[int]struct{}
-	removedresults   map[int]struct{}
-	clearedresults   bool
-	act              *int
-	clearedact       bool
 	done             bool
-	oldValue         func(context.Context) (*Task, error)
-	predicates       []predicate.Task
+	oldValue         func(context.Context) (*TaskChecklist, error)
+	predicates       []predicate.TaskChecklist
 }
 
-var _ ent.Mutation = (*TaskMutation)(nil)
+var _ ent.Mutation = (*TaskChecklistMutation)(nil)
 
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
+// taskchecklistOption allows management of the mutation configuration using functional options.
+type taskchecklistOption func(*TaskChecklistMutation)
 
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+// newTaskChecklistMutation creates new mutation for the TaskChecklist entity.
+func newTaskChecklistMutation(c config, op Op, opts ...taskchecklistOption) *TaskChecklistMutation {
+	m := &TaskChecklistMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeTaskChecklist,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6423,32 +9403,32 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id int) taskOption {
-	return func(m *TaskMutation) {
+// withTaskChecklistID sets the ID field of the mutation.
+func withTaskChecklistID(id int) taskchecklistOption {
+	return func(m *TaskChecklistMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *TaskChecklist
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*TaskChecklist, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().TaskChecklist.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
-	}
-}
-
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+	}
+}
+
+// withTaskChecklist sets the old TaskChecklist of the mutation.
+func withTaskChecklist(node *TaskChecklist) taskchecklistOption {
+	return func(m *TaskChecklistMutation) {
+		m.oldValue = func(context.Context) (*TaskChecklist, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6457,7 +9437,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m TaskChecklistMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6465,7 +9445,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m TaskChecklistMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6476,7 +9456,7 @@ func (m TaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id int, exists bool) {
+func (m *TaskChecklistMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6487,7 +9467,7 @@ func (m *TaskMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TaskChecklistMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6496,55 +9476,55 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TaskChecklist.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetBuildingID sets the "building_id" field.
-func (m *TaskMutation) SetBuildingID(i int) {
-	m.building = &i
+// SetTaskID sets the "task_id" field.
+func (m *TaskChecklistMutation) SetTaskID(i int) {
+	m.task = &i
 }
 
-// BuildingID returns the value of the "building_id" field in the mutation.
-func (m *TaskMutation) BuildingID() (r int, exists bool) {
-	v := m.building
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *TaskChecklistMutation) TaskID() (r int, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuildingID returns the old "building_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the TaskChecklist entity.
+// If the TaskChecklist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldBuildingID(ctx context.Context) (v int, err error) {
+func (m *TaskChecklistMutation) OldTaskID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuildingID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuildingID requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuildingID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.BuildingID, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetBuildingID resets all changes to the "building_id" field.
-func (m *TaskMutation) ResetBuildingID() {
-	m.building = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *TaskChecklistMutation) ResetTaskID() {
+	m.task = nil
 }
 
 // SetChecklistID sets the "checklist_id" field.
-func (m *TaskMutation) SetChecklistID(i int) {
+func (m *TaskChecklistMutation) SetChecklistID(i int) {
 	m.checklist = &i
 }
 
 // ChecklistID returns the value of the "checklist_id" field in the mutation.
-func (m *TaskMutation) ChecklistID() (r int, exists bool) {
+func (m *TaskChecklistMutation) ChecklistID() (r int, exists bool) {
 	v := m.checklist
 	if v == nil {
 		return
@@ -6552,10 +9532,10 @@ func (m *TaskMutation) ChecklistID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldChecklistID returns the old "checklist_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldChecklistID returns the old "checklist_id" field's value of the TaskChecklist entity.
+// If the TaskChecklist object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldChecklistID(ctx context.Context) (v int, err error) {
+func (m *TaskChecklistMutation) OldChecklistID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChecklistID is only allowed on UpdateOne operations")
 	}
@@ -6570,494 +9550,693 @@ func (m *TaskMutation) OldChecklistID(ctx context.Context) (v int, err error) {
 }
 
 // ResetChecklistID resets all changes to the "checklist_id" field.
-func (m *TaskMutation) ResetChecklistID() {
+func (m *TaskChecklistMutation) ResetChecklistID() {
 	m.checklist = nil
 }
 
-// SetInspectorID sets the "inspector_id" field.
-func (m *TaskMutation) SetInspectorID(i int) {
-	m.inspector = &i
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TaskChecklistMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[taskchecklist.FieldTaskID] = struct{}{}
 }
 
-// InspectorID returns the value of the "inspector_id" field in the mutation.
-func (m *TaskMutation) InspectorID() (r int, exists bool) {
-	v := m.inspector
-	if v == nil {
-		return
-	}
-	return *v, true
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TaskChecklistMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
-// OldInspectorID returns the old "inspector_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldInspectorID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInspectorID is only allowed on UpdateOne operations")
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TaskChecklistMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInspectorID requires an ID field in the mutation")
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *TaskChecklistMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// ClearChecklist clears the "checklist" edge to the Checklist entity.
+func (m *TaskChecklistMutation) ClearChecklist() {
+	m.clearedchecklist = true
+	m.clearedFields[taskchecklist.FieldChecklistID] = struct{}{}
+}
+
+// ChecklistCleared reports if the "checklist" edge to the Checklist entity was cleared.
+func (m *TaskChecklistMutation) ChecklistCleared() bool {
+	return m.clearedchecklist
+}
+
+// ChecklistIDs returns the "checklist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChecklistID instead. It exists only for internal usage by the builders.
+func (m *TaskChecklistMutation) ChecklistIDs() (ids []int) {
+	if id := m.checklist; id != nil {
+		ids = append(ids, *id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInspectorID: %w", err)
+	return
+}
+
+// ResetChecklist resets all changes to the "checklist" edge.
+func (m *TaskChecklistMutation) ResetChecklist() {
+	m.checklist = nil
+	m.clearedchecklist = false
+}
+
+// Where appends a list predicates to the TaskChecklistMutation builder.
+func (m *TaskChecklistMutation) Where(ps ...predicate.TaskChecklist) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskChecklistMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskChecklistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskChecklist, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.InspectorID, nil
+	m.Where(p...)
 }
 
-// ResetInspectorID resets all changes to the "inspector_id" field.
-func (m *TaskMutation) ResetInspectorID() {
-	m.inspector = nil
+// Op returns the operation name.
+func (m *TaskChecklistMutation) Op() Op {
+	return m.op
 }
 
-// SetTitle sets the "title" field.
-func (m *TaskMutation) SetTitle(s string) {
-	m.title = &s
+// SetOp allows setting the mutation operation.
+func (m *TaskChecklistMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *TaskMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// Type returns the node type of this mutation (TaskChecklist).
+func (m *TaskChecklistMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskChecklistMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.task != nil {
+		fields = append(fields, taskchecklist.FieldTaskID)
 	}
-	return *v, true
+	if m.checklist != nil {
+		fields = append(fields, taskchecklist.FieldChecklistID)
+	}
+	return fields
 }
 
-// OldTitle returns the old "title" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskChecklistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskchecklist.FieldTaskID:
+		return m.TaskID()
+	case taskchecklist.FieldChecklistID:
+		return m.ChecklistID()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskChecklistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taskchecklist.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case taskchecklist.FieldChecklistID:
+		return m.OldChecklistID(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	return nil, fmt.Errorf("unknown TaskChecklist field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskChecklistMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskchecklist.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case taskchecklist.FieldChecklistID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecklistID(v)
+		return nil
 	}
-	return oldValue.Title, nil
+	return fmt.Errorf("unknown TaskChecklist field %s", name)
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *TaskMutation) ResetTitle() {
-	m.title = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskChecklistMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// SetPriority sets the "priority" field.
-func (m *TaskMutation) SetPriority(s string) {
-	m.priority = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskChecklistMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *TaskMutation) Priority() (r string, exists bool) {
-	v := m.priority
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskChecklistMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown TaskChecklist numeric field %s", name)
 }
 
-// OldPriority returns the old "priority" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPriority(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskChecklistMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskChecklistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskChecklistMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TaskChecklist nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskChecklistMutation) ResetField(name string) error {
+	switch name {
+	case taskchecklist.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case taskchecklist.FieldChecklistID:
+		m.ResetChecklistID()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+	return fmt.Errorf("unknown TaskChecklist field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskChecklistMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, taskchecklist.EdgeTask)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	if m.checklist != nil {
+		edges = append(edges, taskchecklist.EdgeChecklist)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskChecklistMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case taskchecklist.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case taskchecklist.EdgeChecklist:
+		if id := m.checklist; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Priority, nil
+	return nil
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *TaskMutation) ResetPriority() {
-	m.priority = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskChecklistMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// SetStatus sets the "status" field.
-func (m *TaskMutation) SetStatus(t task.Status) {
-	m.status = &t
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskChecklistMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TaskMutation) Status() (r task.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskChecklistMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, taskchecklist.EdgeTask)
 	}
-	return *v, true
+	if m.clearedchecklist {
+		edges = append(edges, taskchecklist.EdgeChecklist)
+	}
+	return edges
 }
 
-// OldStatus returns the old "status" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskChecklistMutation) EdgeCleared(name string) bool {
+	switch name {
+	case taskchecklist.EdgeTask:
+		return m.clearedtask
+	case taskchecklist.EdgeChecklist:
+		return m.clearedchecklist
 	}
-	return oldValue.Status, nil
+	return false
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TaskMutation) ResetStatus() {
-	m.status = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskChecklistMutation) ClearEdge(name string) error {
+	switch name {
+	case taskchecklist.EdgeTask:
+		m.ClearTask()
+		return nil
+	case taskchecklist.EdgeChecklist:
+		m.ClearChecklist()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskChecklist unique edge %s", name)
 }
 
-// SetDescription sets the "description" field.
-func (m *TaskMutation) SetDescription(s string) {
-	m.description = &s
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskChecklistMutation) ResetEdge(name string) error {
+	switch name {
+	case taskchecklist.EdgeTask:
+		m.ResetTask()
+		return nil
+	case taskchecklist.EdgeChecklist:
+		m.ResetChecklist()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskChecklist edge %s", name)
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TaskMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// TaskStatusHistoryMutation represents an operation that mutates the TaskStatusHistory nodes in the graph.
+type TaskStatusHistoryMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	from_status            *string
+	to_status              *string
+	changed_at             *time.Time
+	clearedFields          map[string]struct{}
+	task                   *int
+	clearedtask            bool
+	changed_by_user        *int
+	clearedchanged_by_user bool
+	done                   bool
+	oldValue               func(context.Context) (*TaskStatusHistory, error)
+	predicates             []predicate.TaskStatusHistory
+}
+
+var _ ent.Mutation = (*TaskStatusHistoryMutation)(nil)
+
+// taskstatushistoryOption allows management of the mutation configuration using functional options.
+type taskstatushistoryOption func(*TaskStatusHistoryMutation)
+
+// newTaskStatusHistoryMutation creates new mutation for the TaskStatusHistory entity.
+func newTaskStatusHistoryMutation(c config, op Op, opts ...taskstatushistoryOption) *TaskStatusHistoryMutation {
+	m := &TaskStatusHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskStatusHistory,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldDescription returns the old "description" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+// withTaskStatusHistoryID sets the ID field of the mutation.
+func withTaskStatusHistoryID(id int) taskstatushistoryOption {
+	return func(m *TaskStatusHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskStatusHistory
+		)
+		m.oldValue = func(ctx context.Context) (*TaskStatusHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskStatusHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+}
+
+// withTaskStatusHistory sets the old TaskStatusHistory of the mutation.
+func withTaskStatusHistory(node *TaskStatusHistory) taskstatushistoryOption {
+	return func(m *TaskStatusHistoryMutation) {
+		m.oldValue = func(context.Context) (*TaskStatusHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Description, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *TaskMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[task.FieldDescription] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskStatusHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TaskMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[task.FieldDescription]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskStatusHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TaskMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, task.FieldDescription)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskStatusHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetScheduledDate sets the "scheduled_date" field.
-func (m *TaskMutation) SetScheduledDate(t time.Time) {
-	m.scheduled_date = &t
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskStatusHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskStatusHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// ScheduledDate returns the value of the "scheduled_date" field in the mutation.
-func (m *TaskMutation) ScheduledDate() (r time.Time, exists bool) {
-	v := m.scheduled_date
+// SetTaskID sets the "task_id" field.
+func (m *TaskStatusHistoryMutation) SetTaskID(i int) {
+	m.task = &i
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *TaskStatusHistoryMutation) TaskID() (r int, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheduledDate returns the old "scheduled_date" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the TaskStatusHistory entity.
+// If the TaskStatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldScheduledDate(ctx context.Context) (v time.Time, err error) {
+func (m *TaskStatusHistoryMutation) OldTaskID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheduledDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheduledDate requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheduledDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.ScheduledDate, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetScheduledDate resets all changes to the "scheduled_date" field.
-func (m *TaskMutation) ResetScheduledDate() {
-	m.scheduled_date = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *TaskStatusHistoryMutation) ResetTaskID() {
+	m.task = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetFromStatus sets the "from_status" field.
+func (m *TaskStatusHistoryMutation) SetFromStatus(s string) {
+	m.from_status = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *TaskStatusHistoryMutation) FromStatus() (r string, exists bool) {
+	v := m.from_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldFromStatus returns the old "from_status" field's value of the TaskStatusHistory entity.
+// If the TaskStatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TaskStatusHistoryMutation) OldFromStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.FromStatus, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *TaskStatusHistoryMutation) ResetFromStatus() {
+	m.from_status = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TaskMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetToStatus sets the "to_status" field.
+func (m *TaskStatusHistoryMutation) SetToStatus(s string) {
+	m.to_status = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *TaskStatusHistoryMutation) ToStatus() (r string, exists bool) {
+	v := m.to_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldToStatus returns the old "to_status" field's value of the TaskStatusHistory entity.
+// If the TaskStatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TaskStatusHistoryMutation) OldToStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TaskMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearInspector clears the "inspector" edge to the User entity.
-func (m *TaskMutation) ClearInspector() {
-	m.clearedinspector = true
-	m.clearedFields[task.FieldInspectorID] = struct{}{}
-}
-
-// InspectorCleared reports if the "inspector" edge to the User entity was cleared.
-func (m *TaskMutation) InspectorCleared() bool {
-	return m.clearedinspector
-}
-
-// InspectorIDs returns the "inspector" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// InspectorID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) InspectorIDs() (ids []int) {
-	if id := m.inspector; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetInspector resets all changes to the "inspector" edge.
-func (m *TaskMutation) ResetInspector() {
-	m.inspector = nil
-	m.clearedinspector = false
-}
-
-// ClearBuilding clears the "building" edge to the Building entity.
-func (m *TaskMutation) ClearBuilding() {
-	m.clearedbuilding = true
-	m.clearedFields[task.FieldBuildingID] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
 }
 
-// BuildingCleared reports if the "building" edge to the Building entity was cleared.
-func (m *TaskMutation) BuildingCleared() bool {
-	return m.clearedbuilding
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *TaskStatusHistoryMutation) ResetToStatus() {
+	m.to_status = nil
 }
 
-// BuildingIDs returns the "building" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BuildingID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) BuildingIDs() (ids []int) {
-	if id := m.building; id != nil {
-		ids = append(ids, *id)
+// SetChangedBy sets the "changed_by" field.
+func (m *TaskStatusHistoryMutation) SetChangedBy(i int) {
+	m.changed_by_user = &i
+}
+
+// ChangedBy returns the value of the "changed_by" field in the mutation.
+func (m *TaskStatusHistoryMutation) ChangedBy() (r int, exists bool) {
+	v := m.changed_by_user
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetBuilding resets all changes to the "building" edge.
-func (m *TaskMutation) ResetBuilding() {
-	m.building = nil
-	m.clearedbuilding = false
+// OldChangedBy returns the old "changed_by" field's value of the TaskStatusHistory entity.
+// If the TaskStatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskStatusHistoryMutation) OldChangedBy(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedBy: %w", err)
+	}
+	return oldValue.ChangedBy, nil
 }
 
-// ClearChecklist clears the "checklist" edge to the Checklist entity.
-func (m *TaskMutation) ClearChecklist() {
-	m.clearedchecklist = true
-	m.clearedFields[task.FieldChecklistID] = struct{}{}
+// ClearChangedBy clears the value of the "changed_by" field.
+func (m *TaskStatusHistoryMutation) ClearChangedBy() {
+	m.changed_by_user = nil
+	m.clearedFields[taskstatushistory.FieldChangedBy] = struct{}{}
 }
 
-// ChecklistCleared reports if the "checklist" edge to the Checklist entity was cleared.
-func (m *TaskMutation) ChecklistCleared() bool {
-	return m.clearedchecklist
+// ChangedByCleared returns if the "changed_by" field was cleared in this mutation.
+func (m *TaskStatusHistoryMutation) ChangedByCleared() bool {
+	_, ok := m.clearedFields[taskstatushistory.FieldChangedBy]
+	return ok
 }
 
-// ChecklistIDs returns the "checklist" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChecklistID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) ChecklistIDs() (ids []int) {
-	if id := m.checklist; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetChangedBy resets all changes to the "changed_by" field.
+func (m *TaskStatusHistoryMutation) ResetChangedBy() {
+	m.changed_by_user = nil
+	delete(m.clearedFields, taskstatushistory.FieldChangedBy)
 }
 
-// ResetChecklist resets all changes to the "checklist" edge.
-func (m *TaskMutation) ResetChecklist() {
-	m.checklist = nil
-	m.clearedchecklist = false
+// SetChangedAt sets the "changed_at" field.
+func (m *TaskStatusHistoryMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
 }
 
-// AddResultIDs adds the "results" edge to the InspectionResult entity by ids.
-func (m *TaskMutation) AddResultIDs(ids ...int) {
-	if m.results == nil {
-		m.results = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.results[ids[i]] = struct{}{}
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *TaskStatusHistoryMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearResults clears the "results" edge to the InspectionResult entity.
-func (m *TaskMutation) ClearResults() {
-	m.clearedresults = true
+// OldChangedAt returns the old "changed_at" field's value of the TaskStatusHistory entity.
+// If the TaskStatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskStatusHistoryMutation) OldChangedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
 }
 
-// ResultsCleared reports if the "results" edge to the InspectionResult entity was cleared.
-func (m *TaskMutation) ResultsCleared() bool {
-	return m.clearedresults
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *TaskStatusHistoryMutation) ResetChangedAt() {
+	m.changed_at = nil
 }
 
-// RemoveResultIDs removes the "results" edge to the InspectionResult entity by IDs.
-func (m *TaskMutation) RemoveResultIDs(ids ...int) {
-	if m.removedresults == nil {
-		m.removedresults = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.results, ids[i])
-		m.removedresults[ids[i]] = struct{}{}
-	}
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TaskStatusHistoryMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[taskstatushistory.FieldTaskID] = struct{}{}
 }
 
-// RemovedResults returns the removed IDs of the "results" edge to the InspectionResult entity.
-func (m *TaskMutation) RemovedResultsIDs() (ids []int) {
-	for id := range m.removedresults {
-		ids = append(ids, id)
-	}
-	return
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TaskStatusHistoryMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
-// ResultsIDs returns the "results" edge IDs in the mutation.
-func (m *TaskMutation) ResultsIDs() (ids []int) {
-	for id := range m.results {
-		ids = append(ids, id)
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TaskStatusHistoryMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetResults resets all changes to the "results" edge.
-func (m *TaskMutation) ResetResults() {
-	m.results = nil
-	m.clearedresults = false
-	m.removedresults = nil
+// ResetTask resets all changes to the "task" edge.
+func (m *TaskStatusHistoryMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// SetActID sets the "act" edge to the InspectionAct entity by id.
-func (m *TaskMutation) SetActID(id int) {
-	m.act = &id
+// SetChangedByUserID sets the "changed_by_user" edge to the User entity by id.
+func (m *TaskStatusHistoryMutation) SetChangedByUserID(id int) {
+	m.changed_by_user = &id
 }
 
-// ClearAct clears the "act" edge to the InspectionAct entity.
-func (m *TaskMutation) ClearAct() {
-	m.clearedact = true
+// ClearChangedByUser clears the "changed_by_user" edge to the User entity.
+func (m *TaskStatusHistoryMutation) ClearChangedByUser() {
+	m.clearedchanged_by_user = true
+	m.clearedFields[taskstatushistory.FieldChangedBy] = struct{}{}
 }
 
-// ActCleared reports if the "act" edge to the InspectionAct entity was cleared.
-func (m *TaskMutation) ActCleared() bool {
-	return m.clearedact
+// ChangedByUserCleared reports if the "changed_by_user" edge to the User entity was cleared.
+func (m *TaskStatusHistoryMutation) ChangedByUserCleared() bool {
+	return m.ChangedByCleared() || m.clearedchanged_by_user
 }
 
-// ActID returns the "act" edge ID in the mutation.
-func (m *TaskMutation) ActID() (id int, exists bool) {
-	if m.act != nil {
-		return *m.act, true
+// ChangedByUserID returns the "changed_by_user" edge ID in the mutation.
+func (m *TaskStatusHistoryMutation) ChangedByUserID() (id int, exists bool) {
+	if m.changed_by_user != nil {
+		return *m.changed_by_user, true
 	}
 	return
 }
 
-// ActIDs returns the "act" edge IDs in the mutation.
+// ChangedByUserIDs returns the "changed_by_user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ActID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) ActIDs() (ids []int) {
-	if id := m.act; id != nil {
+// ChangedByUserID instead. It exists only for internal usage by the builders.
+func (m *TaskStatusHistoryMutation) ChangedByUserIDs() (ids []int) {
+	if id := m.changed_by_user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAct resets all changes to the "act" edge.
-func (m *TaskMutation) ResetAct() {
-	m.act = nil
-	m.clearedact = false
+// ResetChangedByUser resets all changes to the "changed_by_user" edge.
+func (m *TaskStatusHistoryMutation) ResetChangedByUser() {
+	m.changed_by_user = nil
+	m.clearedchanged_by_user = false
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
+// Where appends a list predicates to the TaskStatusHistoryMutation builder.
+func (m *TaskStatusHistoryMutation) Where(ps ...predicate.TaskStatusHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TaskStatusHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
+func (m *TaskStatusHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskStatusHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7065,54 +10244,39 @@ func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+func (m *TaskStatusHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *TaskStatusHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (TaskStatusHistory).
+func (m *TaskStatusHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.building != nil {
-		fields = append(fields, task.FieldBuildingID)
-	}
-	if m.checklist != nil {
-		fields = append(fields, task.FieldChecklistID)
-	}
-	if m.inspector != nil {
-		fields = append(fields, task.FieldInspectorID)
-	}
-	if m.title != nil {
-		fields = append(fields, task.FieldTitle)
-	}
-	if m.priority != nil {
-		fields = append(fields, task.FieldPriority)
-	}
-	if m.status != nil {
-		fields = append(fields, task.FieldStatus)
+func (m *TaskStatusHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.task != nil {
+		fields = append(fields, taskstatushistory.FieldTaskID)
 	}
-	if m.description != nil {
-		fields = append(fields, task.FieldDescription)
+	if m.from_status != nil {
+		fields = append(fields, taskstatushistory.FieldFromStatus)
 	}
-	if m.scheduled_date != nil {
-		fields = append(fields, task.FieldScheduledDate)
+	if m.to_status != nil {
+		fields = append(fields, taskstatushistory.FieldToStatus)
 	}
-	if m.created_at != nil {
-		fields = append(fields, task.FieldCreatedAt)
+	if m.changed_by_user != nil {
+		fields = append(fields, taskstatushistory.FieldChangedBy)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, task.FieldUpdatedAt)
+	if m.changed_at != nil {
+		fields = append(fields, taskstatushistory.FieldChangedAt)
 	}
 	return fields
 }
@@ -7120,28 +10284,18 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *TaskStatusHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldBuildingID:
-		return m.BuildingID()
-	case task.FieldChecklistID:
-		return m.ChecklistID()
-	case task.FieldInspectorID:
-		return m.InspectorID()
-	case task.FieldTitle:
-		return m.Title()
-	case task.FieldPriority:
-		return m.Priority()
-	case task.FieldStatus:
-		return m.Status()
-	case task.FieldDescription:
-		return m.Description()
-	case task.FieldScheduledDate:
-		return m.ScheduledDate()
-	case task.FieldCreatedAt:
-		return m.CreatedAt()
-	case task.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case taskstatushistory.FieldTaskID:
+		return m.TaskID()
+	case taskstatushistory.FieldFromStatus:
+		return m.FromStatus()
+	case taskstatushistory.FieldToStatus:
+		return m.ToStatus()
+	case taskstatushistory.FieldChangedBy:
+		return m.ChangedBy()
+	case taskstatushistory.FieldChangedAt:
+		return m.ChangedAt()
 	}
 	return nil, false
 }
@@ -7149,114 +10303,69 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case task.FieldBuildingID:
-		return m.OldBuildingID(ctx)
-	case task.FieldChecklistID:
-		return m.OldChecklistID(ctx)
-	case task.FieldInspectorID:
-		return m.OldInspectorID(ctx)
-	case task.FieldTitle:
-		return m.OldTitle(ctx)
-	case task.FieldPriority:
-		return m.OldPriority(ctx)
-	case task.FieldStatus:
-		return m.OldStatus(ctx)
-	case task.FieldDescription:
-		return m.OldDescription(ctx)
-	case task.FieldScheduledDate:
-		return m.OldScheduledDate(ctx)
-	case task.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case task.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *TaskStatusHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case task.FieldBuildingID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBuildingID(v)
-		return nil
-	case task.FieldChecklistID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChecklistID(v)
-		return nil
-	case task.FieldInspectorID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInspectorID(v)
-		return nil
-	case task.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case task.FieldPriority:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriority(v)
-		return nil
-	case task.FieldStatus:
-		v, ok := value.(task.Status)
+	case taskstatushistory.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case taskstatushistory.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case taskstatushistory.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case taskstatushistory.FieldChangedBy:
+		return m.OldChangedBy(ctx)
+	case taskstatushistory.FieldChangedAt:
+		return m.OldChangedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskStatusHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskStatusHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskstatushistory.FieldTaskID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetTaskID(v)
 		return nil
-	case task.FieldDescription:
+	case taskstatushistory.FieldFromStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetFromStatus(v)
 		return nil
-	case task.FieldScheduledDate:
-		v, ok := value.(time.Time)
+	case taskstatushistory.FieldToStatus:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScheduledDate(v)
+		m.SetToStatus(v)
 		return nil
-	case task.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case taskstatushistory.FieldChangedBy:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetChangedBy(v)
 		return nil
-	case task.FieldUpdatedAt:
+	case taskstatushistory.FieldChangedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetChangedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown TaskStatusHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
+func (m *TaskStatusHistoryMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -7264,7 +10373,7 @@ func (m *TaskMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TaskStatusHistoryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -7273,123 +10382,85 @@ func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *TaskStatusHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown TaskStatusHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *TaskStatusHistoryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldDescription) {
-		fields = append(fields, task.FieldDescription)
+	if m.FieldCleared(taskstatushistory.FieldChangedBy) {
+		fields = append(fields, taskstatushistory.FieldChangedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *TaskStatusHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *TaskStatusHistoryMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldDescription:
-		m.ClearDescription()
+	case taskstatushistory.FieldChangedBy:
+		m.ClearChangedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown TaskStatusHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *TaskStatusHistoryMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldBuildingID:
-		m.ResetBuildingID()
-		return nil
-	case task.FieldChecklistID:
-		m.ResetChecklistID()
-		return nil
-	case task.FieldInspectorID:
-		m.ResetInspectorID()
-		return nil
-	case task.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case task.FieldPriority:
-		m.ResetPriority()
-		return nil
-	case task.FieldStatus:
-		m.ResetStatus()
+	case taskstatushistory.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case task.FieldDescription:
-		m.ResetDescription()
+	case taskstatushistory.FieldFromStatus:
+		m.ResetFromStatus()
 		return nil
-	case task.FieldScheduledDate:
-		m.ResetScheduledDate()
+	case taskstatushistory.FieldToStatus:
+		m.ResetToStatus()
 		return nil
-	case task.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case taskstatushistory.FieldChangedBy:
+		m.ResetChangedBy()
 		return nil
-	case task.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case taskstatushistory.FieldChangedAt:
+		m.ResetChangedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown TaskStatusHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.inspector != nil {
-		edges = append(edges, task.EdgeInspector)
-	}
-	if m.building != nil {
-		edges = append(edges, task.EdgeBuilding)
-	}
-	if m.checklist != nil {
-		edges = append(edges, task.EdgeChecklist)
-	}
-	if m.results != nil {
-		edges = append(edges, task.EdgeResults)
+func (m *TaskStatusHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, taskstatushistory.EdgeTask)
 	}
-	if m.act != nil {
-		edges = append(edges, task.EdgeAct)
+	if m.changed_by_user != nil {
+		edges = append(edges, taskstatushistory.EdgeChangedByUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *TaskStatusHistoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeInspector:
-		if id := m.inspector; id != nil {
-			return []ent.Value{*id}
-		}
-	case task.EdgeBuilding:
-		if id := m.building; id != nil {
-			return []ent.Value{*id}
-		}
-	case task.EdgeChecklist:
-		if id := m.checklist; id != nil {
+	case taskstatushistory.EdgeTask:
+		if id := m.task; id != nil {
 			return []ent.Value{*id}
 		}
-	case task.EdgeResults:
-		ids := make([]ent.Value, 0, len(m.results))
-		for id := range m.results {
-			ids = append(ids, id)
-		}
-		return ids
-	case task.EdgeAct:
-		if id := m.act; id != nil {
+	case taskstatushistory.EdgeChangedByUser:
+		if id := m.changed_by_user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7397,108 +10468,67 @@ func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedresults != nil {
-		edges = append(edges, task.EdgeResults)
-	}
+func (m *TaskStatusHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case task.EdgeResults:
-		ids := make([]ent.Value, 0, len(m.removedresults))
-		for id := range m.removedresults {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TaskStatusHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedinspector {
-		edges = append(edges, task.EdgeInspector)
-	}
-	if m.clearedbuilding {
-		edges = append(edges, task.EdgeBuilding)
-	}
-	if m.clearedchecklist {
-		edges = append(edges, task.EdgeChecklist)
-	}
-	if m.clearedresults {
-		edges = append(edges, task.EdgeResults)
+func (m *TaskStatusHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, taskstatushistory.EdgeTask)
 	}
-	if m.clearedact {
-		edges = append(edges, task.EdgeAct)
+	if m.clearedchanged_by_user {
+		edges = append(edges, taskstatushistory.EdgeChangedByUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *TaskStatusHistoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeInspector:
-		return m.clearedinspector
-	case task.EdgeBuilding:
-		return m.clearedbuilding
-	case task.EdgeChecklist:
-		return m.clearedchecklist
-	case task.EdgeResults:
-		return m.clearedresults
-	case task.EdgeAct:
-		return m.clearedact
+	case taskstatushistory.EdgeTask:
+		return m.clearedtask
+	case taskstatushistory.EdgeChangedByUser:
+		return m.clearedchanged_by_user
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *TaskStatusHistoryMutation) ClearEdge(name string) error {
 	switch name {
-	case task.EdgeInspector:
-		m.ClearInspector()
-		return nil
-	case task.EdgeBuilding:
-		m.ClearBuilding()
-		return nil
-	case task.EdgeChecklist:
-		m.ClearChecklist()
+	case taskstatushistory.EdgeTask:
+		m.ClearTask()
 		return nil
-	case task.EdgeAct:
-		m.ClearAct()
+	case taskstatushistory.EdgeChangedByUser:
+		m.ClearChangedByUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown TaskStatusHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *TaskStatusHistoryMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeInspector:
-		m.ResetInspector()
-		return nil
-	case task.EdgeBuilding:
-		m.ResetBuilding()
-		return nil
-	case task.EdgeChecklist:
-		m.ResetChecklist()
-		return nil
-	case task.EdgeResults:
-		m.ResetResults()
+	case taskstatushistory.EdgeTask:
+		m.ResetTask()
 		return nil
-	case task.EdgeAct:
-		m.ResetAct()
+	case taskstatushistory.EdgeChangedByUser:
+		m.ResetChangedByUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown TaskStatusHistory edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -7512,6 +10542,7 @@ type UserMutation struct {
 	password_hash             *string
 	first_name                *string
 	last_name                 *string
+	last_login_at             *time.Time
 	clearedFields             map[string]struct{}
 	role                      *int
 	clearedrole               bool
@@ -7524,6 +10555,9 @@ type UserMutation struct {
 	assigned_units            map[int]struct{}
 	removedassigned_units     map[int]struct{}
 	clearedassigned_units     bool
+	status_changes            map[int]struct{}
+	removedstatus_changes     map[int]struct{}
+	clearedstatus_changes     bool
 	done                      bool
 	oldValue                  func(context.Context) (*User, error)
 	predicates                []predicate.User
@@ -7843,6 +10877,55 @@ func (m *UserMutation) ResetLastName() {
 	m.last_name = nil
 }
 
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLoginAt returns the old "last_login_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	}
+	return oldValue.LastLoginAt, nil
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[user.FieldLastLoginAt] = struct{}{}
+}
+
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLoginAt]
+	return ok
+}
+
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, user.FieldLastLoginAt)
+}
+
 // ClearRole clears the "role" edge to the Role entity.
 func (m *UserMutation) ClearRole() {
 	m.clearedrole = true
@@ -8032,6 +11115,60 @@ func (m *UserMutation) ResetAssignedUnits() {
 	m.removedassigned_units = nil
 }
 
+// AddStatusChangeIDs adds the "status_changes" edge to the TaskStatusHistory entity by ids.
+func (m *UserMutation) AddStatusChangeIDs(ids ...int) {
+	if m.status_changes == nil {
+		m.status_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.status_changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatusChanges clears the "status_changes" edge to the TaskStatusHistory entity.
+func (m *UserMutation) ClearStatusChanges() {
+	m.clearedstatus_changes = true
+}
+
+// StatusChangesCleared reports if the "status_changes" edge to the TaskStatusHistory entity was cleared.
+func (m *UserMutation) StatusChangesCleared() bool {
+	return m.clearedstatus_changes
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to the TaskStatusHistory entity by IDs.
+func (m *UserMutation) RemoveStatusChangeIDs(ids ...int) {
+	if m.removedstatus_changes == nil {
+		m.removedstatus_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.status_changes, ids[i])
+		m.removedstatus_changes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatusChanges returns the removed IDs of the "status_changes" edge to the TaskStatusHistory entity.
+func (m *UserMutation) RemovedStatusChangesIDs() (ids []int) {
+	for id := range m.removedstatus_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatusChangesIDs returns the "status_changes" edge IDs in the mutation.
+func (m *UserMutation) StatusChangesIDs() (ids []int) {
+	for id := range m.status_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatusChanges resets all changes to the "status_changes" edge.
+func (m *UserMutation) ResetStatusChanges() {
+	m.status_changes = nil
+	m.clearedstatus_changes = false
+	m.removedstatus_changes = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -8066,7 +11203,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 7)
 	if m.role != nil {
 		fields = append(fields, user.FieldRoleID)
 	}
@@ -8085,6 +11222,9 @@ func (m *UserMutation) Fields() []string {
 	if m.last_name != nil {
 		fields = append(fields, user.FieldLastName)
 	}
+	if m.last_login_at != nil {
+		fields = append(fields, user.FieldLastLoginAt)
+	}
 	return fields
 }
 
@@ -8105,6 +11245,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.FirstName()
 	case user.FieldLastName:
 		return m.LastName()
+	case user.FieldLastLoginAt:
+		return m.LastLoginAt()
 	}
 	return nil, false
 }
@@ -8126,6 +11268,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldFirstName(ctx)
 	case user.FieldLastName:
 		return m.OldLastName(ctx)
+	case user.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -8177,6 +11321,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastName(v)
 		return nil
+	case user.FieldLastLoginAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLoginAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -8209,7 +11360,11 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(user.FieldLastLoginAt) {
+		fields = append(fields, user.FieldLastLoginAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -8222,6 +11377,11 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldLastLoginAt:
+		m.ClearLastLoginAt()
+		return nil
+	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
@@ -8247,13 +11407,16 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldLastName:
 		m.ResetLastName()
 		return nil
+	case user.FieldLastLoginAt:
+		m.ResetLastLoginAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.role != nil {
 		edges = append(edges, user.EdgeRole)
 	}
@@ -8266,6 +11429,9 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.assigned_units != nil {
 		edges = append(edges, user.EdgeAssignedUnits)
 	}
+	if m.status_changes != nil {
+		edges = append(edges, user.EdgeStatusChanges)
+	}
 	return edges
 }
 
@@ -8295,13 +11461,19 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeStatusChanges:
+		ids := make([]ent.Value, 0, len(m.status_changes))
+		for id := range m.status_changes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.removedinspections != nil {
 		edges = append(edges, user.EdgeInspections)
 	}
@@ -8311,6 +11483,9 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedassigned_units != nil {
 		edges = append(edges, user.EdgeAssignedUnits)
 	}
+	if m.removedstatus_changes != nil {
+		edges = append(edges, user.EdgeStatusChanges)
+	}
 	return edges
 }
 
@@ -8336,13 +11511,19 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeStatusChanges:
+		ids := make([]ent.Value, 0, len(m.removedstatus_changes))
+		for id := range m.removedstatus_changes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.clearedrole {
 		edges = append(edges, user.EdgeRole)
 	}
@@ -8355,6 +11536,9 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedassigned_units {
 		edges = append(edges, user.EdgeAssignedUnits)
 	}
+	if m.clearedstatus_changes {
+		edges = append(edges, user.EdgeStatusChanges)
+	}
 	return edges
 }
 
@@ -8370,6 +11554,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedassigned_buildings
 	case user.EdgeAssignedUnits:
 		return m.clearedassigned_units
+	case user.EdgeStatusChanges:
+		return m.clearedstatus_changes
 	}
 	return false
 }
@@ -8401,6 +11587,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeAssignedUnits:
 		m.ResetAssignedUnits()
 		return nil
+	case user.EdgeStatusChanges:
+		m.ResetStatusChanges()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ActSequence is the predicate function for actsequence builders.
+type ActSequence func(*sql.Selector)
+
 // Building is the predicate function for building builders.
 type Building func(*sql.Selector)
 
@@ -39,5 +42,11 @@ type Role func(*sql.Selector)
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaskChecklist is the predicate function for taskchecklist builders.
+type TaskChecklist func(*sql.Selector)
+
+// TaskStatusHistory is the predicate function for taskstatushistory builders.
+type TaskStatusHistory func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
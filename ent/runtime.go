@@ -3,12 +3,15 @@
 package ent
 
 import (
+	"jkh/ent/actsequence"
 	"jkh/ent/building"
 	"jkh/ent/checklist"
+	"jkh/ent/checklistelement"
 	"jkh/ent/inspectionact"
 	"jkh/ent/inspectionresult"
 	"jkh/ent/schema"
 	"jkh/ent/task"
+	"jkh/ent/taskstatushistory"
 	"time"
 )
 
@@ -16,18 +19,38 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	actsequenceFields := schema.ActSequence{}.Fields()
+	_ = actsequenceFields
+	// actsequenceDescValue is the schema descriptor for value field.
+	actsequenceDescValue := actsequenceFields[1].Descriptor()
+	// actsequence.DefaultValue holds the default value on creation for the value field.
+	actsequence.DefaultValue = actsequenceDescValue.Default.(int)
 	buildingFields := schema.Building{}.Fields()
 	_ = buildingFields
 	// buildingDescPhoto is the schema descriptor for photo field.
 	buildingDescPhoto := buildingFields[6].Descriptor()
 	// building.PhotoValidator is a validator for the "photo" field. It is called by the builders before save.
 	building.PhotoValidator = buildingDescPhoto.Validators[0].(func(string) error)
+	// buildingDescContactName is the schema descriptor for contact_name field.
+	buildingDescContactName := buildingFields[7].Descriptor()
+	// building.ContactNameValidator is a validator for the "contact_name" field. It is called by the builders before save.
+	building.ContactNameValidator = buildingDescContactName.Validators[0].(func(string) error)
+	// buildingDescContactPhone is the schema descriptor for contact_phone field.
+	buildingDescContactPhone := buildingFields[8].Descriptor()
+	// building.ContactPhoneValidator is a validator for the "contact_phone" field. It is called by the builders before save.
+	building.ContactPhoneValidator = buildingDescContactPhone.Validators[0].(func(string) error)
 	checklistFields := schema.Checklist{}.Fields()
 	_ = checklistFields
 	// checklistDescCreatedAt is the schema descriptor for created_at field.
 	checklistDescCreatedAt := checklistFields[3].Descriptor()
 	// checklist.DefaultCreatedAt holds the default value on creation for the created_at field.
 	checklist.DefaultCreatedAt = checklistDescCreatedAt.Default.(func() time.Time)
+	checklistelementFields := schema.ChecklistElement{}.Fields()
+	_ = checklistelementFields
+	// checklistelementDescPhotoRequired is the schema descriptor for photo_required field.
+	checklistelementDescPhotoRequired := checklistelementFields[3].Descriptor()
+	// checklistelement.DefaultPhotoRequired holds the default value on creation for the photo_required field.
+	checklistelement.DefaultPhotoRequired = checklistelementDescPhotoRequired.Default.(bool)
 	inspectionactFields := schema.InspectionAct{}.Fields()
 	_ = inspectionactFields
 	// inspectionactDescCreatedAt is the schema descriptor for created_at field.
@@ -38,18 +61,26 @@ func init() {
 	inspectionactDescStatus := inspectionactFields[3].Descriptor()
 	// inspectionact.DefaultStatus holds the default value on creation for the status field.
 	inspectionact.DefaultStatus = inspectionactDescStatus.Default.(string)
+	// inspectionactDescActNumber is the schema descriptor for act_number field.
+	inspectionactDescActNumber := inspectionactFields[4].Descriptor()
+	// inspectionact.ActNumberValidator is a validator for the "act_number" field. It is called by the builders before save.
+	inspectionact.ActNumberValidator = inspectionactDescActNumber.Validators[0].(func(string) error)
 	// inspectionactDescDocumentPath is the schema descriptor for document_path field.
-	inspectionactDescDocumentPath := inspectionactFields[5].Descriptor()
+	inspectionactDescDocumentPath := inspectionactFields[7].Descriptor()
 	// inspectionact.DocumentPathValidator is a validator for the "document_path" field. It is called by the builders before save.
 	inspectionact.DocumentPathValidator = inspectionactDescDocumentPath.Validators[0].(func(string) error)
+	// inspectionactDescIncludeUnfilled is the schema descriptor for include_unfilled field.
+	inspectionactDescIncludeUnfilled := inspectionactFields[9].Descriptor()
+	// inspectionact.DefaultIncludeUnfilled holds the default value on creation for the include_unfilled field.
+	inspectionact.DefaultIncludeUnfilled = inspectionactDescIncludeUnfilled.Default.(bool)
 	inspectionresultFields := schema.InspectionResult{}.Fields()
 	_ = inspectionresultFields
 	// inspectionresultDescCreatedAt is the schema descriptor for created_at field.
-	inspectionresultDescCreatedAt := inspectionresultFields[4].Descriptor()
+	inspectionresultDescCreatedAt := inspectionresultFields[6].Descriptor()
 	// inspectionresult.DefaultCreatedAt holds the default value on creation for the created_at field.
 	inspectionresult.DefaultCreatedAt = inspectionresultDescCreatedAt.Default.(func() time.Time)
 	// inspectionresultDescUpdatedAt is the schema descriptor for updated_at field.
-	inspectionresultDescUpdatedAt := inspectionresultFields[5].Descriptor()
+	inspectionresultDescUpdatedAt := inspectionresultFields[7].Descriptor()
 	// inspectionresult.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	inspectionresult.DefaultUpdatedAt = inspectionresultDescUpdatedAt.Default.(func() time.Time)
 	// inspectionresult.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -70,4 +101,10 @@ func init() {
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taskstatushistoryFields := schema.TaskStatusHistory{}.Fields()
+	_ = taskstatushistoryFields
+	// taskstatushistoryDescChangedAt is the schema descriptor for changed_at field.
+	taskstatushistoryDescChangedAt := taskstatushistoryFields[4].Descriptor()
+	// taskstatushistory.DefaultChangedAt holds the default value on creation for the changed_at field.
+	taskstatushistory.DefaultChangedAt = taskstatushistoryDescChangedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ActSequence holds the schema definition for the ActSequence entity.
+// Счётчик номеров актов: одна строка на календарный год.
+type ActSequence struct {
+	ent.Schema
+}
+
+// Fields of the ActSequence.
+func (ActSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("year").
+			Unique().
+			Immutable(),
+
+		// Последний выданный номер за год (0 — номеров ещё не выдавалось)
+		field.Int("value").
+			Default(0),
+	}
+}
+
+// Edges of the ActSequence.
+func (ActSequence) Edges() []ent.Edge {
+	return nil
+}
@@ -40,6 +40,25 @@ func (Building) Fields() []ent.Field {
 		field.String("photo").
 			MaxLen(500). // VARCHAR(500)
 			Optional(),
+
+		// Контактное лицо (жилец/управляющий) для согласования доступа
+		field.String("contact_name").
+			MaxLen(255).
+			Optional(),
+		field.String("contact_phone").
+			MaxLen(32).
+			Optional(),
+
+		// Размер здания для акта (NULL — неизвестно)
+		field.Int("floors").
+			Optional().
+			Nillable(),
+		field.Int("entrances").
+			Optional().
+			Nillable(),
+		field.Int("apartments").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -44,5 +44,8 @@ func (Checklist) Edges() []ent.Edge {
         
         // Обратная связь: один чек-лист может быть использован во многих Заданиях.
         edge.To("tasks", Task.Type),
+
+        // Использование в качестве дополнительного чек-листа задания (TaskChecklist).
+        edge.To("task_links", TaskChecklist.Type),
 	}
 }
@@ -24,6 +24,10 @@ func (ChecklistElement) Fields() []ent.Field {
 
         field.Int("order_index").
             Optional(),
+
+        // Для проблемного состояния элемента (например, кровля, фасад) обязательно фото
+        field.Bool("photo_required").
+            Default(false),
 	}
 }
 
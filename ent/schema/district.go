@@ -17,6 +17,10 @@ func (District) Fields() []ent.Field {
 		field.String("name").
 			Unique().
 			Comment("Название района (уникальное)."),
+
+		field.Text("description").
+			Optional().
+			Comment("Описание района: границы, контакты, ответственные службы."),
 	}
 }
 
@@ -17,6 +17,10 @@ func (ElementCatalog) Fields() []ent.Field {
 		// Название элемента (например, "Фундамент", "Кровля").
         field.String("name").
             Unique(),
+
+        // Название элемента на втором языке (для двуязычных актов). NULLable.
+        field.String("name_en").
+            Optional(),
         
         // Категория элемента (для удобства фильтрации)
         field.String("category").
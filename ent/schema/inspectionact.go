@@ -27,13 +27,34 @@ func (InspectionAct) Fields() []ent.Field {
 			
 		field.String("status").
 			Default("создан"),
+
+		// Номер акта за год (например, "2025-0001"), присваивается при утверждении
+		field.String("act_number").
+			MaxLen(50).
+			Optional().
+			Nillable().
+			Unique(),
 			
 		field.Text("conclusion").
 			Optional(),
+
+		// Комментарий координатора при утверждении (печатается в заключении акта)
+		field.Text("approval_comment").
+			Optional().
+			Nillable(),
 			
 		field.String("document_path"). // Путь к сгенерированному PDF
 			MaxLen(500).
 			Optional(),
+
+		// Язык акта: определяет, какое название элемента печатается в PDF
+		field.Enum("language").
+			Values("ru", "en").
+			Default("ru"),
+
+		// Печатать в акте все элементы чек-листов, включая неосмотренные ("не осмотрено")
+		field.Bool("include_unfilled").
+			Default(false),
 	}
 }
 
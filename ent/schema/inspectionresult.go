@@ -11,7 +11,7 @@ type InspectionResult struct {
 	ent.Schema
 }
 
-var ConditionStatuses = []string{"Исправное", "Удовлетворительное", "Неудовлетворительное", "Аварийное"}
+var ConditionStatuses = []string{"Исправное", "Удовлетворительное", "Неудовлетворительное", "Аварийное", "Неприменимо"}
 
 // Fields of the InspectionResult.
 func (InspectionResult) Fields() []ent.Field {
@@ -25,11 +25,19 @@ func (InspectionResult) Fields() []ent.Field {
 		// Выбор статуса состояния элемента
         field.Enum("condition_status").
             Values(ConditionStatuses...).
-            Comment("Статус состояния: Исправное, Удовлетворительное, Неудовлетворительное, Аварийное."),
+            Comment("Статус состояния: Исправное, Удовлетворительное, Неудовлетворительное, Аварийное, Неприменимо (элемент отсутствует в здании)."),
             
         // Комментарий инспектора к элементу
         field.String("comment").
             Optional(),
+
+        // Внутренняя заметка инспектора: печатается только во внутреннем варианте акта
+        field.String("internal_note").
+            Optional(),
+
+        // Пути к фотографиям элемента
+        field.Strings("photo_paths").
+            Optional(),
             
         field.Time("created_at").
             Default(time.Now).
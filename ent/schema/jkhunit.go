@@ -19,6 +19,9 @@ func (JkhUnit) Fields() []ent.Field {
 		// Название ЖЭУ (например, "ЖЭУ 5" или "Район Северный").
         field.String("name").
             Unique(),
+		// Описание ЖЭУ (контакты, обслуживаемая территория и т.п.)
+		field.Text("description").
+			Optional(),
 	}
 }
 
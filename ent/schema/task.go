@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
     "entgo.io/ent/schema/edge"
 	"time"
@@ -83,5 +84,13 @@ func (Task) Edges() []ent.Edge {
 		// 2. НОВОЕ: Связь 1:1 к Акту (InspectionAct)
 		edge.To("act", InspectionAct.Type).
 			Unique(),
+
+		// 3. История переходов статуса (удаляется вместе с заданием)
+		edge.To("status_history", TaskStatusHistory.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		// 4. Дополнительные чек-листы (удаляются вместе с заданием)
+		edge.To("extra_checklists", TaskChecklist.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskChecklist holds the schema definition for the TaskChecklist entity.
+// Дополнительные чек-листы задания (основной задаётся полем Task.checklist_id).
+type TaskChecklist struct {
+	ent.Schema
+}
+
+// Fields of the TaskChecklist.
+func (TaskChecklist) Fields() []ent.Field {
+	return []ent.Field{
+		// Явное определение ФК
+		field.Int("task_id"),
+		field.Int("checklist_id"),
+	}
+}
+
+// Edges of the TaskChecklist.
+func (TaskChecklist) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Связь М:1 к Заданию
+		edge.From("task", Task.Type).
+			Ref("extra_checklists").
+			Unique().
+			Required().
+			Field("task_id"),
+
+		// Связь М:1 к Чек-листу
+		edge.From("checklist", Checklist.Type).
+			Ref("task_links").
+			Unique().
+			Required().
+			Field("checklist_id"),
+	}
+}
+
+// Indexes of the TaskChecklist.
+func (TaskChecklist) Indexes() []ent.Index {
+	return []ent.Index{
+		// Один чек-лист добавляется к заданию не более одного раза
+		index.Fields("task_id", "checklist_id").Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// TaskStatusHistory holds the schema definition for the TaskStatusHistory entity.
+type TaskStatusHistory struct {
+	ent.Schema
+}
+
+// Fields of the TaskStatusHistory.
+func (TaskStatusHistory) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. ЯВНОЕ ПОЛЕ ФК (ссылка на Task)
+		field.Int("task_id"),
+
+		// Статус до перехода и после него (значения FSM задания)
+		field.String("from_status"),
+		field.String("to_status"),
+
+		// 2. ЯВНОЕ ПОЛЕ ФК (ссылка на User, выполнивший переход). NULLable.
+		field.Int("changed_by").
+			Optional(),
+
+		field.Time("changed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TaskStatusHistory.
+func (TaskStatusHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Связь М:1 к Заданию
+		edge.From("task", Task.Type).
+			Ref("status_history").
+			Unique().
+			Required().
+			Field("task_id"),
+
+		// Связь М:1 к Пользователю, изменившему статус
+		edge.From("changed_by_user", User.Type).
+			Ref("status_changes").
+			Unique().
+			Field("changed_by"),
+	}
+}
@@ -30,6 +30,11 @@ func (User) Fields() []ent.Field {
         // Имя и Фамилия пользователя
         field.String("first_name"),
         field.String("last_name"),
+
+        // Время последнего успешного входа (пусто, если пользователь ещё не входил)
+        field.Time("last_login_at").
+            Optional().
+            Nillable(),
 	}
 }
 
@@ -51,5 +56,8 @@ func (User) Edges() []ent.Edge {
 
         // ВОЗВРАЩЕНО: Связь М:М: Список ЖЭУ, за которые отвечает пользователь.
         edge.To("assigned_units", InspectorUnit.Type), // <-- ВОЗВРАЩЕНО
+
+        // Обратная связь к истории статусов заданий (кто менял статус)
+        edge.To("status_changes", TaskStatusHistory.Type),
 	}
 }
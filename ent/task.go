@@ -59,9 +59,13 @@ type TaskEdges struct {
 	Results []*InspectionResult `json:"results,omitempty"`
 	// Act holds the value of the act edge.
 	Act *InspectionAct `json:"act,omitempty"`
+	// StatusHistory holds the value of the status_history edge.
+	StatusHistory []*TaskStatusHistory `json:"status_history,omitempty"`
+	// ExtraChecklists holds the value of the extra_checklists edge.
+	ExtraChecklists []*TaskChecklist `json:"extra_checklists,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // InspectorOrErr returns the Inspector value or an error if the edge
@@ -117,6 +121,24 @@ func (e TaskEdges) ActOrErr() (*InspectionAct, error) {
 	return nil, &NotLoadedError{edge: "act"}
 }
 
+// StatusHistoryOrErr returns the StatusHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) StatusHistoryOrErr() ([]*TaskStatusHistory, error) {
+	if e.loadedTypes[5] {
+		return e.StatusHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "status_history"}
+}
+
+// ExtraChecklistsOrErr returns the ExtraChecklists value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ExtraChecklistsOrErr() ([]*TaskChecklist, error) {
+	if e.loadedTypes[6] {
+		return e.ExtraChecklists, nil
+	}
+	return nil, &NotLoadedError{edge: "extra_checklists"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -247,6 +269,16 @@ func (_m *Task) QueryAct() *InspectionActQuery {
 	return NewTaskClient(_m.config).QueryAct(_m)
 }
 
+// QueryStatusHistory queries the "status_history" edge of the Task entity.
+func (_m *Task) QueryStatusHistory() *TaskStatusHistoryQuery {
+	return NewTaskClient(_m.config).QueryStatusHistory(_m)
+}
+
+// QueryExtraChecklists queries the "extra_checklists" edge of the Task entity.
+func (_m *Task) QueryExtraChecklists() *TaskChecklistQuery {
+	return NewTaskClient(_m.config).QueryExtraChecklists(_m)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -45,6 +45,10 @@ const (
 	EdgeResults = "results"
 	// EdgeAct holds the string denoting the act edge name in mutations.
 	EdgeAct = "act"
+	// EdgeStatusHistory holds the string denoting the status_history edge name in mutations.
+	EdgeStatusHistory = "status_history"
+	// EdgeExtraChecklists holds the string denoting the extra_checklists edge name in mutations.
+	EdgeExtraChecklists = "extra_checklists"
 	// Table holds the table name of the task in the database.
 	Table = "tasks"
 	// InspectorTable is the table that holds the inspector relation/edge.
@@ -82,6 +86,20 @@ const (
 	ActInverseTable = "inspection_acts"
 	// ActColumn is the table column denoting the act relation/edge.
 	ActColumn = "task_id"
+	// StatusHistoryTable is the table that holds the status_history relation/edge.
+	StatusHistoryTable = "task_status_histories"
+	// StatusHistoryInverseTable is the table name for the TaskStatusHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "taskstatushistory" package.
+	StatusHistoryInverseTable = "task_status_histories"
+	// StatusHistoryColumn is the table column denoting the status_history relation/edge.
+	StatusHistoryColumn = "task_id"
+	// ExtraChecklistsTable is the table that holds the extra_checklists relation/edge.
+	ExtraChecklistsTable = "task_checklists"
+	// ExtraChecklistsInverseTable is the table name for the TaskChecklist entity.
+	// It exists in this package in order to avoid circular dependency with the "taskchecklist" package.
+	ExtraChecklistsInverseTable = "task_checklists"
+	// ExtraChecklistsColumn is the table column denoting the extra_checklists relation/edge.
+	ExtraChecklistsColumn = "task_id"
 )
 
 // Columns holds all SQL columns for task fields.
@@ -250,6 +268,34 @@ func ByActField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newActStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByStatusHistoryCount orders the results by status_history count.
+func ByStatusHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusHistoryStep(), opts...)
+	}
+}
+
+// ByStatusHistory orders the results by status_history terms.
+func ByStatusHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExtraChecklistsCount orders the results by extra_checklists count.
+func ByExtraChecklistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExtraChecklistsStep(), opts...)
+	}
+}
+
+// ByExtraChecklists orders the results by extra_checklists terms.
+func ByExtraChecklists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExtraChecklistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newInspectorStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -285,3 +331,17 @@ func newActStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, ActTable, ActColumn),
 	)
 }
+func newStatusHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoryTable, StatusHistoryColumn),
+	)
+}
+func newExtraChecklistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExtraChecklistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExtraChecklistsTable, ExtraChecklistsColumn),
+	)
+}
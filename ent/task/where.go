@@ -620,6 +620,52 @@ func HasActWith(preds ...predicate.InspectionAct) predicate.Task {
 	})
 }
 
+// HasStatusHistory applies the HasEdge predicate on the "status_history" edge.
+func HasStatusHistory() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoryTable, StatusHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusHistoryWith applies the HasEdge predicate on the "status_history" edge with a given conditions (other predicates).
+func HasStatusHistoryWith(preds ...predicate.TaskStatusHistory) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newStatusHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExtraChecklists applies the HasEdge predicate on the "extra_checklists" edge.
+func HasExtraChecklists() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExtraChecklistsTable, ExtraChecklistsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExtraChecklistsWith applies the HasEdge predicate on the "extra_checklists" edge with a given conditions (other predicates).
+func HasExtraChecklistsWith(preds ...predicate.TaskChecklist) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newExtraChecklistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
@@ -11,6 +11,8 @@ import (
 	"jkh/ent/inspectionact"
 	"jkh/ent/inspectionresult"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
 	"time"
 
@@ -174,6 +176,36 @@ func (_c *TaskCreate) SetAct(v *InspectionAct) *TaskCreate {
 	return _c.SetActID(v.ID)
 }
 
+// AddStatusHistoryIDs adds the "status_history" edge to the TaskStatusHistory entity by IDs.
+func (_c *TaskCreate) AddStatusHistoryIDs(ids ...int) *TaskCreate {
+	_c.mutation.AddStatusHistoryIDs(ids...)
+	return _c
+}
+
+// AddStatusHistory adds the "status_history" edges to the TaskStatusHistory entity.
+func (_c *TaskCreate) AddStatusHistory(v ...*TaskStatusHistory) *TaskCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatusHistoryIDs(ids...)
+}
+
+// AddExtraChecklistIDs adds the "extra_checklists" edge to the TaskChecklist entity by IDs.
+func (_c *TaskCreate) AddExtraChecklistIDs(ids ...int) *TaskCreate {
+	_c.mutation.AddExtraChecklistIDs(ids...)
+	return _c
+}
+
+// AddExtraChecklists adds the "extra_checklists" edges to the TaskChecklist entity.
+func (_c *TaskCreate) AddExtraChecklists(v ...*TaskChecklist) *TaskCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExtraChecklistIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_c *TaskCreate) Mutation() *TaskMutation {
 	return _c.mutation
@@ -407,6 +439,38 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusHistoryTable,
+			Columns: []string{task.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExtraChecklistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ExtraChecklistsTable,
+			Columns: []string{task.ExtraChecklistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,6 +12,8 @@ import (
 	"jkh/ent/inspectionresult"
 	"jkh/ent/predicate"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
 	"math"
 
@@ -24,15 +26,17 @@ import (
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	ctx           *QueryContext
-	order         []task.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Task
-	withInspector *UserQuery
-	withBuilding  *BuildingQuery
-	withChecklist *ChecklistQuery
-	withResults   *InspectionResultQuery
-	withAct       *InspectionActQuery
+	ctx                 *QueryContext
+	order               []task.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Task
+	withInspector       *UserQuery
+	withBuilding        *BuildingQuery
+	withChecklist       *ChecklistQuery
+	withResults         *InspectionResultQuery
+	withAct             *InspectionActQuery
+	withStatusHistory   *TaskStatusHistoryQuery
+	withExtraChecklists *TaskChecklistQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -179,6 +183,50 @@ func (_q *TaskQuery) QueryAct() *InspectionActQuery {
 	return query
 }
 
+// QueryStatusHistory chains the current query on the "status_history" edge.
+func (_q *TaskQuery) QueryStatusHistory() *TaskStatusHistoryQuery {
+	query := (&TaskStatusHistoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskstatushistory.Table, taskstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.StatusHistoryTable, task.StatusHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExtraChecklists chains the current query on the "extra_checklists" edge.
+func (_q *TaskQuery) QueryExtraChecklists() *TaskChecklistQuery {
+	query := (&TaskChecklistClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskchecklist.Table, taskchecklist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ExtraChecklistsTable, task.ExtraChecklistsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Task entity from the query.
 // Returns a *NotFoundError when no Task was found.
 func (_q *TaskQuery) First(ctx context.Context) (*Task, error) {
@@ -366,16 +414,18 @@ func (_q *TaskQuery) Clone() *TaskQuery {
 		return nil
 	}
 	return &TaskQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]task.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.Task{}, _q.predicates...),
-		withInspector: _q.withInspector.Clone(),
-		withBuilding:  _q.withBuilding.Clone(),
-		withChecklist: _q.withChecklist.Clone(),
-		withResults:   _q.withResults.Clone(),
-		withAct:       _q.withAct.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]task.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Task{}, _q.predicates...),
+		withInspector:       _q.withInspector.Clone(),
+		withBuilding:        _q.withBuilding.Clone(),
+		withChecklist:       _q.withChecklist.Clone(),
+		withResults:         _q.withResults.Clone(),
+		withAct:             _q.withAct.Clone(),
+		withStatusHistory:   _q.withStatusHistory.Clone(),
+		withExtraChecklists: _q.withExtraChecklists.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -437,6 +487,28 @@ func (_q *TaskQuery) WithAct(opts ...func(*InspectionActQuery)) *TaskQuery {
 	return _q
 }
 
+// WithStatusHistory tells the query-builder to eager-load the nodes that are connected to
+// the "status_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithStatusHistory(opts ...func(*TaskStatusHistoryQuery)) *TaskQuery {
+	query := (&TaskStatusHistoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatusHistory = query
+	return _q
+}
+
+// WithExtraChecklists tells the query-builder to eager-load the nodes that are connected to
+// the "extra_checklists" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithExtraChecklists(opts ...func(*TaskChecklistQuery)) *TaskQuery {
+	query := (&TaskChecklistClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExtraChecklists = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -515,12 +587,14 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	var (
 		nodes       = []*Task{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			_q.withInspector != nil,
 			_q.withBuilding != nil,
 			_q.withChecklist != nil,
 			_q.withResults != nil,
 			_q.withAct != nil,
+			_q.withStatusHistory != nil,
+			_q.withExtraChecklists != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -572,6 +646,20 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 			return nil, err
 		}
 	}
+	if query := _q.withStatusHistory; query != nil {
+		if err := _q.loadStatusHistory(ctx, query, nodes,
+			func(n *Task) { n.Edges.StatusHistory = []*TaskStatusHistory{} },
+			func(n *Task, e *TaskStatusHistory) { n.Edges.StatusHistory = append(n.Edges.StatusHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExtraChecklists; query != nil {
+		if err := _q.loadExtraChecklists(ctx, query, nodes,
+			func(n *Task) { n.Edges.ExtraChecklists = []*TaskChecklist{} },
+			func(n *Task, e *TaskChecklist) { n.Edges.ExtraChecklists = append(n.Edges.ExtraChecklists, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -719,6 +807,66 @@ func (_q *TaskQuery) loadAct(ctx context.Context, query *InspectionActQuery, nod
 	}
 	return nil
 }
+func (_q *TaskQuery) loadStatusHistory(ctx context.Context, query *TaskStatusHistoryQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskStatusHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskstatushistory.FieldTaskID)
+	}
+	query.Where(predicate.TaskStatusHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.StatusHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadExtraChecklists(ctx context.Context, query *TaskChecklistQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskChecklist)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskchecklist.FieldTaskID)
+	}
+	query.Where(predicate.TaskChecklist(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ExtraChecklistsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,6 +12,8 @@ import (
 	"jkh/ent/inspectionresult"
 	"jkh/ent/predicate"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
 	"time"
 
@@ -206,6 +208,36 @@ func (_u *TaskUpdate) SetAct(v *InspectionAct) *TaskUpdate {
 	return _u.SetActID(v.ID)
 }
 
+// AddStatusHistoryIDs adds the "status_history" edge to the TaskStatusHistory entity by IDs.
+func (_u *TaskUpdate) AddStatusHistoryIDs(ids ...int) *TaskUpdate {
+	_u.mutation.AddStatusHistoryIDs(ids...)
+	return _u
+}
+
+// AddStatusHistory adds the "status_history" edges to the TaskStatusHistory entity.
+func (_u *TaskUpdate) AddStatusHistory(v ...*TaskStatusHistory) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusHistoryIDs(ids...)
+}
+
+// AddExtraChecklistIDs adds the "extra_checklists" edge to the TaskChecklist entity by IDs.
+func (_u *TaskUpdate) AddExtraChecklistIDs(ids ...int) *TaskUpdate {
+	_u.mutation.AddExtraChecklistIDs(ids...)
+	return _u
+}
+
+// AddExtraChecklists adds the "extra_checklists" edges to the TaskChecklist entity.
+func (_u *TaskUpdate) AddExtraChecklists(v ...*TaskChecklist) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExtraChecklistIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdate) Mutation() *TaskMutation {
 	return _u.mutation
@@ -256,6 +288,48 @@ func (_u *TaskUpdate) ClearAct() *TaskUpdate {
 	return _u
 }
 
+// ClearStatusHistory clears all "status_history" edges to the TaskStatusHistory entity.
+func (_u *TaskUpdate) ClearStatusHistory() *TaskUpdate {
+	_u.mutation.ClearStatusHistory()
+	return _u
+}
+
+// RemoveStatusHistoryIDs removes the "status_history" edge to TaskStatusHistory entities by IDs.
+func (_u *TaskUpdate) RemoveStatusHistoryIDs(ids ...int) *TaskUpdate {
+	_u.mutation.RemoveStatusHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveStatusHistory removes "status_history" edges to TaskStatusHistory entities.
+func (_u *TaskUpdate) RemoveStatusHistory(v ...*TaskStatusHistory) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusHistoryIDs(ids...)
+}
+
+// ClearExtraChecklists clears all "extra_checklists" edges to the TaskChecklist entity.
+func (_u *TaskUpdate) ClearExtraChecklists() *TaskUpdate {
+	_u.mutation.ClearExtraChecklists()
+	return _u
+}
+
+// RemoveExtraChecklistIDs removes the "extra_checklists" edge to TaskChecklist entities by IDs.
+func (_u *TaskUpdate) RemoveExtraChecklistIDs(ids ...int) *TaskUpdate {
+	_u.mutation.RemoveExtraChecklistIDs(ids...)
+	return _u
+}
+
+// RemoveExtraChecklists removes "extra_checklists" edges to TaskChecklist entities.
+func (_u *TaskUpdate) RemoveExtraChecklists(v ...*TaskChecklist) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExtraChecklistIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TaskUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -505,6 +579,96 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusHistoryTable,
+			Columns: []string{task.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusHistoryIDs(); len(nodes) > 0 && !_u.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusHistoryTable,
+			Columns: []string{task.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusHistoryTable,
+			Columns: []string{task.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExtraChecklistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ExtraChecklistsTable,
+			Columns: []string{task.ExtraChecklistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExtraChecklistsIDs(); len(nodes) > 0 && !_u.mutation.ExtraChecklistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ExtraChecklistsTable,
+			Columns: []string{task.ExtraChecklistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExtraChecklistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ExtraChecklistsTable,
+			Columns: []string{task.ExtraChecklistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{task.Label}
@@ -698,6 +862,36 @@ func (_u *TaskUpdateOne) SetAct(v *InspectionAct) *TaskUpdateOne {
 	return _u.SetActID(v.ID)
 }
 
+// AddStatusHistoryIDs adds the "status_history" edge to the TaskStatusHistory entity by IDs.
+func (_u *TaskUpdateOne) AddStatusHistoryIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.AddStatusHistoryIDs(ids...)
+	return _u
+}
+
+// AddStatusHistory adds the "status_history" edges to the TaskStatusHistory entity.
+func (_u *TaskUpdateOne) AddStatusHistory(v ...*TaskStatusHistory) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusHistoryIDs(ids...)
+}
+
+// AddExtraChecklistIDs adds the "extra_checklists" edge to the TaskChecklist entity by IDs.
+func (_u *TaskUpdateOne) AddExtraChecklistIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.AddExtraChecklistIDs(ids...)
+	return _u
+}
+
+// AddExtraChecklists adds the "extra_checklists" edges to the TaskChecklist entity.
+func (_u *TaskUpdateOne) AddExtraChecklists(v ...*TaskChecklist) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExtraChecklistIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdateOne) Mutation() *TaskMutation {
 	return _u.mutation
@@ -748,6 +942,48 @@ func (_u *TaskUpdateOne) ClearAct() *TaskUpdateOne {
 	return _u
 }
 
+// ClearStatusHistory clears all "status_history" edges to the TaskStatusHistory entity.
+func (_u *TaskUpdateOne) ClearStatusHistory() *TaskUpdateOne {
+	_u.mutation.ClearStatusHistory()
+	return _u
+}
+
+// RemoveStatusHistoryIDs removes the "status_history" edge to TaskStatusHistory entities by IDs.
+func (_u *TaskUpdateOne) RemoveStatusHistoryIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.RemoveStatusHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveStatusHistory removes "status_history" edges to TaskStatusHistory entities.
+func (_u *TaskUpdateOne) RemoveStatusHistory(v ...*TaskStatusHistory) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusHistoryIDs(ids...)
+}
+
+// ClearExtraChecklists clears all "extra_checklists" edges to the TaskChecklist entity.
+func (_u *TaskUpdateOne) ClearExtraChecklists() *TaskUpdateOne {
+	_u.mutation.ClearExtraChecklists()
+	return _u
+}
+
+// RemoveExtraChecklistIDs removes the "extra_checklists" edge to TaskChecklist entities by IDs.
+func (_u *TaskUpdateOne) RemoveExtraChecklistIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.RemoveExtraChecklistIDs(ids...)
+	return _u
+}
+
+// RemoveExtraChecklists removes "extra_checklists" edges to TaskChecklist entities.
+func (_u *TaskUpdateOne) RemoveExtraChecklists(v ...*TaskChecklist) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExtraChecklistIDs(ids...)
+}
+
 // Where appends a list predicates to the TaskUpdate builder.
 func (_u *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1027,6 +1263,96 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusHistoryTable,
+			Columns: []string{task.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusHistoryIDs(); len(nodes) > 0 && !_u.mutation.StatusHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusHistoryTable,
+			Columns: []string{task.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusHistoryTable,
+			Columns: []string{task.StatusHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExtraChecklistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ExtraChecklistsTable,
+			Columns: []string{task.ExtraChecklistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExtraChecklistsIDs(); len(nodes) > 0 && !_u.mutation.ExtraChecklistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ExtraChecklistsTable,
+			Columns: []string{task.ExtraChecklistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExtraChecklistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ExtraChecklistsTable,
+			Columns: []string{task.ExtraChecklistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Task{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jkh/ent/checklist"
+	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TaskChecklist is the model entity for the TaskChecklist schema.
+type TaskChecklist struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id,omitempty"`
+	// ChecklistID holds the value of the "checklist_id" field.
+	ChecklistID int `json:"checklist_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskChecklistQuery when eager-loading is set.
+	Edges        TaskChecklistEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskChecklistEdges holds the relations/edges for other nodes in the graph.
+type TaskChecklistEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// Checklist holds the value of the checklist edge.
+	Checklist *Checklist `json:"checklist,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskChecklistEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// ChecklistOrErr returns the Checklist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskChecklistEdges) ChecklistOrErr() (*Checklist, error) {
+	if e.Checklist != nil {
+		return e.Checklist, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: checklist.Label}
+	}
+	return nil, &NotLoadedError{edge: "checklist"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskChecklist) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskchecklist.FieldID, taskchecklist.FieldTaskID, taskchecklist.FieldChecklistID:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskChecklist fields.
+func (_m *TaskChecklist) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskchecklist.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case taskchecklist.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = int(value.Int64)
+			}
+		case taskchecklist.FieldChecklistID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field checklist_id", values[i])
+			} else if value.Valid {
+				_m.ChecklistID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskChecklist.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskChecklist) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskChecklist entity.
+func (_m *TaskChecklist) QueryTask() *TaskQuery {
+	return NewTaskChecklistClient(_m.config).QueryTask(_m)
+}
+
+// QueryChecklist queries the "checklist" edge of the TaskChecklist entity.
+func (_m *TaskChecklist) QueryChecklist() *ChecklistQuery {
+	return NewTaskChecklistClient(_m.config).QueryChecklist(_m)
+}
+
+// Update returns a builder for updating this TaskChecklist.
+// Note that you need to call TaskChecklist.Unwrap() before calling this method if this TaskChecklist
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskChecklist) Update() *TaskChecklistUpdateOne {
+	return NewTaskChecklistClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskChecklist entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskChecklist) Unwrap() *TaskChecklist {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskChecklist is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskChecklist) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskChecklist(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("checklist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChecklistID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskChecklists is a parsable slice of TaskChecklist.
+type TaskChecklists []*TaskChecklist
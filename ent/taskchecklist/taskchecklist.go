@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskchecklist
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the taskchecklist type in the database.
+	Label = "task_checklist"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldChecklistID holds the string denoting the checklist_id field in the database.
+	FieldChecklistID = "checklist_id"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// EdgeChecklist holds the string denoting the checklist edge name in mutations.
+	EdgeChecklist = "checklist"
+	// Table holds the table name of the taskchecklist in the database.
+	Table = "task_checklists"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "task_checklists"
+	// TaskInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TaskInverseTable = "tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "task_id"
+	// ChecklistTable is the table that holds the checklist relation/edge.
+	ChecklistTable = "task_checklists"
+	// ChecklistInverseTable is the table name for the Checklist entity.
+	// It exists in this package in order to avoid circular dependency with the "checklist" package.
+	ChecklistInverseTable = "checklists"
+	// ChecklistColumn is the table column denoting the checklist relation/edge.
+	ChecklistColumn = "checklist_id"
+)
+
+// Columns holds all SQL columns for taskchecklist fields.
+var Columns = []string{
+	FieldID,
+	FieldTaskID,
+	FieldChecklistID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the TaskChecklist queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByChecklistID orders the results by the checklist_id field.
+func ByChecklistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecklistID, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChecklistField orders the results by checklist field.
+func ByChecklistField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChecklistStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
+func newChecklistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChecklistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ChecklistTable, ChecklistColumn),
+	)
+}
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskchecklist
+
+import (
+	"jkh/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldEQ(FieldTaskID, v))
+}
+
+// ChecklistID applies equality check predicate on the "checklist_id" field. It's identical to ChecklistIDEQ.
+func ChecklistID(v int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldEQ(FieldChecklistID, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// ChecklistIDEQ applies the EQ predicate on the "checklist_id" field.
+func ChecklistIDEQ(v int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldEQ(FieldChecklistID, v))
+}
+
+// ChecklistIDNEQ applies the NEQ predicate on the "checklist_id" field.
+func ChecklistIDNEQ(v int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldNEQ(FieldChecklistID, v))
+}
+
+// ChecklistIDIn applies the In predicate on the "checklist_id" field.
+func ChecklistIDIn(vs ...int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldIn(FieldChecklistID, vs...))
+}
+
+// ChecklistIDNotIn applies the NotIn predicate on the "checklist_id" field.
+func ChecklistIDNotIn(vs ...int) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.FieldNotIn(FieldChecklistID, vs...))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.TaskChecklist {
+	return predicate.TaskChecklist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.TaskChecklist {
+	return predicate.TaskChecklist(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChecklist applies the HasEdge predicate on the "checklist" edge.
+func HasChecklist() predicate.TaskChecklist {
+	return predicate.TaskChecklist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChecklistTable, ChecklistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChecklistWith applies the HasEdge predicate on the "checklist" edge with a given conditions (other predicates).
+func HasChecklistWith(preds ...predicate.Checklist) predicate.TaskChecklist {
+	return predicate.TaskChecklist(func(s *sql.Selector) {
+		step := newChecklistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskChecklist) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskChecklist) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskChecklist) predicate.TaskChecklist {
+	return predicate.TaskChecklist(sql.NotPredicates(p))
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jkh/ent/checklist"
+	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskChecklistCreate is the builder for creating a TaskChecklist entity.
+type TaskChecklistCreate struct {
+	config
+	mutation *TaskChecklistMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *TaskChecklistCreate) SetTaskID(v int) *TaskChecklistCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetChecklistID sets the "checklist_id" field.
+func (_c *TaskChecklistCreate) SetChecklistID(v int) *TaskChecklistCreate {
+	_c.mutation.SetChecklistID(v)
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *TaskChecklistCreate) SetTask(v *Task) *TaskChecklistCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// SetChecklist sets the "checklist" edge to the Checklist entity.
+func (_c *TaskChecklistCreate) SetChecklist(v *Checklist) *TaskChecklistCreate {
+	return _c.SetChecklistID(v.ID)
+}
+
+// Mutation returns the TaskChecklistMutation object of the builder.
+func (_c *TaskChecklistCreate) Mutation() *TaskChecklistMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskChecklist in the database.
+func (_c *TaskChecklistCreate) Save(ctx context.Context) (*TaskChecklist, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskChecklistCreate) SaveX(ctx context.Context) *TaskChecklist {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskChecklistCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskChecklistCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskChecklistCreate) check() error {
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskChecklist.task_id"`)}
+	}
+	if _, ok := _c.mutation.ChecklistID(); !ok {
+		return &ValidationError{Name: "checklist_id", err: errors.New(`ent: missing required field "TaskChecklist.checklist_id"`)}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskChecklist.task"`)}
+	}
+	if len(_c.mutation.ChecklistIDs()) == 0 {
+		return &ValidationError{Name: "checklist", err: errors.New(`ent: missing required edge "TaskChecklist.checklist"`)}
+	}
+	return nil
+}
+
+func (_c *TaskChecklistCreate) sqlSave(ctx context.Context) (*TaskChecklist, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskChecklistCreate) createSpec() (*TaskChecklist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskChecklist{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskchecklist.Table, sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt))
+	)
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.TaskTable,
+			Columns: []string{taskchecklist.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChecklistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.ChecklistTable,
+			Columns: []string{taskchecklist.ChecklistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChecklistID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskChecklistCreateBulk is the builder for creating many TaskChecklist entities in bulk.
+type TaskChecklistCreateBulk struct {
+	config
+	err      error
+	builders []*TaskChecklistCreate
+}
+
+// Save creates the TaskChecklist entities in the database.
+func (_c *TaskChecklistCreateBulk) Save(ctx context.Context) ([]*TaskChecklist, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskChecklist, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskChecklistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskChecklistCreateBulk) SaveX(ctx context.Context) []*TaskChecklist {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskChecklistCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskChecklistCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jkh/ent/checklist"
+	"jkh/ent/predicate"
+	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskChecklistUpdate is the builder for updating TaskChecklist entities.
+type TaskChecklistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskChecklistMutation
+}
+
+// Where appends a list predicates to the TaskChecklistUpdate builder.
+func (_u *TaskChecklistUpdate) Where(ps ...predicate.TaskChecklist) *TaskChecklistUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskChecklistUpdate) SetTaskID(v int) *TaskChecklistUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskChecklistUpdate) SetNillableTaskID(v *int) *TaskChecklistUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetChecklistID sets the "checklist_id" field.
+func (_u *TaskChecklistUpdate) SetChecklistID(v int) *TaskChecklistUpdate {
+	_u.mutation.SetChecklistID(v)
+	return _u
+}
+
+// SetNillableChecklistID sets the "checklist_id" field if the given value is not nil.
+func (_u *TaskChecklistUpdate) SetNillableChecklistID(v *int) *TaskChecklistUpdate {
+	if v != nil {
+		_u.SetChecklistID(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskChecklistUpdate) SetTask(v *Task) *TaskChecklistUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetChecklist sets the "checklist" edge to the Checklist entity.
+func (_u *TaskChecklistUpdate) SetChecklist(v *Checklist) *TaskChecklistUpdate {
+	return _u.SetChecklistID(v.ID)
+}
+
+// Mutation returns the TaskChecklistMutation object of the builder.
+func (_u *TaskChecklistUpdate) Mutation() *TaskChecklistMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskChecklistUpdate) ClearTask() *TaskChecklistUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearChecklist clears the "checklist" edge to the Checklist entity.
+func (_u *TaskChecklistUpdate) ClearChecklist() *TaskChecklistUpdate {
+	_u.mutation.ClearChecklist()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskChecklistUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskChecklistUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskChecklistUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskChecklistUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskChecklistUpdate) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskChecklist.task"`)
+	}
+	if _u.mutation.ChecklistCleared() && len(_u.mutation.ChecklistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskChecklist.checklist"`)
+	}
+	return nil
+}
+
+func (_u *TaskChecklistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskchecklist.Table, taskchecklist.Columns, sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.TaskTable,
+			Columns: []string{taskchecklist.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.TaskTable,
+			Columns: []string{taskchecklist.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChecklistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.ChecklistTable,
+			Columns: []string{taskchecklist.ChecklistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecklistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.ChecklistTable,
+			Columns: []string{taskchecklist.ChecklistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskchecklist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskChecklistUpdateOne is the builder for updating a single TaskChecklist entity.
+type TaskChecklistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskChecklistMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskChecklistUpdateOne) SetTaskID(v int) *TaskChecklistUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskChecklistUpdateOne) SetNillableTaskID(v *int) *TaskChecklistUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetChecklistID sets the "checklist_id" field.
+func (_u *TaskChecklistUpdateOne) SetChecklistID(v int) *TaskChecklistUpdateOne {
+	_u.mutation.SetChecklistID(v)
+	return _u
+}
+
+// SetNillableChecklistID sets the "checklist_id" field if the given value is not nil.
+func (_u *TaskChecklistUpdateOne) SetNillableChecklistID(v *int) *TaskChecklistUpdateOne {
+	if v != nil {
+		_u.SetChecklistID(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskChecklistUpdateOne) SetTask(v *Task) *TaskChecklistUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetChecklist sets the "checklist" edge to the Checklist entity.
+func (_u *TaskChecklistUpdateOne) SetChecklist(v *Checklist) *TaskChecklistUpdateOne {
+	return _u.SetChecklistID(v.ID)
+}
+
+// Mutation returns the TaskChecklistMutation object of the builder.
+func (_u *TaskChecklistUpdateOne) Mutation() *TaskChecklistMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskChecklistUpdateOne) ClearTask() *TaskChecklistUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearChecklist clears the "checklist" edge to the Checklist entity.
+func (_u *TaskChecklistUpdateOne) ClearChecklist() *TaskChecklistUpdateOne {
+	_u.mutation.ClearChecklist()
+	return _u
+}
+
+// Where appends a list predicates to the TaskChecklistUpdate builder.
+func (_u *TaskChecklistUpdateOne) Where(ps ...predicate.TaskChecklist) *TaskChecklistUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskChecklistUpdateOne) Select(field string, fields ...string) *TaskChecklistUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskChecklist entity.
+func (_u *TaskChecklistUpdateOne) Save(ctx context.Context) (*TaskChecklist, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskChecklistUpdateOne) SaveX(ctx context.Context) *TaskChecklist {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskChecklistUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskChecklistUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskChecklistUpdateOne) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskChecklist.task"`)
+	}
+	if _u.mutation.ChecklistCleared() && len(_u.mutation.ChecklistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskChecklist.checklist"`)
+	}
+	return nil
+}
+
+func (_u *TaskChecklistUpdateOne) sqlSave(ctx context.Context) (_node *TaskChecklist, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskchecklist.Table, taskchecklist.Columns, sqlgraph.NewFieldSpec(taskchecklist.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskChecklist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskchecklist.FieldID)
+		for _, f := range fields {
+			if !taskchecklist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskchecklist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.TaskTable,
+			Columns: []string{taskchecklist.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.TaskTable,
+			Columns: []string{taskchecklist.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChecklistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.ChecklistTable,
+			Columns: []string{taskchecklist.ChecklistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklist.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecklistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskchecklist.ChecklistTable,
+			Columns: []string{taskchecklist.ChecklistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklist.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskChecklist{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskchecklist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"jkh/ent/task"
+	"jkh/ent/taskstatushistory"
+	"jkh/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TaskStatusHistory is the model entity for the TaskStatusHistory schema.
+type TaskStatusHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy int `json:"changed_by,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskStatusHistoryQuery when eager-loading is set.
+	Edges        TaskStatusHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskStatusHistoryEdges holds the relations/edges for other nodes in the graph.
+type TaskStatusHistoryEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// ChangedByUser holds the value of the changed_by_user edge.
+	ChangedByUser *User `json:"changed_by_user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskStatusHistoryEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// ChangedByUserOrErr returns the ChangedByUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskStatusHistoryEdges) ChangedByUserOrErr() (*User, error) {
+	if e.ChangedByUser != nil {
+		return e.ChangedByUser, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "changed_by_user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskStatusHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskstatushistory.FieldID, taskstatushistory.FieldTaskID, taskstatushistory.FieldChangedBy:
+			values[i] = new(sql.NullInt64)
+		case taskstatushistory.FieldFromStatus, taskstatushistory.FieldToStatus:
+			values[i] = new(sql.NullString)
+		case taskstatushistory.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskStatusHistory fields.
+func (_m *TaskStatusHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskstatushistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case taskstatushistory.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = int(value.Int64)
+			}
+		case taskstatushistory.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = value.String
+			}
+		case taskstatushistory.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = value.String
+			}
+		case taskstatushistory.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				_m.ChangedBy = int(value.Int64)
+			}
+		case taskstatushistory.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				_m.ChangedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskStatusHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskStatusHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskStatusHistory entity.
+func (_m *TaskStatusHistory) QueryTask() *TaskQuery {
+	return NewTaskStatusHistoryClient(_m.config).QueryTask(_m)
+}
+
+// QueryChangedByUser queries the "changed_by_user" edge of the TaskStatusHistory entity.
+func (_m *TaskStatusHistory) QueryChangedByUser() *UserQuery {
+	return NewTaskStatusHistoryClient(_m.config).QueryChangedByUser(_m)
+}
+
+// Update returns a builder for updating this TaskStatusHistory.
+// Note that you need to call TaskStatusHistory.Unwrap() before calling this method if this TaskStatusHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskStatusHistory) Update() *TaskStatusHistoryUpdateOne {
+	return NewTaskStatusHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskStatusHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskStatusHistory) Unwrap() *TaskStatusHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskStatusHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskStatusHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskStatusHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(_m.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(_m.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChangedBy))
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(_m.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskStatusHistories is a parsable slice of TaskStatusHistory.
+type TaskStatusHistories []*TaskStatusHistory
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jkh/ent/task"
+	"jkh/ent/taskstatushistory"
+	"jkh/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskStatusHistoryCreate is the builder for creating a TaskStatusHistory entity.
+type TaskStatusHistoryCreate struct {
+	config
+	mutation *TaskStatusHistoryMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *TaskStatusHistoryCreate) SetTaskID(v int) *TaskStatusHistoryCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *TaskStatusHistoryCreate) SetFromStatus(v string) *TaskStatusHistoryCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *TaskStatusHistoryCreate) SetToStatus(v string) *TaskStatusHistoryCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_c *TaskStatusHistoryCreate) SetChangedBy(v int) *TaskStatusHistoryCreate {
+	_c.mutation.SetChangedBy(v)
+	return _c
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_c *TaskStatusHistoryCreate) SetNillableChangedBy(v *int) *TaskStatusHistoryCreate {
+	if v != nil {
+		_c.SetChangedBy(*v)
+	}
+	return _c
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_c *TaskStatusHistoryCreate) SetChangedAt(v time.Time) *TaskStatusHistoryCreate {
+	_c.mutation.SetChangedAt(v)
+	return _c
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_c *TaskStatusHistoryCreate) SetNillableChangedAt(v *time.Time) *TaskStatusHistoryCreate {
+	if v != nil {
+		_c.SetChangedAt(*v)
+	}
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *TaskStatusHistoryCreate) SetTask(v *Task) *TaskStatusHistoryCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// SetChangedByUserID sets the "changed_by_user" edge to the User entity by ID.
+func (_c *TaskStatusHistoryCreate) SetChangedByUserID(id int) *TaskStatusHistoryCreate {
+	_c.mutation.SetChangedByUserID(id)
+	return _c
+}
+
+// SetNillableChangedByUserID sets the "changed_by_user" edge to the User entity by ID if the given value is not nil.
+func (_c *TaskStatusHistoryCreate) SetNillableChangedByUserID(id *int) *TaskStatusHistoryCreate {
+	if id != nil {
+		_c = _c.SetChangedByUserID(*id)
+	}
+	return _c
+}
+
+// SetChangedByUser sets the "changed_by_user" edge to the User entity.
+func (_c *TaskStatusHistoryCreate) SetChangedByUser(v *User) *TaskStatusHistoryCreate {
+	return _c.SetChangedByUserID(v.ID)
+}
+
+// Mutation returns the TaskStatusHistoryMutation object of the builder.
+func (_c *TaskStatusHistoryCreate) Mutation() *TaskStatusHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskStatusHistory in the database.
+func (_c *TaskStatusHistoryCreate) Save(ctx context.Context) (*TaskStatusHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskStatusHistoryCreate) SaveX(ctx context.Context) *TaskStatusHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskStatusHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskStatusHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskStatusHistoryCreate) defaults() {
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		v := taskstatushistory.DefaultChangedAt()
+		_c.mutation.SetChangedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskStatusHistoryCreate) check() error {
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskStatusHistory.task_id"`)}
+	}
+	if _, ok := _c.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "TaskStatusHistory.from_status"`)}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "TaskStatusHistory.to_status"`)}
+	}
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "TaskStatusHistory.changed_at"`)}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskStatusHistory.task"`)}
+	}
+	return nil
+}
+
+func (_c *TaskStatusHistoryCreate) sqlSave(ctx context.Context) (*TaskStatusHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskStatusHistoryCreate) createSpec() (*TaskStatusHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskStatusHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskstatushistory.Table, sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(taskstatushistory.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(taskstatushistory.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.ChangedAt(); ok {
+		_spec.SetField(taskstatushistory.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.TaskTable,
+			Columns: []string{taskstatushistory.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChangedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.ChangedByUserTable,
+			Columns: []string{taskstatushistory.ChangedByUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChangedBy = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskStatusHistoryCreateBulk is the builder for creating many TaskStatusHistory entities in bulk.
+type TaskStatusHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*TaskStatusHistoryCreate
+}
+
+// Save creates the TaskStatusHistory entities in the database.
+func (_c *TaskStatusHistoryCreateBulk) Save(ctx context.Context) ([]*TaskStatusHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskStatusHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskStatusHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskStatusHistoryCreateBulk) SaveX(ctx context.Context) []*TaskStatusHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskStatusHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskStatusHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,523 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"jkh/ent/predicate"
+	"jkh/ent/task"
+	"jkh/ent/taskstatushistory"
+	"jkh/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskStatusHistoryUpdate is the builder for updating TaskStatusHistory entities.
+type TaskStatusHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskStatusHistoryMutation
+}
+
+// Where appends a list predicates to the TaskStatusHistoryUpdate builder.
+func (_u *TaskStatusHistoryUpdate) Where(ps ...predicate.TaskStatusHistory) *TaskStatusHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskStatusHistoryUpdate) SetTaskID(v int) *TaskStatusHistoryUpdate {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdate) SetNillableTaskID(v *int) *TaskStatusHistoryUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *TaskStatusHistoryUpdate) SetFromStatus(v string) *TaskStatusHistoryUpdate {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdate) SetNillableFromStatus(v *string) *TaskStatusHistoryUpdate {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *TaskStatusHistoryUpdate) SetToStatus(v string) *TaskStatusHistoryUpdate {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdate) SetNillableToStatus(v *string) *TaskStatusHistoryUpdate {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *TaskStatusHistoryUpdate) SetChangedBy(v int) *TaskStatusHistoryUpdate {
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdate) SetNillableChangedBy(v *int) *TaskStatusHistoryUpdate {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (_u *TaskStatusHistoryUpdate) ClearChangedBy() *TaskStatusHistoryUpdate {
+	_u.mutation.ClearChangedBy()
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskStatusHistoryUpdate) SetTask(v *Task) *TaskStatusHistoryUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetChangedByUserID sets the "changed_by_user" edge to the User entity by ID.
+func (_u *TaskStatusHistoryUpdate) SetChangedByUserID(id int) *TaskStatusHistoryUpdate {
+	_u.mutation.SetChangedByUserID(id)
+	return _u
+}
+
+// SetNillableChangedByUserID sets the "changed_by_user" edge to the User entity by ID if the given value is not nil.
+func (_u *TaskStatusHistoryUpdate) SetNillableChangedByUserID(id *int) *TaskStatusHistoryUpdate {
+	if id != nil {
+		_u = _u.SetChangedByUserID(*id)
+	}
+	return _u
+}
+
+// SetChangedByUser sets the "changed_by_user" edge to the User entity.
+func (_u *TaskStatusHistoryUpdate) SetChangedByUser(v *User) *TaskStatusHistoryUpdate {
+	return _u.SetChangedByUserID(v.ID)
+}
+
+// Mutation returns the TaskStatusHistoryMutation object of the builder.
+func (_u *TaskStatusHistoryUpdate) Mutation() *TaskStatusHistoryMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskStatusHistoryUpdate) ClearTask() *TaskStatusHistoryUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearChangedByUser clears the "changed_by_user" edge to the User entity.
+func (_u *TaskStatusHistoryUpdate) ClearChangedByUser() *TaskStatusHistoryUpdate {
+	_u.mutation.ClearChangedByUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskStatusHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskStatusHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskStatusHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskStatusHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskStatusHistoryUpdate) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskStatusHistory.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskStatusHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskstatushistory.Table, taskstatushistory.Columns, sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(taskstatushistory.FieldFromStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(taskstatushistory.FieldToStatus, field.TypeString, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.TaskTable,
+			Columns: []string{taskstatushistory.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.TaskTable,
+			Columns: []string{taskstatushistory.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChangedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.ChangedByUserTable,
+			Columns: []string{taskstatushistory.ChangedByUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChangedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.ChangedByUserTable,
+			Columns: []string{taskstatushistory.ChangedByUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskstatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskStatusHistoryUpdateOne is the builder for updating a single TaskStatusHistory entity.
+type TaskStatusHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskStatusHistoryMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskStatusHistoryUpdateOne) SetTaskID(v int) *TaskStatusHistoryUpdateOne {
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdateOne) SetNillableTaskID(v *int) *TaskStatusHistoryUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *TaskStatusHistoryUpdateOne) SetFromStatus(v string) *TaskStatusHistoryUpdateOne {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdateOne) SetNillableFromStatus(v *string) *TaskStatusHistoryUpdateOne {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *TaskStatusHistoryUpdateOne) SetToStatus(v string) *TaskStatusHistoryUpdateOne {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdateOne) SetNillableToStatus(v *string) *TaskStatusHistoryUpdateOne {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *TaskStatusHistoryUpdateOne) SetChangedBy(v int) *TaskStatusHistoryUpdateOne {
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *TaskStatusHistoryUpdateOne) SetNillableChangedBy(v *int) *TaskStatusHistoryUpdateOne {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (_u *TaskStatusHistoryUpdateOne) ClearChangedBy() *TaskStatusHistoryUpdateOne {
+	_u.mutation.ClearChangedBy()
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskStatusHistoryUpdateOne) SetTask(v *Task) *TaskStatusHistoryUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetChangedByUserID sets the "changed_by_user" edge to the User entity by ID.
+func (_u *TaskStatusHistoryUpdateOne) SetChangedByUserID(id int) *TaskStatusHistoryUpdateOne {
+	_u.mutation.SetChangedByUserID(id)
+	return _u
+}
+
+// SetNillableChangedByUserID sets the "changed_by_user" edge to the User entity by ID if the given value is not nil.
+func (_u *TaskStatusHistoryUpdateOne) SetNillableChangedByUserID(id *int) *TaskStatusHistoryUpdateOne {
+	if id != nil {
+		_u = _u.SetChangedByUserID(*id)
+	}
+	return _u
+}
+
+// SetChangedByUser sets the "changed_by_user" edge to the User entity.
+func (_u *TaskStatusHistoryUpdateOne) SetChangedByUser(v *User) *TaskStatusHistoryUpdateOne {
+	return _u.SetChangedByUserID(v.ID)
+}
+
+// Mutation returns the TaskStatusHistoryMutation object of the builder.
+func (_u *TaskStatusHistoryUpdateOne) Mutation() *TaskStatusHistoryMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskStatusHistoryUpdateOne) ClearTask() *TaskStatusHistoryUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearChangedByUser clears the "changed_by_user" edge to the User entity.
+func (_u *TaskStatusHistoryUpdateOne) ClearChangedByUser() *TaskStatusHistoryUpdateOne {
+	_u.mutation.ClearChangedByUser()
+	return _u
+}
+
+// Where appends a list predicates to the TaskStatusHistoryUpdate builder.
+func (_u *TaskStatusHistoryUpdateOne) Where(ps ...predicate.TaskStatusHistory) *TaskStatusHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskStatusHistoryUpdateOne) Select(field string, fields ...string) *TaskStatusHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskStatusHistory entity.
+func (_u *TaskStatusHistoryUpdateOne) Save(ctx context.Context) (*TaskStatusHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskStatusHistoryUpdateOne) SaveX(ctx context.Context) *TaskStatusHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskStatusHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskStatusHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskStatusHistoryUpdateOne) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskStatusHistory.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskStatusHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TaskStatusHistory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskstatushistory.Table, taskstatushistory.Columns, sqlgraph.NewFieldSpec(taskstatushistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskStatusHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskstatushistory.FieldID)
+		for _, f := range fields {
+			if !taskstatushistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskstatushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(taskstatushistory.FieldFromStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(taskstatushistory.FieldToStatus, field.TypeString, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.TaskTable,
+			Columns: []string{taskstatushistory.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.TaskTable,
+			Columns: []string{taskstatushistory.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChangedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.ChangedByUserTable,
+			Columns: []string{taskstatushistory.ChangedByUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChangedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatushistory.ChangedByUserTable,
+			Columns: []string{taskstatushistory.ChangedByUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskStatusHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskstatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,6 +12,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ActSequence is the client for interacting with the ActSequence builders.
+	ActSequence *ActSequenceClient
 	// Building is the client for interacting with the Building builders.
 	Building *BuildingClient
 	// Checklist is the client for interacting with the Checklist builders.
@@ -34,6 +36,10 @@ type Tx struct {
 	Role *RoleClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskChecklist is the client for interacting with the TaskChecklist builders.
+	TaskChecklist *TaskChecklistClient
+	// TaskStatusHistory is the client for interacting with the TaskStatusHistory builders.
+	TaskStatusHistory *TaskStatusHistoryClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -167,6 +173,7 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ActSequence = NewActSequenceClient(tx.config)
 	tx.Building = NewBuildingClient(tx.config)
 	tx.Checklist = NewChecklistClient(tx.config)
 	tx.ChecklistElement = NewChecklistElementClient(tx.config)
@@ -178,6 +185,8 @@ func (tx *Tx) init() {
 	tx.JkhUnit = NewJkhUnitClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskChecklist = NewTaskChecklistClient(tx.config)
+	tx.TaskStatusHistory = NewTaskStatusHistoryClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -188,7 +197,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Building.QueryXXX(), the query will be executed
+// applies a query, for example: ActSequence.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -7,6 +7,7 @@ import (
 	"jkh/ent/role"
 	"jkh/ent/user"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -29,6 +30,8 @@ type User struct {
 	FirstName string `json:"first_name,omitempty"`
 	// LastName holds the value of the "last_name" field.
 	LastName string `json:"last_name,omitempty"`
+	// LastLoginAt holds the value of the "last_login_at" field.
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -45,9 +48,11 @@ type UserEdges struct {
 	AssignedBuildings []*Building `json:"assigned_buildings,omitempty"`
 	// AssignedUnits holds the value of the assigned_units edge.
 	AssignedUnits []*InspectorUnit `json:"assigned_units,omitempty"`
+	// StatusChanges holds the value of the status_changes edge.
+	StatusChanges []*TaskStatusHistory `json:"status_changes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // RoleOrErr returns the Role value or an error if the edge
@@ -88,6 +93,15 @@ func (e UserEdges) AssignedUnitsOrErr() ([]*InspectorUnit, error) {
 	return nil, &NotLoadedError{edge: "assigned_units"}
 }
 
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) StatusChangesOrErr() ([]*TaskStatusHistory, error) {
+	if e.loadedTypes[4] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -97,6 +111,8 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldLogin, user.FieldPasswordHash, user.FieldFirstName, user.FieldLastName:
 			values[i] = new(sql.NullString)
+		case user.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -154,6 +170,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LastName = value.String
 			}
+		case user.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				_m.LastLoginAt = new(time.Time)
+				*_m.LastLoginAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -187,6 +210,11 @@ func (_m *User) QueryAssignedUnits() *InspectorUnitQuery {
 	return NewUserClient(_m.config).QueryAssignedUnits(_m)
 }
 
+// QueryStatusChanges queries the "status_changes" edge of the User entity.
+func (_m *User) QueryStatusChanges() *TaskStatusHistoryQuery {
+	return NewUserClient(_m.config).QueryStatusChanges(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -226,6 +254,11 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_name=")
 	builder.WriteString(_m.LastName)
+	builder.WriteString(", ")
+	if v := _m.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -11,6 +11,7 @@ import (
 	"jkh/ent/predicate"
 	"jkh/ent/role"
 	"jkh/ent/task"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
 	"math"
 
@@ -31,6 +32,7 @@ type UserQuery struct {
 	withInspections       *TaskQuery
 	withAssignedBuildings *BuildingQuery
 	withAssignedUnits     *InspectorUnitQuery
+	withStatusChanges     *TaskStatusHistoryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +157,28 @@ func (_q *UserQuery) QueryAssignedUnits() *InspectorUnitQuery {
 	return query
 }
 
+// QueryStatusChanges chains the current query on the "status_changes" edge.
+func (_q *UserQuery) QueryStatusChanges() *TaskStatusHistoryQuery {
+	query := (&TaskStatusHistoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(taskstatushistory.Table, taskstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StatusChangesTable, user.StatusChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -351,6 +375,7 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withInspections:       _q.withInspections.Clone(),
 		withAssignedBuildings: _q.withAssignedBuildings.Clone(),
 		withAssignedUnits:     _q.withAssignedUnits.Clone(),
+		withStatusChanges:     _q.withStatusChanges.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -401,6 +426,17 @@ func (_q *UserQuery) WithAssignedUnits(opts ...func(*InspectorUnitQuery)) *UserQ
 	return _q
 }
 
+// WithStatusChanges tells the query-builder to eager-load the nodes that are connected to
+// the "status_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithStatusChanges(opts ...func(*TaskStatusHistoryQuery)) *UserQuery {
+	query := (&TaskStatusHistoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatusChanges = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -479,11 +515,12 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withRole != nil,
 			_q.withInspections != nil,
 			_q.withAssignedBuildings != nil,
 			_q.withAssignedUnits != nil,
+			_q.withStatusChanges != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -531,6 +568,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withStatusChanges; query != nil {
+		if err := _q.loadStatusChanges(ctx, query, nodes,
+			func(n *User) { n.Edges.StatusChanges = []*TaskStatusHistory{} },
+			func(n *User, e *TaskStatusHistory) { n.Edges.StatusChanges = append(n.Edges.StatusChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -653,6 +697,36 @@ func (_q *UserQuery) loadAssignedUnits(ctx context.Context, query *InspectorUnit
 	}
 	return nil
 }
+func (_q *UserQuery) loadStatusChanges(ctx context.Context, query *TaskStatusHistoryQuery, nodes []*User, init func(*User), assign func(*User, *TaskStatusHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskstatushistory.FieldChangedBy)
+	}
+	query.Where(predicate.TaskStatusHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.StatusChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChangedBy
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "changed_by" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 
+	"golang.org/x/crypto/bcrypt"
 	"jkh/ent"
 	"jkh/ent/role" // Импортируем модель для работы с ролями
 	"jkh/pkg/db"
+	"jkh/pkg/logger"
 	"jkh/pkg/server"
 
 	_ "jkh/docs" // Swagger документация (сгенерированная)
@@ -34,18 +37,23 @@ import (
 // @description Введите JWT токен в формате: Bearer {token}
 
 func main() {
-	log.Println("Starting JKH Inspection Backend...")
+	// Уровень логирования задаётся JKH_LOG_LEVEL (debug, info, warn, error).
+	// Фатальные ошибки запуска по-прежнему выводятся через log.Fatal независимо от уровня.
+	logger.SetDefault(logger.FromEnv())
+	logger.Infof("Starting JKH Inspection Backend...")
 	
 	// 1. Инициализация клиента БД Ent и выполнение миграций
 	entClient := db.NewClient()
 	defer func() {
 		if err := entClient.Close(); err!= nil {
-			log.Printf("Error closing DB client: %v", err)
+			logger.Errorf("Error closing DB client: %v", err)
 		}
 	}()
 
-	// 2. Добавление базовых ролей (Specialist, Coordinator, Inspector)
-	seedDatabase(entClient)
+	// 2. Добавление базовых ролей (Specialist, Coordinator, Inspector) и первого администратора
+	if err := seedDatabase(context.Background(), entClient, adminSeedFromEnv()); err != nil {
+		log.Fatalf("Failed to seed database: %v", err)
+	}
 
 	// 3. Инициализация и запуск HTTP-сервера Gin
 	r := server.SetupRouter(entClient)
@@ -53,30 +61,104 @@ func main() {
 	log.Fatal(r.Run(":8080")) // Сервер будет запущен на порту 8080
 }
 
-// seedDatabase создает необходимые базовые данные (роли)
-func seedDatabase(client *ent.Client) {
-    ctx := context.Background()
-
-    roles := []string{"Specialist", "Coordinator", "Inspector"}
-
-    for _, roleName := range roles {
-        // Проверяем, существует ли роль
-        count, err := client.Role.Query().
-            Where(role.NameEQ(roleName)).
-            Count(ctx)
-        
-        if err!= nil {
-            log.Fatalf("Failed to query roles: %v", err)
-        }
-
-        if count == 0 {
-            _, err := client.Role.Create().
-                SetName(roleName).
-                Save(ctx)
-            if err!= nil {
-                log.Fatalf("Failed to seed role %s: %v", roleName, err)
-            }
-            fmt.Printf("Role '%s' seeded successfully.\n", roleName)
-        }
-    }
-}
\ No newline at end of file
+// adminSeed — параметры первого администратора (Specialist), задаваемые через окружение.
+type adminSeed struct {
+	Email     string
+	Login     string
+	Password  string
+	FirstName string
+	LastName  string
+}
+
+// adminSeedFromEnv читает параметры первого администратора из переменных окружения
+// JKH_ADMIN_EMAIL, JKH_ADMIN_LOGIN, JKH_ADMIN_PASSWORD (обязательные),
+// JKH_ADMIN_FIRST_NAME, JKH_ADMIN_LAST_NAME (необязательные).
+// Возвращает nil, если администратор не настроен.
+func adminSeedFromEnv() *adminSeed {
+	admin := &adminSeed{
+		Email:     os.Getenv("JKH_ADMIN_EMAIL"),
+		Login:     os.Getenv("JKH_ADMIN_LOGIN"),
+		Password:  os.Getenv("JKH_ADMIN_PASSWORD"),
+		FirstName: os.Getenv("JKH_ADMIN_FIRST_NAME"),
+		LastName:  os.Getenv("JKH_ADMIN_LAST_NAME"),
+	}
+	if admin.Email == "" || admin.Login == "" || admin.Password == "" {
+		return nil
+	}
+	if admin.FirstName == "" {
+		admin.FirstName = "Администратор"
+	}
+	if admin.LastName == "" {
+		admin.LastName = "Системы"
+	}
+	return admin
+}
+
+// seedDatabase создает необходимые базовые данные (роли и, при наличии настроек, первого администратора).
+// Повторный вызов ничего не дублирует.
+func seedDatabase(ctx context.Context, client *ent.Client, admin *adminSeed) error {
+	roles := []string{"Specialist", "Coordinator", "Inspector"}
+
+	for _, roleName := range roles {
+		// Проверяем, существует ли роль
+		count, err := client.Role.Query().
+			Where(role.NameEQ(roleName)).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query roles: %w", err)
+		}
+
+		if count == 0 {
+			if _, err := client.Role.Create().SetName(roleName).Save(ctx); err != nil {
+				return fmt.Errorf("failed to seed role %s: %w", roleName, err)
+			}
+			logger.Infof("Role '%s' seeded successfully.", roleName)
+		}
+	}
+
+	return seedAdmin(ctx, client, admin)
+}
+
+// seedAdmin создает первого администратора (Specialist), если в системе ещё нет пользователей.
+func seedAdmin(ctx context.Context, client *ent.Client, admin *adminSeed) error {
+	if admin == nil {
+		logger.Infof("Initial admin is not configured (JKH_ADMIN_EMAIL/JKH_ADMIN_LOGIN/JKH_ADMIN_PASSWORD), skipping.")
+		return nil
+	}
+
+	count, err := client.User.Query().Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query users: %w", err)
+	}
+	if count > 0 {
+		logger.Infof("Initial admin not created: %d user(s) already present.", count)
+		return nil
+	}
+
+	specialist, err := client.Role.Query().
+		Where(role.NameEQ("Specialist")).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find Specialist role: %w", err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(admin.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash admin password: %w", err)
+	}
+
+	_, err = client.User.Create().
+		SetEmail(admin.Email).
+		SetLogin(admin.Login).
+		SetPasswordHash(string(hashedPassword)).
+		SetFirstName(admin.FirstName).
+		SetLastName(admin.LastName).
+		SetRole(specialist).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create initial admin: %w", err)
+	}
+
+	logger.Infof("Initial admin '%s' created successfully.", admin.Login)
+	return nil
+}
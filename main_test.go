@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"jkh/ent/user"
+	"jkh/pkg/testutil"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestSeedDatabase_Idempotent(t *testing.T) {
+	client := testutil.SetupTestDBWithoutRoles(t)
+	ctx := context.Background()
+
+	admin := &adminSeed{
+		Email:     "admin@example.com",
+		Login:     "admin",
+		Password:  "secret123",
+		FirstName: "Иван",
+		LastName:  "Специалист",
+	}
+
+	// Двукратный запуск не должен дублировать роли и администратора
+	for i := 0; i < 2; i++ {
+		if err := seedDatabase(ctx, client, admin); err != nil {
+			t.Fatalf("seedDatabase (run %d) failed: %v", i+1, err)
+		}
+	}
+
+	if n := client.Role.Query().CountX(ctx); n != 3 {
+		t.Errorf("Expected 3 roles, got %d", n)
+	}
+	if n := client.User.Query().CountX(ctx); n != 1 {
+		t.Fatalf("Expected 1 user, got %d", n)
+	}
+
+	u := client.User.Query().Where(user.LoginEQ("admin")).WithRole().OnlyX(ctx)
+	if u.Edges.Role.Name != "Specialist" {
+		t.Errorf("Expected admin role 'Specialist', got %s", u.Edges.Role.Name)
+	}
+	if u.PasswordHash == admin.Password {
+		t.Error("Expected password to be hashed")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(admin.Password)); err != nil {
+		t.Errorf("Password hash does not match: %v", err)
+	}
+}
+
+func TestSeedDatabase_NoAdminConfigured(t *testing.T) {
+	client := testutil.SetupTestDBWithoutRoles(t)
+	ctx := context.Background()
+
+	if err := seedDatabase(ctx, client, nil); err != nil {
+		t.Fatalf("seedDatabase failed: %v", err)
+	}
+
+	if n := client.Role.Query().CountX(ctx); n != 3 {
+		t.Errorf("Expected 3 roles, got %d", n)
+	}
+	if n := client.User.Query().CountX(ctx); n != 0 {
+		t.Errorf("Expected no users, got %d", n)
+	}
+}
+
+func TestAdminSeedFromEnv(t *testing.T) {
+	t.Setenv("JKH_ADMIN_EMAIL", "root@example.com")
+	t.Setenv("JKH_ADMIN_LOGIN", "root")
+	t.Setenv("JKH_ADMIN_PASSWORD", "")
+
+	if admin := adminSeedFromEnv(); admin != nil {
+		t.Errorf("Expected nil admin without password, got %+v", admin)
+	}
+
+	t.Setenv("JKH_ADMIN_PASSWORD", "secret")
+	admin := adminSeedFromEnv()
+	if admin == nil {
+		t.Fatal("Expected admin to be configured")
+	}
+	if admin.Login != "root" || admin.FirstName == "" || admin.LastName == "" {
+		t.Errorf("Unexpected admin seed: %+v", admin)
+	}
+}
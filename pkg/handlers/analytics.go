@@ -3,8 +3,10 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"jkh/pkg/models"
@@ -21,17 +23,37 @@ func NewAnalyticsHandler(s *service.AnalyticsService) *AnalyticsHandler {
 	return &AnalyticsHandler{Service: s}
 }
 
+// GetSummary godoc
+// @Summary      Сводка для панели координатора
+// @Description  Распределение заданий по статусам, доля утверждённых (в процентах), лента за последние 30 дней и количество актов, ожидающих утверждения
+// @Tags         Аналитика
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} models.SummaryStats "Сводка"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /tasks/analytics/summary [get]
+func (h *AnalyticsHandler) GetSummary(c *gin.Context) {
+	summary, err := h.Service.GetSummary(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to build summary"})
+		return
+	}
+	c.JSON(http.StatusOK, summary)
+}
+
 // PreviewChart godoc
 // @Summary      Предпросмотр графика
 // @Description  Генерация графика в формате PNG для предпросмотра
 // @Tags         Аналитика
 // @Produce      image/png
 // @Security     BearerAuth
-// @Param        chart query string true "Тип графика" Enums(inspector_performance, status_distribution, failure_frequency)
+// @Param        chart query string true "Тип графика" Enums(inspector_performance, status_distribution, failure_frequency, problem_rate, failure_by_district)
 // @Param        from query string true "Начало периода (YYYY-MM-DD)"
 // @Param        to query string true "Конец периода (YYYY-MM-DD)"
+// @Param        inspector_id query int false "Только задания этого инспектора"
 // @Success      200 {file} file "PNG изображение графика"
-// @Failure      400 {object} map[string]string "Неверные параметры"
+// @Failure      400 {object} map[string]string "Неверные параметры или инспектор не найден"
 // @Failure      401 {object} map[string]string "Не авторизован"
 // @Failure      500 {object} map[string]string "Ошибка генерации графика"
 // @Router       /tasks/analytics/preview [get]
@@ -54,21 +76,38 @@ func (h *AnalyticsHandler) PreviewChart(c *gin.Context) {
 		return
 	}
 
+	inspectorID := 0
+	if raw := c.Query("inspector_id"); raw != "" {
+		inspectorID, err = strconv.Atoi(raw)
+		if err != nil || inspectorID <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inspector_id"})
+			return
+		}
+	}
+
 	var img []byte
 
 	switch chart {
 	case "inspector_performance":
-		img, err = h.Service.GenerateInspectorPerformancePNG(c.Request.Context(), from, to)
+		img, err = h.Service.GenerateInspectorPerformancePNG(c.Request.Context(), from, to, inspectorID)
 	case "status_distribution":
-		img, err = h.Service.GenerateStatusDistributionPNG(c.Request.Context(), from, to)
+		img, err = h.Service.GenerateStatusDistributionPNG(c.Request.Context(), from, to, inspectorID)
 	case "failure_frequency":
-		img, err = h.Service.GenerateFailureFrequencyPNG(c.Request.Context(), from, to)
+		img, err = h.Service.GenerateFailureFrequencyPNG(c.Request.Context(), from, to, inspectorID)
+	case "problem_rate":
+		img, err = h.Service.GenerateProblemRatePNG(c.Request.Context(), from, to, inspectorID)
+	case "failure_by_district":
+		img, err = h.Service.GenerateFailureByDistrictPNG(c.Request.Context(), from, to, inspectorID)
 	default:
 		c.JSON(http.StatusBadRequest, gin.H{"error": "unsupported chart type"})
 		return
 	}
 
 	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "inspector not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to build chart: " + err.Error()})
 		return
 	}
@@ -84,7 +123,7 @@ func (h *AnalyticsHandler) PreviewChart(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        request body models.AnalyticsReportRequest true "Параметры отчёта"
 // @Success      200 {file} file "PDF файл отчёта"
-// @Failure      400 {object} map[string]string "Неверные параметры"
+// @Failure      400 {object} map[string]string "Неверные параметры или инспектор не найден"
 // @Failure      401 {object} map[string]string "Не авторизован"
 // @Failure      500 {object} map[string]string "Ошибка генерации отчёта"
 // @Router       /tasks/analytics/report [post]
@@ -107,12 +146,21 @@ func (h *AnalyticsHandler) GenerateReport(c *gin.Context) {
 
 	charts := req.Charts
 	if len(charts) == 0 {
-		// По умолчанию генерируем все 3 графика
-		charts = []string{"status_distribution", "failure_frequency", "inspector_performance"}
+		// По умолчанию генерируем все графики
+		charts = []string{"status_distribution", "failure_frequency", "inspector_performance", "problem_rate", "failure_by_district"}
+	}
+
+	inspectorID := 0
+	if req.InspectorID != nil {
+		inspectorID = *req.InspectorID
 	}
 
-	pdfBytes, filename, err := h.Service.GenerateReportPDF(c.Request.Context(), from, to, charts)
+	pdfBytes, filename, err := h.Service.GenerateReportPDF(c.Request.Context(), from, to, charts, inspectorID)
 	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "inspector not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate report"})
 		return
 	}
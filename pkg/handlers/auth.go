@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/crypto/bcrypt"
@@ -10,6 +11,7 @@ import (
 	"jkh/ent"
 	"jkh/ent/user"
 	"jkh/pkg/auth"
+	"jkh/pkg/logger"
 	"jkh/pkg/models"
 )
 
@@ -94,6 +96,11 @@ func (h *AuthHandler) Login(c *gin.Context) {
         return
     }
 
+    // Запоминаем время входа (для сводки по пользователю); ошибка не мешает входу
+    if err := h.Client.User.UpdateOneID(foundUser.ID).SetLastLoginAt(time.Now()).Exec(ctx); err != nil {
+        logger.Warnf("failed to record last login for user %d: %v", foundUser.ID, err)
+    }
+
     // 5. Отдаём токены: вариант A — возвращаем оба в JSON
     c.JSON(http.StatusOK, models.LoginResponse{
         AccessToken:  accessToken,
@@ -56,7 +56,7 @@ func TestAuthHandler_Login_Success(t *testing.T) {
 
 	// Создаём пользователя
 	hash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
-	client.User.Create().
+	u := client.User.Create().
 		SetEmail("test@example.com").
 		SetLogin("testuser").
 		SetPasswordHash(string(hash)).
@@ -98,6 +98,11 @@ func TestAuthHandler_Login_Success(t *testing.T) {
 	if resp.Role != "inspector" {
 		t.Errorf("Expected role 'inspector', got %s", resp.Role)
 	}
+
+	// Время входа сохраняется
+	if client.User.GetX(ctx, u.ID).LastLoginAt == nil {
+		t.Error("Expected last_login_at to be set after login")
+	}
 }
 
 func TestAuthHandler_Login_InvalidCredentials(t *testing.T) {
@@ -29,7 +29,7 @@ func NewBuildingHandler(s *service.BuildingService) *BuildingHandler {
 // @Security     BearerAuth
 // @Param        request body models.CreateBuildingRequest true "Данные здания"
 // @Success      201 {object} models.BuildingResponse "Здание успешно создано"
-// @Failure      400 {object} map[string]string "Неверный запрос или FK не найден"
+// @Failure      400 {object} map[string]string "Неверный запрос, FK не найден, ЖЭУ не относится к району, неверный телефон контакта или отрицательный размер здания"
 // @Failure      401 {object} map[string]string "Не авторизован"
 // @Failure      409 {object} map[string]string "Адрес здания уже существует"
 // @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
@@ -51,6 +51,18 @@ func (h *BuildingHandler) CreateBuilding(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid District, JKH Unit, or Inspector ID"})
 			return
 		}
+		if errors.Is(err, service.ErrDistrictUnitMismatch) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "JKH unit does not belong to the specified district"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidContactPhone) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid contact phone"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidBuildingSize) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Floors, entrances and apartments must be non-negative"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create building"})
 		return
 	}
@@ -58,18 +70,46 @@ func (h *BuildingHandler) CreateBuilding(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
+// parseBuildingFilter — разбор query-параметров district_id и jkh_unit_id.
+func parseBuildingFilter(c *gin.Context) (models.BuildingFilter, error) {
+	var filter models.BuildingFilter
+
+	if districtID, err := parseOptionalInt(c, "district_id"); err != nil {
+		return filter, err
+	} else if districtID > 0 {
+		filter.DistrictID = &districtID
+	}
+
+	if jkhUnitID, err := parseOptionalInt(c, "jkh_unit_id"); err != nil {
+		return filter, err
+	} else if jkhUnitID > 0 {
+		filter.JkhUnitID = &jkhUnitID
+	}
+
+	return filter, nil
+}
+
 // ListBuildings godoc
 // @Summary      Получить список зданий
-// @Description  Возвращает список всех зданий в системе
+// @Description  Возвращает список зданий в системе с возможностью фильтрации по району и ЖЭУ
 // @Tags         Здания
 // @Produce      json
 // @Security     BearerAuth
+// @Param        district_id query int false "Фильтр по району"
+// @Param        jkh_unit_id query int false "Фильтр по ЖЭУ"
 // @Success      200 {array} models.BuildingResponse "Список зданий"
+// @Failure      400 {object} map[string]string "Неверные параметры фильтра"
 // @Failure      401 {object} map[string]string "Не авторизован"
 // @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
 // @Router       /admin/buildings [get]
 func (h *BuildingHandler) ListBuildings(c *gin.Context) {
-	resp, err := h.Service.ListBuildings(c.Request.Context())
+	filter, err := parseBuildingFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filter parameters"})
+		return
+	}
+
+	resp, err := h.Service.ListBuildings(c.Request.Context(), filter)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve building list"})
 		return
@@ -77,6 +117,36 @@ func (h *BuildingHandler) ListBuildings(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// ExportBuildingsCSV godoc
+// @Summary      Экспорт реестра зданий в CSV
+// @Description  Выгрузка зданий (id, адрес, район, ЖЭУ, год постройки, наличие инспектора) в CSV с UTF-8 BOM для Excel. Поддерживает те же фильтры, что и список зданий
+// @Tags         Здания
+// @Produce      text/csv
+// @Security     BearerAuth
+// @Param        district_id query int false "Фильтр по району"
+// @Param        jkh_unit_id query int false "Фильтр по ЖЭУ"
+// @Success      200 {file} file "CSV-файл реестра зданий"
+// @Failure      400 {object} map[string]string "Неверные параметры фильтра"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/buildings.csv [get]
+func (h *BuildingHandler) ExportBuildingsCSV(c *gin.Context) {
+	filter, err := parseBuildingFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filter parameters"})
+		return
+	}
+
+	data, err := h.Service.ExportCSV(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export buildings"})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="buildings.csv"`)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", data)
+}
+
 // GetBuilding godoc
 // @Summary      Получить здание по ID
 // @Description  Возвращает информацию о конкретном здании
@@ -120,7 +190,7 @@ func (h *BuildingHandler) GetBuilding(c *gin.Context) {
 // @Param        id path int true "ID здания"
 // @Param        request body models.CreateBuildingRequest true "Данные для обновления"
 // @Success      200 {object} models.BuildingResponse "Обновленные данные здания"
-// @Failure      400 {object} map[string]string "Неверный запрос или FK не найден"
+// @Failure      400 {object} map[string]string "Неверный запрос, FK не найден, ЖЭУ не относится к району, неверный телефон контакта или отрицательный размер здания"
 // @Failure      401 {object} map[string]string "Не авторизован"
 // @Failure      404 {object} map[string]string "Здание не найдено"
 // @Failure      409 {object} map[string]string "Адрес здания уже занят"
@@ -153,6 +223,18 @@ func (h *BuildingHandler) UpdateBuilding(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid District, JKH Unit, or Inspector ID"})
 			return
 		}
+		if errors.Is(err, service.ErrDistrictUnitMismatch) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "JKH unit does not belong to the specified district"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidContactPhone) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid contact phone"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidBuildingSize) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Floors, entrances and apartments must be non-negative"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update building"})
 		return
 	}
@@ -160,6 +242,55 @@ func (h *BuildingHandler) UpdateBuilding(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// TransferBuilding godoc
+// @Summary      Перевести здание в другой район/ЖЭУ
+// @Description  Перевод здания в другой район и ЖЭУ (например, при изменении административных границ). ЖЭУ должно относиться к указанному району
+// @Tags         Здания
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID здания"
+// @Param        request body models.TransferBuildingRequest true "Новые район и ЖЭУ"
+// @Success      200 {object} models.BuildingResponse "Обновленные данные здания"
+// @Failure      400 {object} map[string]string "Неверный запрос, FK не найден или ЖЭУ не относится к району"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Здание не найдено"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/buildings/{id}/transfer [post]
+func (h *BuildingHandler) TransferBuilding(c *gin.Context) {
+	id, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid building ID"})
+		return
+	}
+
+	var req models.TransferBuildingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request or validation failed"})
+		return
+	}
+
+	resp, err := h.Service.Transfer(c.Request.Context(), id, req.DistrictID, req.JkhUnitID)
+	if err != nil {
+		if errors.Is(err, service.ErrBuildingNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Building not found"})
+			return
+		}
+		if errors.Is(err, service.ErrFKNotFound) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid District or JKH Unit ID"})
+			return
+		}
+		if errors.Is(err, service.ErrDistrictUnitMismatch) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "JKH unit does not belong to the specified district"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to transfer building"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // DeleteBuilding godoc
 // @Summary      Удалить здание
 // @Description  Удаление здания из системы
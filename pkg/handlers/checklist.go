@@ -115,6 +115,40 @@ func (h *ChecklistHandler) GetChecklist(c *gin.Context) {
     c.JSON(http.StatusOK, resp)
 }
 
+// DownloadBlankForm godoc
+// @Summary      Скачать бланк осмотра
+// @Description  PDF-бланк осмотра по чек-листу для заполнения на бумаге: элементы в порядке проверки с пустыми колонками статуса и примечания
+// @Tags         Чек-листы
+// @Produce      application/pdf
+// @Security     BearerAuth
+// @Param        id path int true "ID чек-листа"
+// @Success      200 {file} file "PDF файл бланка"
+// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Чек-лист не найден"
+// @Failure      500 {object} map[string]string "Ошибка генерации бланка"
+// @Router       /admin/checklists/{id}/blank-form [get]
+func (h *ChecklistHandler) DownloadBlankForm(c *gin.Context) {
+    id, err := parseID(c)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid checklist ID"})
+        return
+    }
+
+    pdfData, filename, err := h.Service.BlankFormPDF(c.Request.Context(), id)
+    if err != nil {
+        if errors.Is(err, service.ErrChecklistNotFound) {
+            c.JSON(http.StatusNotFound, gin.H{"error": "Checklist not found"})
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate blank form"})
+        return
+    }
+
+    c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+    c.Data(http.StatusOK, "application/pdf", pdfData)
+}
+
 // UpdateChecklist godoc
 // @Summary      Обновить чек-лист
 // @Description  Обновление данных чек-листа (название, тип осмотра)
@@ -255,7 +289,7 @@ func (h *ChecklistHandler) AddElementToChecklist(c *gin.Context) {
 
 // RemoveElementFromChecklist godoc
 // @Summary      Удалить элемент из чек-листа
-// @Description  Удаление элемента из конкретного чек-листа
+// @Description  Удаление элемента из конкретного чек-листа. Элемент, по которому уже внесены результаты осмотра, удалить нельзя
 // @Tags         Чек-листы
 // @Produce      json
 // @Security     BearerAuth
@@ -265,6 +299,7 @@ func (h *ChecklistHandler) AddElementToChecklist(c *gin.Context) {
 // @Failure      400 {object} map[string]string "Неверный ID"
 // @Failure      401 {object} map[string]string "Не авторизован"
 // @Failure      404 {object} map[string]string "Элемент не найден в чек-листе"
+// @Failure      409 {object} map[string]string "По элементу есть результаты осмотра"
 // @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
 // @Router       /admin/checklists/{id}/elements/{element_id} [delete]
 func (h *ChecklistHandler) RemoveElementFromChecklist(c *gin.Context) {
@@ -286,6 +321,10 @@ func (h *ChecklistHandler) RemoveElementFromChecklist(c *gin.Context) {
             c.JSON(http.StatusNotFound, gin.H{"error": "Element not found in this checklist"})
             return
         }
+        if errors.Is(err, service.ErrElementHasResults) {
+            c.JSON(http.StatusConflict, gin.H{"error": "Element has inspection results and cannot be removed"})
+            return
+        }
         c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove element from checklist"})
         return
     }
@@ -295,14 +334,14 @@ func (h *ChecklistHandler) RemoveElementFromChecklist(c *gin.Context) {
 
 // UpdateElementOrder godoc
 // @Summary      Изменить порядок элемента
-// @Description  Изменение позиции элемента в чек-листе
+// @Description  Изменение позиции элемента в чек-листе и (опционально) обязательности фото при проблемном состоянии
 // @Tags         Чек-листы
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int true "ID чек-листа"
 // @Param        element_id path int true "ID элемента"
-// @Param        request body models.UpdateElementOrderRequest true "Новый порядковый номер"
+// @Param        request body models.UpdateElementOrderRequest true "Новый порядковый номер и флаг photo_required"
 // @Success      200 {object} map[string]string "Порядок успешно изменен"
 // @Failure      400 {object} map[string]string "Неверный запрос"
 // @Failure      401 {object} map[string]string "Не авторизован"
@@ -328,7 +367,7 @@ func (h *ChecklistHandler) UpdateElementOrder(c *gin.Context) {
         return
     }
 
-    err = h.Service.UpdateElementOrder(c.Request.Context(), checklistID, elementID, req.OrderIndex)
+    err = h.Service.UpdateElementOrder(c.Request.Context(), checklistID, elementID, req)
     if err != nil {
         if errors.Is(err, service.ErrChecklistElementNotFound) {
             c.JSON(http.StatusNotFound, gin.H{"error": "Element not found in this checklist"})
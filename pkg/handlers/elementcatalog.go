@@ -3,6 +3,7 @@ package handlers
 import (
     "errors"
     "net/http"
+    "strconv"
     "strings"
 
     "jkh/pkg/models"
@@ -70,6 +71,53 @@ func (h *ElementCatalogHandler) CreateElement(c *gin.Context) {
     c.JSON(http.StatusCreated, resp)
 }
 
+// BulkCreateElements godoc
+// @Summary      Пакетное создание элементов справочника
+// @Description  Создание списка элементов за один запрос (например, при наполнении справочника). Дубликаты названий не прерывают пакет, а отмечаются в построчном отчёте. При atomic=true любой дубликат отменяет создание всего пакета (409)
+// @Tags         Справочник элементов
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        atomic query bool false "Не создавать ничего при любом конфликте"
+// @Param        request body []models.CreateElementCatalogRequest true "Список элементов"
+// @Success      200 {object} models.BulkCreateElementsResponse "Построчный отчёт"
+// @Failure      400 {object} map[string]string "Неверный запрос"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      409 {object} models.BulkCreateElementsResponse "Конфликт названий (atomic=true)"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/elements/bulk [post]
+func (h *ElementCatalogHandler) BulkCreateElements(c *gin.Context) {
+    atomic, err := strconv.ParseBool(c.DefaultQuery("atomic", "false"))
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid atomic flag"})
+        return
+    }
+
+    var reqs []models.CreateElementCatalogRequest
+    if err := c.ShouldBindJSON(&reqs); err != nil || len(reqs) == 0 {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request or validation failed"})
+        return
+    }
+
+    resp, err := h.Service.BulkCreate(c.Request.Context(), reqs, atomic)
+    if err != nil {
+        if errors.Is(err, service.ErrElementConflict) {
+            c.JSON(http.StatusConflict, gin.H{"error": "Element name already exists"})
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create elements"})
+        return
+    }
+
+    // В атомарном режиме конфликт означает, что ни один элемент не создан
+    if atomic && resp.Failed > 0 {
+        c.JSON(http.StatusConflict, resp)
+        return
+    }
+
+    c.JSON(http.StatusOK, resp)
+}
+
 // ListElements godoc
 // @Summary      Получить список элементов справочника
 // @Description  Возвращает список всех элементов для чек-листов
@@ -0,0 +1,94 @@
+// pkg/handlers/errors.go
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"jkh/pkg/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================================
+// КОДЫ ОШИБОК API
+// ============================================================================
+
+// Машиночитаемые коды ошибок. Возвращаются в поле "code" рядом с текстом "error";
+// клиенты должны ориентироваться на код, а не на текст сообщения.
+const (
+	CodeInvalidRequest       = "INVALID_REQUEST"
+	CodeInvalidID            = "INVALID_ID"
+	CodeUnauthenticated      = "UNAUTHENTICATED"
+	CodeInternal             = "INTERNAL_ERROR"
+	CodeTaskNotFound         = "TASK_NOT_FOUND"
+	CodeInvalidTransition    = "INVALID_TRANSITION"
+	CodeInspectorNotAssigned = "INSPECTOR_NOT_ASSIGNED"
+	CodeInvalidForeignKey    = "INVALID_FOREIGN_KEY"
+	CodeForbiddenAction      = "FORBIDDEN_ACTION"
+	CodeJkhUnitNotFound      = "JKH_UNIT_NOT_FOUND"
+	CodeChecklistIncomplete  = "CHECKLIST_INCOMPLETE"
+	CodeDuplicateChecklist   = "DUPLICATE_CHECKLIST"
+	CodeBuildingNotFound     = "BUILDING_NOT_FOUND"
+	CodeInvalidPriority      = "INVALID_PRIORITY"
+	CodeInvalidStatusFilter  = "INVALID_STATUS_FILTER"
+	CodePhotoRequired        = "PHOTO_REQUIRED"
+)
+
+// apiError — HTTP-представление доменной ошибки.
+type apiError struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+// serviceErrors — соответствие sentinel-ошибок сервисного слоя ответам API.
+var serviceErrors = []struct {
+	Err error
+	apiError
+}{
+	{service.ErrTaskNotFound, apiError{http.StatusNotFound, CodeTaskNotFound, "Task not found"}},
+	{service.ErrInvalidStatusTransition, apiError{http.StatusBadRequest, CodeInvalidTransition, "Invalid status transition"}},
+	{service.ErrInspectorNotAssigned, apiError{http.StatusBadRequest, CodeInspectorNotAssigned, "Inspector is not assigned to this JKH unit"}},
+	{service.ErrInvalidForeignKey, apiError{http.StatusBadRequest, CodeInvalidForeignKey, "Invalid building, checklist, or inspector ID"}},
+	{service.ErrUnauthorizedAction, apiError{http.StatusForbidden, CodeForbiddenAction, "Action is not allowed"}},
+	{service.ErrJkhUnitNotFound, apiError{http.StatusNotFound, CodeJkhUnitNotFound, "JKH unit not found"}},
+	{service.ErrChecklistIncomplete, apiError{http.StatusBadRequest, CodeChecklistIncomplete, "Not all checklist elements have inspection results"}},
+	{service.ErrPhotoRequired, apiError{http.StatusBadRequest, CodePhotoRequired, "Photo is required for problem results"}},
+	{service.ErrBuildingNotFound, apiError{http.StatusNotFound, CodeBuildingNotFound, "Building not found"}},
+	{service.ErrInvalidPriority, apiError{http.StatusBadRequest, CodeInvalidPriority, "Invalid task priority"}},
+	{service.ErrInvalidStatusFilter, apiError{http.StatusBadRequest, CodeInvalidStatusFilter, "Invalid status filter"}},
+	{service.ErrDuplicateChecklist, apiError{http.StatusBadRequest, CodeDuplicateChecklist, "Checklist is attached to the task more than once"}},
+}
+
+// mapServiceError — поиск ответа для ошибки сервиса (через errors.Is).
+// Неизвестные ошибки считаются внутренними (500) с сообщением fallbackMessage.
+func mapServiceError(err error, fallbackMessage string) apiError {
+	for _, m := range serviceErrors {
+		if errors.Is(err, m.Err) {
+			return m.apiError
+		}
+	}
+	return apiError{http.StatusInternalServerError, CodeInternal, fallbackMessage}
+}
+
+// respondError — ответ с ошибкой в формате {"error": "...", "code": "..."}.
+func respondError(c *gin.Context, status int, code, message string) {
+	c.JSON(status, gin.H{"error": message, "code": code})
+}
+
+// respondServiceError — ответ для ошибки сервисного слоя.
+// Если ошибка содержит перечень элементов (например, без обязательного фото), он
+// возвращается в поле "elements".
+func respondServiceError(c *gin.Context, err error, fallbackMessage string) {
+	e := mapServiceError(err, fallbackMessage)
+
+	var photoErr *service.PhotoRequiredError
+	if errors.As(err, &photoErr) {
+		c.JSON(e.Status, gin.H{"error": e.Message, "code": e.Code, "elements": photoErr.Elements})
+		return
+	}
+
+	respondError(c, e.Status, e.Code, e.Message)
+}
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
+	"jkh/pkg/models"
 	"jkh/pkg/service"
 
 	"github.com/gin-gonic/gin"
@@ -27,8 +29,9 @@ func NewInspectionActHandler(s *service.InspectionActService) *InspectionActHand
 // @Produce      application/pdf
 // @Security     BearerAuth
 // @Param        id path int true "ID задания"
+// @Param        variant query string false "Вариант акта: official (по умолчанию, без внутренних заметок) или internal (с заметками инспектора)" Enums(official, internal)
 // @Success      200 {file} file "PDF файл акта осмотра"
-// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      400 {object} map[string]string "Неверный ID или вариант акта"
 // @Failure      401 {object} map[string]string "Не авторизован"
 // @Failure      404 {object} map[string]string "Акт осмотра не найден"
 // @Failure      500 {object} map[string]string "Ошибка генерации акта"
@@ -40,8 +43,103 @@ func (h *InspectionActHandler) DownloadAct(c *gin.Context) {
 		return
 	}
 
-	pdfData, filename, err := h.Service.GeneratePDFForAct(c.Request.Context(), taskID)
+	variant := c.DefaultQuery("variant", service.ActVariantOfficial)
+	pdfData, filename, err := h.Service.GenerateActVariantPDF(c.Request.Context(), taskID, variant)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidActVariant) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "variant must be official or internal"})
+			return
+		}
+		if errors.Is(err, service.ErrActNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Inspection act not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate inspection act"})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Data(http.StatusOK, "application/pdf", pdfData)
+}
+
+// CreateActLink godoc
+// @Summary      Подписанная ссылка на акт
+// @Description  Выдаёт короткоживущую подписанную ссылку на скачивание PDF-акта без JWT (например, для просмотрщика PDF). Ссылка действует только для акта этого задания
+// @Tags         Инспектор
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Param        ttl_minutes query int false "Срок действия ссылки в минутах (по умолчанию 15, не более 1440)"
+// @Success      200 {object} models.ActSignedLinkResponse "Подписанная ссылка"
+// @Failure      400 {object} map[string]string "Неверный ID или срок действия"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Акт осмотра не найден"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /inspector/tasks/{id}/act/link [post]
+func (h *InspectionActHandler) CreateActLink(c *gin.Context) {
+	taskID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || taskID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	ttl := service.DefaultActLinkTTL
+	if raw := c.Query("ttl_minutes"); raw != "" {
+		minutes, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ttl_minutes must be an integer"})
+			return
+		}
+		ttl = time.Duration(minutes) * time.Minute
+	}
+
+	resp, err := h.Service.CreateSignedLink(c.Request.Context(), taskID, ttl)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidLinkTTL) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ttl_minutes must be between 1 and 1440"})
+			return
+		}
+		if errors.Is(err, service.ErrActNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Inspection act not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create act link"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DownloadSignedAct godoc
+// @Summary      Скачать акт по подписанной ссылке
+// @Description  Скачивание PDF-акта по токену из подписанной ссылки. Не требует JWT
+// @Tags         Акты
+// @Produce      application/pdf
+// @Param        token query string true "Токен подписанной ссылки"
+// @Success      200 {file} file "PDF файл акта осмотра"
+// @Failure      400 {object} map[string]string "Токен не указан"
+// @Failure      403 {object} map[string]string "Неверная подпись ссылки"
+// @Failure      404 {object} map[string]string "Акт осмотра не найден"
+// @Failure      410 {object} map[string]string "Срок действия ссылки истёк"
+// @Failure      500 {object} map[string]string "Ошибка генерации акта"
+// @Router       /acts/download [get]
+func (h *InspectionActHandler) DownloadSignedAct(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	pdfData, filename, err := h.Service.DownloadBySignedToken(c.Request.Context(), token)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidActLink) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid act link"})
+			return
+		}
+		if errors.Is(err, service.ErrActLinkExpired) {
+			c.JSON(http.StatusGone, gin.H{"error": "Act link expired"})
+			return
+		}
 		if errors.Is(err, service.ErrActNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Inspection act not found"})
 			return
@@ -53,3 +151,156 @@ func (h *InspectionActHandler) DownloadAct(c *gin.Context) {
 	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
 	c.Data(http.StatusOK, "application/pdf", pdfData)
 }
+
+// DownloadBuildingActsArchive godoc
+// @Summary      Архив актов здания
+// @Description  ZIP со всеми утверждёнными актами по заданиям здания и описью manifest.csv. Задания без утверждённого акта пропускаются
+// @Tags         Здания
+// @Produce      application/zip
+// @Security     BearerAuth
+// @Param        id path int true "ID здания"
+// @Success      200 {file} file "ZIP-архив актов"
+// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Здание не найдено"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/buildings/{id}/acts/archive [get]
+func (h *InspectionActHandler) DownloadBuildingActsArchive(c *gin.Context) {
+	buildingID, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid building ID"})
+		return
+	}
+
+	data, filename, err := h.Service.BuildingActsArchive(c.Request.Context(), buildingID)
+	if err != nil {
+		if errors.Is(err, service.ErrBuildingNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Building not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build acts archive"})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Data(http.StatusOK, "application/zip", data)
+}
+
+// GetActAudit godoc
+// @Summary      Цепочка согласования акта
+// @Description  Машиночитаемая запись об акте: кто отправил, кто утвердил, отметки времени, номер акта, заключение и история статусов задания
+// @Tags         Задания
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Success      200 {object} models.ActAuditResponse "Цепочка согласования акта"
+// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Акт осмотра не найден"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /tasks/{id}/act/audit [get]
+func (h *InspectionActHandler) GetActAudit(c *gin.Context) {
+	taskID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || taskID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	resp, err := h.Service.GetActAudit(c.Request.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, service.ErrActNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Inspection act not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve act audit"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateActLanguage godoc
+// @Summary      Изменить язык акта
+// @Description  Выбор языка акта (ru/en). Названия элементов в PDF печатаются на выбранном языке; при отсутствии перевода используется основное название
+// @Tags         Задания
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Param        request body models.UpdateActLanguageRequest true "Язык акта"
+// @Success      200 {object} map[string]string "Язык акта изменён"
+// @Failure      400 {object} map[string]string "Неверный ID или язык"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Акт осмотра не найден"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /tasks/{id}/act/language [put]
+func (h *InspectionActHandler) UpdateActLanguage(c *gin.Context) {
+	taskID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || taskID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req models.UpdateActLanguageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	err = h.Service.SetActLanguage(c.Request.Context(), taskID, req.Language)
+	if err != nil {
+		if errors.Is(err, service.ErrActNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Inspection act not found"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidActLanguage) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid act language"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update act language"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Act language updated"})
+}
+
+// UpdateActIncludeUnfilled godoc
+// @Summary      Неосмотренные элементы в акте
+// @Description  При include_unfilled=true в таблицу акта попадают все элементы чек-листов задания в порядке проверки; элементы без результата печатаются со статусом "Не осмотрено". По умолчанию выключено
+// @Tags         Задания
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Param        request body models.UpdateActIncludeUnfilledRequest true "Флаг include_unfilled"
+// @Success      200 {object} map[string]string "Настройка акта изменена"
+// @Failure      400 {object} map[string]string "Неверный ID или тело запроса"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Акт осмотра не найден"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /tasks/{id}/act/include-unfilled [put]
+func (h *InspectionActHandler) UpdateActIncludeUnfilled(c *gin.Context) {
+	taskID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || taskID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req models.UpdateActIncludeUnfilledRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	err = h.Service.SetActIncludeUnfilled(c.Request.Context(), taskID, *req.IncludeUnfilled)
+	if err != nil {
+		if errors.Is(err, service.ErrActNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Inspection act not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update act settings"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Act settings updated"})
+}
@@ -69,6 +69,10 @@ func (h *InspectionResultHandler) CreateOrUpdateResult(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Checklist element does not belong to task's checklist"})
 			return
 		}
+		if errors.Is(err, service.ErrInvalidConditionStatus) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid condition status"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save result"})
 		return
 	}
@@ -76,6 +80,70 @@ func (h *InspectionResultHandler) CreateOrUpdateResult(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
+// PatchResult godoc
+// @Summary      Частично обновить результат осмотра
+// @Description  Автосохранение: обновляет только переданные поля (статус и/или комментарий) результата элемента. Если результата ещё нет, для создания обязателен статус
+// @Tags         Инспектор
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Param        element_id path int true "ID элемента чек-листа"
+// @Param        request body models.PatchInspectionResultRequest true "Изменённые поля результата"
+// @Success      200 {object} models.InspectionResultResponse "Результат сохранен"
+// @Failure      400 {object} map[string]string "Неверный запрос, задание не в работе или не указан статус нового результата"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Задание не найдено"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /inspector/tasks/{id}/results/{element_id} [patch]
+func (h *InspectionResultHandler) PatchResult(c *gin.Context) {
+	taskID, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	elementID, err := strconv.Atoi(c.Param("element_id"))
+	if err != nil || elementID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid element ID"})
+		return
+	}
+
+	var req models.PatchInspectionResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request or validation failed"})
+		return
+	}
+
+	resp, err := h.Service.PatchResult(c.Request.Context(), taskID, elementID, req)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+			return
+		}
+		if errors.Is(err, service.ErrTaskNotInProgress) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Task is not in progress (cannot add results)"})
+			return
+		}
+		if errors.Is(err, service.ErrChecklistElementInvalid) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Checklist element does not belong to task's checklist"})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidConditionStatus) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid condition status"})
+			return
+		}
+		if errors.Is(err, service.ErrConditionStatusRequired) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Condition status is required to create a result"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save result"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // GetTaskResults godoc
 // @Summary      Получить результаты осмотра
 // @Description  Возвращает все результаты осмотра для конкретного задания
@@ -109,6 +177,46 @@ func (h *InspectionResultHandler) GetTaskResults(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// GetResult godoc
+// @Summary      Получить результат осмотра элемента
+// @Description  Возвращает результат осмотра одного элемента чек-листа (например, для заполнения формы редактирования)
+// @Tags         Инспектор
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Param        element_id path int true "ID элемента чек-листа"
+// @Success      200 {object} models.InspectionResultResponse "Результат осмотра элемента"
+// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Результат не найден"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /inspector/tasks/{id}/results/{element_id} [get]
+func (h *InspectionResultHandler) GetResult(c *gin.Context) {
+	taskID, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	elementID, err := strconv.Atoi(c.Param("element_id"))
+	if err != nil || elementID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid element ID"})
+		return
+	}
+
+	resp, err := h.Service.GetResult(c.Request.Context(), taskID, elementID)
+	if err != nil {
+		if errors.Is(err, service.ErrResultNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Result not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve result"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // DeleteResult godoc
 // @Summary      Удалить результат осмотра
 // @Description  Удаление результата осмотра конкретного элемента
@@ -148,3 +256,87 @@ func (h *InspectionResultHandler) DeleteResult(c *gin.Context) {
 
 	c.JSON(http.StatusNoContent, nil)
 }
+
+// GetMissingElements godoc
+// @Summary      Незаполненные элементы чек-листа
+// @Description  Возвращает элементы чек-листа задания (в порядке проверки), для которых ещё нет результата осмотра
+// @Tags         Инспектор
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Success      200 {array} models.ChecklistElementDetail "Незаполненные элементы"
+// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Задание не найдено"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /inspector/tasks/{id}/missing [get]
+func (h *InspectionResultHandler) GetMissingElements(c *gin.Context) {
+	taskID, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	resp, err := h.Service.MissingElements(c.Request.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve missing elements"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CompareTasks godoc
+// @Summary      Сравнение двух осмотров здания
+// @Description  Поэлементное сравнение результатов двух заданий одного здания: состояние в каждом осмотре и изменение от A к B (improved, worsened, unchanged, not_comparable)
+// @Tags         Здания
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID здания"
+// @Param        task_a query int true "ID первого (более раннего) задания"
+// @Param        task_b query int true "ID второго задания"
+// @Success      200 {object} models.TaskComparisonResponse "Сравнение осмотров"
+// @Failure      400 {object} map[string]string "Неверные параметры или задание не относится к зданию"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      403 {object} map[string]string "Доступ запрещён"
+// @Failure      404 {object} map[string]string "Здание или задание не найдено"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/buildings/{id}/compare [get]
+func (h *InspectionResultHandler) CompareTasks(c *gin.Context) {
+	buildingID, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid building ID"})
+		return
+	}
+
+	taskA, errA := strconv.Atoi(c.Query("task_a"))
+	taskB, errB := strconv.Atoi(c.Query("task_b"))
+	if errA != nil || errB != nil || taskA <= 0 || taskB <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "task_a and task_b must be positive integers"})
+		return
+	}
+
+	resp, err := h.Service.CompareTasks(c.Request.Context(), buildingID, taskA, taskB)
+	if err != nil {
+		if errors.Is(err, service.ErrBuildingNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Building not found"})
+			return
+		}
+		if errors.Is(err, service.ErrTaskNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+			return
+		}
+		if errors.Is(err, service.ErrTaskNotInBuilding) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Task does not belong to this building"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to compare tasks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
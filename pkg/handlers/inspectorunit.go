@@ -75,6 +75,47 @@ func (h *InspectorUnitHandler) AssignInspector(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
+// AssignInspectorsBulk godoc
+// @Summary      Массово назначить инспекторов на ЖЭУ
+// @Description  Назначение нескольких инспекторов одной транзакцией. Уже назначенные пропускаются, пользователи без роли Inspector не назначаются; по каждому ID возвращается статус (assigned, already_assigned, not_found, not_inspector)
+// @Tags         Назначения инспекторов
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID ЖЭУ"
+// @Param        request body models.BulkAssignInspectorsRequest true "ID инспекторов"
+// @Success      200 {object} models.BulkAssignInspectorsResponse "Итог назначения по каждому инспектору"
+// @Failure      400 {object} map[string]string "Неверный запрос"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "ЖЭУ не найдено"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/jkhunits/{id}/inspectors/bulk [post]
+func (h *InspectorUnitHandler) AssignInspectorsBulk(c *gin.Context) {
+	id, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JKH unit ID"})
+		return
+	}
+
+	var req models.BulkAssignInspectorsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	resp, err := h.Service.AssignMany(c.Request.Context(), id, req.InspectorIDs)
+	if err != nil {
+		if errors.Is(err, service.ErrJkhUnitNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "JKH unit not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to assign inspectors"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // UnassignInspector godoc
 // @Summary      Открепить инспектора от ЖЭУ
 // @Description  Удаление привязки инспектора к жилищно-эксплуатационной единице
@@ -172,3 +213,41 @@ func (h *InspectorUnitHandler) ListUnitsForInspector(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, list)
 }
+
+// SuggestInspectorForUnit godoc
+// @Summary      Предложить инспектора для назначения
+// @Description  Возвращает инспектора ЖЭУ с наименьшим количеством активных заданий (для сбалансированного назначения)
+// @Tags         Назначения инспекторов
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID ЖЭУ"
+// @Success      200 {object} models.SuggestedInspectorResponse "Предложенный инспектор"
+// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "ЖЭУ не найдено или за ним не закреплены инспекторы"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/jkhunits/{id}/inspectors/suggested [get]
+func (h *InspectorUnitHandler) SuggestInspectorForUnit(c *gin.Context) {
+	id, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JKH unit ID"})
+		return
+	}
+
+	resp, err := h.Service.SuggestInspectorForUnit(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrJkhUnitNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "JKH unit not found"})
+			return
+		case errors.Is(err, service.ErrNoInspectorsForUnit):
+			c.JSON(http.StatusNotFound, gin.H{"error": "No inspectors assigned to this JKH unit"})
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to suggest inspector"})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,76 @@
+// pkg/handlers/maintenance.go
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"jkh/pkg/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MaintenanceHandler struct {
+	Service *service.MaintenanceService
+}
+
+func NewMaintenanceHandler(s *service.MaintenanceService) *MaintenanceHandler {
+	return &MaintenanceHandler{Service: s}
+}
+
+// PurgeOldData godoc
+// @Summary      Очистка устаревших данных
+// @Description  Безвозвратно удаляет отменённые задания, не изменявшиеся дольше указанного срока, вместе с черновыми актами и результатами осмотра. Утверждённые акты не затрагиваются
+// @Tags         Администрирование
+// @Produce      json
+// @Security     BearerAuth
+// @Param        older_than_days query int true "Срок хранения в днях"
+// @Success      200 {object} models.PurgeResult "Итог очистки"
+// @Failure      400 {object} map[string]string "Неверный срок хранения"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      403 {object} map[string]string "Доступ запрещён"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/maintenance/purge [post]
+func (h *MaintenanceHandler) PurgeOldData(c *gin.Context) {
+	days, err := strconv.Atoi(c.Query("older_than_days"))
+	if err != nil || days < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "older_than_days must be a positive integer"})
+		return
+	}
+
+	resp, err := h.Service.PurgeOldData(c.Request.Context(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRetention) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "older_than_days must be a positive integer"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to purge old data"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// BackfillActNumbers godoc
+// @Summary      Номера для старых актов
+// @Description  Присваивает номера утверждённым актам без номера (утверждённым до введения нумерации) по годам в порядке даты утверждения. Повторный запуск ничего не меняет
+// @Tags         Администрирование
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} models.BackfillActNumbersResult "Итог нумерации"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      403 {object} map[string]string "Доступ запрещён"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/maintenance/backfill-act-numbers [post]
+func (h *MaintenanceHandler) BackfillActNumbers(c *gin.Context) {
+	resp, err := h.Service.BackfillActNumbers(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to backfill act numbers"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
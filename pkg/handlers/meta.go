@@ -0,0 +1,32 @@
+// pkg/handlers/meta.go
+
+package handlers
+
+import (
+	"net/http"
+
+	"jkh/pkg/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MetaHandler struct {
+	Service *service.MetaService
+}
+
+func NewMetaHandler(s *service.MetaService) *MetaHandler {
+	return &MetaHandler{Service: s}
+}
+
+// GetMeta godoc
+// @Summary      Справочник перечислений
+// @Description  Все перечисления приложения (статусы и приоритеты заданий, статусы состояния элементов, типы осмотров, языки актов) с подписями для выпадающих списков
+// @Tags         Справочники
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} models.MetaResponse "Перечисления и подписи"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Router       /meta [get]
+func (h *MetaHandler) GetMeta(c *gin.Context) {
+	c.JSON(http.StatusOK, h.Service.GetMeta())
+}
@@ -0,0 +1,41 @@
+// pkg/handlers/role.go
+
+package handlers
+
+import (
+	"net/http"
+
+	"jkh/pkg/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RoleHandler связывает HTTP-запросы с RoleService
+type RoleHandler struct {
+	Service *service.RoleService
+}
+
+// Конструктор
+func NewRoleHandler(s *service.RoleService) *RoleHandler {
+	return &RoleHandler{Service: s}
+}
+
+// ListRoles godoc
+// @Summary      Получить список ролей
+// @Description  Возвращает все роли пользователей (для выбора роли при создании пользователя)
+// @Tags         Пользователи
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} models.RoleResponse "Список ролей"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      403 {object} map[string]string "Доступ запрещён"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/roles [get]
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+	resp, err := h.Service.ListRoles(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve role list"})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
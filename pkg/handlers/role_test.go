@@ -0,0 +1,56 @@
+// pkg/handlers/role_test.go
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"jkh/pkg/models"
+	"jkh/pkg/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRoleHandler_ListRoles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client := setupTestClient(t)
+
+	// Роли, которые создаёт seedDatabase
+	for _, roleName := range []string{"Specialist", "Coordinator", "Inspector"} {
+		client.Role.Create().SetName(roleName).SaveX(context.Background())
+	}
+
+	h := NewRoleHandler(service.NewRoleService(client))
+	r := gin.New()
+	r.GET("/api/v1/roles", h.ListRoles)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/roles", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+	}
+
+	var resp []models.RoleResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	expected := []string{"Specialist", "Coordinator", "Inspector"}
+	if len(resp) != len(expected) {
+		t.Fatalf("Expected %d roles, got %d", len(expected), len(resp))
+	}
+	for i, name := range expected {
+		if resp[i].Name != name {
+			t.Errorf("Expected role %d to be %s, got %s", i, name, resp[i].Name)
+		}
+		if resp[i].ID == 0 {
+			t.Errorf("Expected role %s to have an ID", name)
+		}
+	}
+}
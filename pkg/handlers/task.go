@@ -1,8 +1,9 @@
 package handlers
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"jkh/ent/task"
 	"jkh/pkg/models"
@@ -36,28 +37,20 @@ func NewTaskHandler(s *service.TaskService) *TaskHandler {
 // @Security     BearerAuth
 // @Param        request body models.CreateTaskRequest true "Данные задания"
 // @Success      201 {object} models.TaskDetailResponse "Задание успешно создано"
-// @Failure      400 {object} map[string]string "Неверный запрос или FK не найден"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неверный запрос или FK не найден"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /tasks/ [post]
 func (h *TaskHandler) CreateTask(c *gin.Context) {
 	var req models.CreateTaskRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request or validation failed"})
+		respondError(c, http.StatusBadRequest, CodeInvalidRequest, "Invalid request or validation failed")
 		return
 	}
 
 	resp, err := h.Service.CreateTask(c.Request.Context(), req)
 	if err != nil {
-		if errors.Is(err, service.ErrInvalidForeignKey) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid building, checklist, or inspector ID"})
-			return
-		}
-		if errors.Is(err, service.ErrInspectorNotAssigned) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Inspector is not assigned to this JKH unit"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create task"})
+		respondServiceError(c, err, "Failed to create task")
 		return
 	}
 
@@ -72,8 +65,9 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        status query string false "Фильтр по статусу (New, Pending, InProgress, OnReview, ForRevision, Approved, Canceled)"
 // @Success      200 {array} models.TaskResponse "Список заданий"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неизвестный статус в фильтре"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /tasks/ [get]
 func (h *TaskHandler) ListAllTasks(c *gin.Context) {
 	// Опциональный фильтр по статусу
@@ -84,7 +78,73 @@ func (h *TaskHandler) ListAllTasks(c *gin.Context) {
 
 	resp, err := h.Service.ListTasks(c.Request.Context(), nil, statusFilter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task list"})
+		respondServiceError(c, err, "Failed to retrieve task list")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListPendingOverdue godoc
+// @Summary      Задания, не принятые в срок
+// @Description  Возвращает задания в статусе Pending, которые инспектор не принял в течение установленного срока (JKH_ACCEPTANCE_SLA_HOURS, по умолчанию 48 ч). Самые давние — первыми
+// @Tags         Задания
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} models.OverdueTaskResponse "Просроченные задания"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /tasks/pending-overdue [get]
+func (h *TaskHandler) ListPendingOverdue(c *gin.Context) {
+	resp, err := h.Service.ListUnacceptedBeyondSLA(c.Request.Context())
+	if err != nil {
+		respondServiceError(c, err, "Failed to retrieve overdue tasks")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListReviewQueueByInspector godoc
+// @Summary      Очередь на утверждение по инспекторам
+// @Description  Задания в статусе OnReview, сгруппированные по инспекторам: число заданий и возраст самой давней отправки в группе. Первыми — группы с самой давней отправкой
+// @Tags         Задания
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} models.ReviewQueueGroup "Группы заданий на проверке"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /tasks/review-queue/by-inspector [get]
+func (h *TaskHandler) ListReviewQueueByInspector(c *gin.Context) {
+	resp, err := h.Service.ReviewQueueByInspector(c.Request.Context())
+	if err != nil {
+		respondServiceError(c, err, "Failed to retrieve review queue")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListAssignableInspectors godoc
+// @Summary      Инспекторы, доступные для здания
+// @Description  Возвращает инспекторов, закреплённых за ЖЭУ указанного здания (для выбора при создании задания). Если у здания нет ЖЭУ — пустой список
+// @Tags         Задания
+// @Produce      json
+// @Security     BearerAuth
+// @Param        building_id query int true "ID здания"
+// @Success      200 {array} models.UserResponse "Инспекторы ЖЭУ здания"
+// @Failure      400 {object} models.ErrorResponse "Неверный ID здания"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Здание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /tasks/assignable-inspectors [get]
+func (h *TaskHandler) ListAssignableInspectors(c *gin.Context) {
+	buildingID, err := parseOptionalInt(c, "building_id")
+	if err != nil || buildingID == 0 {
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid building_id")
+		return
+	}
+
+	resp, err := h.Service.AssignableInspectors(c.Request.Context(), buildingID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to retrieve inspectors")
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -98,34 +158,121 @@ func (h *TaskHandler) ListAllTasks(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        id path int true "ID задания"
 // @Success      200 {object} models.TaskDetailResponse "Данные задания"
-// @Failure      400 {object} map[string]string "Неверный ID"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      404 {object} map[string]string "Задание не найдено"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неверный ID"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Задание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /tasks/{id} [get]
 func (h *TaskHandler) GetTask(c *gin.Context) {
 	id, err := parseID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid task ID")
 		return
 	}
 
 	resp, err := h.Service.RetrieveTask(c.Request.Context(), id)
 	if err != nil {
-		if errors.Is(err, service.ErrTaskNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		respondServiceError(c, err, "Failed to retrieve task")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ExportInspection godoc
+// @Summary      Экспорт осмотра в JSON
+// @Description  Полный осмотр одним документом для архивации и передачи в другие системы: задание, здание, чек-листы с элементами в порядке проверки и результатами по ним, метаданные акта и история статусов
+// @Tags         Задания
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID задания"
+// @Success      200 {object} models.InspectionExport "Полный осмотр"
+// @Failure      400 {object} models.ErrorResponse "Неверный ID"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Задание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /tasks/{id}/export [get]
+func (h *TaskHandler) ExportInspection(c *gin.Context) {
+	id, err := parseID(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid task ID")
+		return
+	}
+
+	resp, err := h.Service.ExportInspection(c.Request.Context(), id)
+	if err != nil {
+		respondServiceError(c, err, "Failed to export inspection")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListTasksByUnit godoc
+// @Summary      Получить задания по ЖЭУ
+// @Description  Постраничный список заданий по зданиям указанного ЖЭУ с возможностью фильтрации по статусу
+// @Tags         ЖЭУ
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID ЖЭУ"
+// @Param        status query string false "Фильтр по статусу (New, Pending, InProgress, OnReview, ForRevision, Approved, Canceled)"
+// @Param        page query int false "Номер страницы (по умолчанию 1)"
+// @Param        page_size query int false "Размер страницы (по умолчанию 20, максимум 100)"
+// @Success      200 {object} models.TaskListResponse "Страница заданий"
+// @Failure      400 {object} models.ErrorResponse "Неверный ID, статус или параметры пагинации"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "ЖЭУ не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /admin/jkhunits/{id}/tasks [get]
+func (h *TaskHandler) ListTasksByUnit(c *gin.Context) {
+	id, err := parseID(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid JKH unit ID")
+		return
+	}
+
+	var filter models.TaskListFilter
+	if status := c.Query("status"); status != "" {
+		if task.StatusValidator(task.Status(status)) != nil {
+			respondError(c, http.StatusBadRequest, CodeInvalidRequest, "Invalid status filter")
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		filter.Status = &status
+	}
+	if filter.Page, err = parseOptionalInt(c, "page"); err != nil {
+		respondError(c, http.StatusBadRequest, CodeInvalidRequest, "Invalid page parameter")
+		return
+	}
+	if filter.PageSize, err = parseOptionalInt(c, "page_size"); err != nil {
+		respondError(c, http.StatusBadRequest, CodeInvalidRequest, "Invalid page_size parameter")
+		return
+	}
+
+	resp, err := h.Service.ListTasksByUnit(c.Request.Context(), id, filter)
+	if err != nil {
+		respondServiceError(c, err, "Failed to retrieve task list")
 		return
 	}
 
 	c.JSON(http.StatusOK, resp)
 }
 
+// parseOptionalInt — разбор необязательного положительного целого query-параметра (0, если не указан).
+func parseOptionalInt(c *gin.Context, name string) (int, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < 1 {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+	return v, nil
+}
+
 // UpdateTaskStatus godoc
 // @Summary      Изменить статус задания
-// @Description  Изменение статуса задания (согласно FSM: New→Pending→InProgress→OnReview→Approved/ForRevision)
+// @Description  Изменение статуса задания (согласно FSM: New→Pending→InProgress→OnReview→Approved/ForRevision). При утверждении можно передать approval_comment — он сохраняется на акте и печатается в заключении
 // @Tags         Задания
 // @Accept       json
 // @Produce      json
@@ -133,35 +280,31 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 // @Param        id path int true "ID задания"
 // @Param        request body models.UpdateTaskStatusRequest true "Новый статус"
 // @Success      200 {object} map[string]string "Статус успешно изменен"
-// @Failure      400 {object} map[string]string "Неверный запрос или недопустимый переход статуса"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      404 {object} map[string]string "Задание не найдено"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неверный запрос или недопустимый переход статуса"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Задание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /tasks/{id}/status [put]
 func (h *TaskHandler) UpdateTaskStatus(c *gin.Context) {
 	id, err := parseID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid task ID")
 		return
 	}
 
 	var req models.UpdateTaskStatusRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request or validation failed"})
+		respondError(c, http.StatusBadRequest, CodeInvalidRequest, "Invalid request or validation failed")
 		return
 	}
 
-	err = h.Service.UpdateTaskStatus(c.Request.Context(), id, task.Status(req.Status))
+	if task.Status(req.Status) == task.StatusApproved {
+		err = h.Service.ApproveTask(c.Request.Context(), id, c.GetInt("userID"), req.ApprovalComment)
+	} else {
+		err = h.Service.UpdateTaskStatus(c.Request.Context(), id, task.Status(req.Status), c.GetInt("userID"))
+	}
 	if err != nil {
-		if errors.Is(err, service.ErrTaskNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-			return
-		}
-		if errors.Is(err, service.ErrInvalidStatusTransition) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status transition"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task status"})
+		respondServiceError(c, err, "Failed to update task status")
 		return
 	}
 
@@ -178,35 +321,27 @@ func (h *TaskHandler) UpdateTaskStatus(c *gin.Context) {
 // @Param        id path int true "ID задания"
 // @Param        request body models.AssignInspectorRequest true "ID нового инспектора"
 // @Success      200 {object} map[string]string "Инспектор успешно назначен"
-// @Failure      400 {object} map[string]string "Неверный запрос или инспектор не найден"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      404 {object} map[string]string "Задание не найдено"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неверный запрос или инспектор не найден"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Задание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /tasks/{id}/assign [put]
 func (h *TaskHandler) AssignInspector(c *gin.Context) {
 	id, err := parseID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid task ID")
 		return
 	}
 
 	var req models.AssignInspectorRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request or validation failed"})
+		respondError(c, http.StatusBadRequest, CodeInvalidRequest, "Invalid request or validation failed")
 		return
 	}
 
 	err = h.Service.AssignInspector(c.Request.Context(), id, req.InspectorID)
 	if err != nil {
-		if errors.Is(err, service.ErrTaskNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-			return
-		}
-		if errors.Is(err, service.ErrInvalidForeignKey) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid inspector ID"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to assign inspector"})
+		respondServiceError(c, err, "Failed to assign inspector")
 		return
 	}
 
@@ -221,25 +356,21 @@ func (h *TaskHandler) AssignInspector(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        id path int true "ID задания"
 // @Success      204 "Задание успешно удалено"
-// @Failure      400 {object} map[string]string "Неверный ID"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      404 {object} map[string]string "Задание не найдено"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неверный ID"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Задание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /admin/tasks/{id} [delete]
 func (h *TaskHandler) DeleteTask(c *gin.Context) {
 	id, err := parseID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid task ID")
 		return
 	}
 
 	err = h.Service.DeleteTask(c.Request.Context(), id)
 	if err != nil {
-		if errors.Is(err, service.ErrTaskNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete task"})
+		respondServiceError(c, err, "Failed to delete task")
 		return
 	}
 
@@ -258,14 +389,15 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        status query string false "Фильтр по статусу"
 // @Success      200 {array} models.TaskResponse "Список заданий инспектора"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неизвестный статус в фильтре"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /inspector/tasks [get]
 func (h *TaskHandler) ListMyTasks(c *gin.Context) {
 	// Извлекаем userID из JWT-токена (установлен middleware AuthRequired)
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		respondError(c, http.StatusUnauthorized, CodeUnauthenticated, "User not authenticated")
 		return
 	}
 
@@ -279,7 +411,7 @@ func (h *TaskHandler) ListMyTasks(c *gin.Context) {
 
 	resp, err := h.Service.ListTasks(c.Request.Context(), &inspectorID, statusFilter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task list"})
+		respondServiceError(c, err, "Failed to retrieve task list")
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -293,30 +425,22 @@ func (h *TaskHandler) ListMyTasks(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        id path int true "ID задания"
 // @Success      200 {object} map[string]string "Задание успешно принято"
-// @Failure      400 {object} map[string]string "Неверный ID или недопустимый переход статуса"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      404 {object} map[string]string "Задание не найдено"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неверный ID или недопустимый переход статуса"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Задание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /inspector/tasks/{id}/accept [post]
 func (h *TaskHandler) AcceptTask(c *gin.Context) {
 	id, err := parseID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid task ID")
 		return
 	}
 
 	// Переход в статус InProgress
-	err = h.Service.UpdateTaskStatus(c.Request.Context(), id, task.StatusInProgress)
+	err = h.Service.UpdateTaskStatus(c.Request.Context(), id, task.StatusInProgress, c.GetInt("userID"))
 	if err != nil {
-		if errors.Is(err, service.ErrTaskNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-			return
-		}
-		if errors.Is(err, service.ErrInvalidStatusTransition) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Task cannot be accepted (invalid status)"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to accept task"})
+		respondServiceError(c, err, "Failed to accept task")
 		return
 	}
 
@@ -331,32 +455,22 @@ func (h *TaskHandler) AcceptTask(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        id path int true "ID задания"
 // @Success      200 {object} map[string]string "Задание отправлено на проверку"
-// @Failure      400 {object} map[string]string "Неверный ID или недопустимый переход статуса"
-// @Failure      401 {object} map[string]string "Не авторизован"
-// @Failure      404 {object} map[string]string "Задание не найдено"
-// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure      400 {object} models.ErrorResponse "Неверный ID, недопустимый переход статуса, заполнены не все элементы чек-листа или нет обязательного фото (PHOTO_REQUIRED, список в elements)"
+// @Failure      401 {object} models.ErrorResponse "Не авторизован"
+// @Failure      404 {object} models.ErrorResponse "Задание не найдено"
+// @Failure      500 {object} models.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /inspector/tasks/{id}/submit [post]
 func (h *TaskHandler) SubmitTask(c *gin.Context) {
 	id, err := parseID(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		respondError(c, http.StatusBadRequest, CodeInvalidID, "Invalid task ID")
 		return
 	}
 
-	// TODO: Проверить, что все элементы чек-листа заполнены (InspectionResult)
-
 	// Переход в статус OnReview
-	err = h.Service.UpdateTaskStatus(c.Request.Context(), id, task.StatusOnReview)
+	err = h.Service.UpdateTaskStatus(c.Request.Context(), id, task.StatusOnReview, c.GetInt("userID"))
 	if err != nil {
-		if errors.Is(err, service.ErrTaskNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-			return
-		}
-		if errors.Is(err, service.ErrInvalidStatusTransition) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Task cannot be submitted (invalid status)"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to submit task"})
+		respondServiceError(c, err, "Failed to submit task")
 		return
 	}
 
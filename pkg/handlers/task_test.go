@@ -0,0 +1,227 @@
+// pkg/handlers/task_test.go
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"jkh/ent"
+	"jkh/pkg/models"
+	"jkh/pkg/service"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	_ "modernc.org/sqlite"
+)
+
+func setupTaskTest(t *testing.T) (*gin.Engine, *ent.Client) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	db, err := sql.Open("sqlite", ":memory:?_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(drv))
+
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	t.Cleanup(func() {
+		client.Close()
+		db.Close()
+	})
+
+	taskService := service.NewTaskService(client)
+	taskHandler := NewTaskHandler(taskService)
+
+	r := gin.New()
+	r.POST("/api/v1/tasks", taskHandler.CreateTask)
+	r.GET("/api/v1/tasks/:id", taskHandler.GetTask)
+	r.PUT("/api/v1/tasks/:id/status", taskHandler.UpdateTaskStatus)
+	r.GET("/api/v1/jkhunits/:id/tasks", taskHandler.ListTasksByUnit)
+	r.GET("/api/v1/tasks", taskHandler.ListAllTasks)
+
+	return r, client
+}
+
+// taskTestData — здание, чек-лист и инспектор (не закреплённый за ЖЭУ здания).
+type taskTestData struct {
+	BuildingID  int
+	ChecklistID int
+	InspectorID int
+}
+
+func createTaskTestData(t *testing.T, client *ent.Client) taskTestData {
+	t.Helper()
+	ctx := context.Background()
+
+	role := client.Role.Create().SetName("Inspector").SaveX(ctx)
+	inspector := client.User.Create().
+		SetEmail("inspector@example.com").
+		SetLogin("inspector").
+		SetPasswordHash("hash").
+		SetFirstName("Иван").
+		SetLastName("Иванов").
+		SetRoleID(role.ID).
+		SaveX(ctx)
+	district := client.District.Create().SetName("Район").SaveX(ctx)
+	unit := client.JkhUnit.Create().SetName("ЖЭУ-1").SetDistrictID(district.ID).SaveX(ctx)
+	b := client.Building.Create().
+		SetAddress("ул. Тестовая, д. 1").
+		SetDistrictID(district.ID).
+		SetJkhUnitID(unit.ID).
+		SaveX(ctx)
+	cl := client.Checklist.Create().SetTitle("Чек-лист").SaveX(ctx)
+
+	return taskTestData{BuildingID: b.ID, ChecklistID: cl.ID, InspectorID: inspector.ID}
+}
+
+// assertErrorCode разбирает ответ с ошибкой и проверяет HTTP-статус и код.
+func assertErrorCode(t *testing.T, w *httptest.ResponseRecorder, status int, code string) {
+	t.Helper()
+
+	if w.Code != status {
+		t.Errorf("Expected status %d, got %d. Body: %s", status, w.Code, w.Body.String())
+	}
+
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Code != code {
+		t.Errorf("Expected code %s, got %s", code, resp.Code)
+	}
+	if resp.Error == "" {
+		t.Error("Expected human-readable error message")
+	}
+}
+
+func TestTaskHandler_GetTask_NotFoundCode(t *testing.T) {
+	r, _ := setupTaskTest(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/99999", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assertErrorCode(t, w, http.StatusNotFound, CodeTaskNotFound)
+}
+
+func TestTaskHandler_GetTask_InvalidIDCode(t *testing.T) {
+	r, _ := setupTaskTest(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/abc", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assertErrorCode(t, w, http.StatusBadRequest, CodeInvalidID)
+}
+
+func TestTaskHandler_CreateTask_InspectorNotAssignedCode(t *testing.T) {
+	r, client := setupTaskTest(t)
+	data := createTaskTestData(t, client)
+
+	reqBody := models.CreateTaskRequest{
+		BuildingID:    data.BuildingID,
+		ChecklistID:   data.ChecklistID,
+		InspectorID:   data.InspectorID,
+		Title:         "Осмотр",
+		ScheduledDate: time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assertErrorCode(t, w, http.StatusBadRequest, CodeInspectorNotAssigned)
+}
+
+func TestTaskHandler_CreateTask_InvalidRequestCode(t *testing.T) {
+	r, _ := setupTaskTest(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks", bytes.NewBufferString("{invalid"))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assertErrorCode(t, w, http.StatusBadRequest, CodeInvalidRequest)
+}
+
+func TestTaskHandler_UpdateTaskStatus_InvalidTransitionCode(t *testing.T) {
+	r, client := setupTaskTest(t)
+	data := createTaskTestData(t, client)
+
+	tk := client.Task.Create().
+		SetBuildingID(data.BuildingID).
+		SetChecklistID(data.ChecklistID).
+		SetInspectorID(data.InspectorID).
+		SetTitle("Осмотр").
+		SetScheduledDate(time.Now()).
+		SaveX(context.Background())
+
+	// New → Approved не допускается FSM
+	body, _ := json.Marshal(models.UpdateTaskStatusRequest{Status: "Approved"})
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/tasks/%d/status", tk.ID), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assertErrorCode(t, w, http.StatusBadRequest, CodeInvalidTransition)
+}
+
+func TestTaskHandler_ListTasksByUnit_NotFoundCode(t *testing.T) {
+	r, _ := setupTaskTest(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jkhunits/99999/tasks", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assertErrorCode(t, w, http.StatusNotFound, CodeJkhUnitNotFound)
+}
+
+func TestTaskHandler_ListAllTasks_InvalidStatusFilterCode(t *testing.T) {
+	r, _ := setupTaskTest(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks?status=done", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assertErrorCode(t, w, http.StatusBadRequest, CodeInvalidStatusFilter)
+
+	// Без фильтра и с допустимым статусом — 200
+	for _, url := range []string{"/api/v1/tasks", "/api/v1/tasks?status=InProgress"} {
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: expected status 200, got %d. Body: %s", url, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestMapServiceError(t *testing.T) {
+	wrapped := fmt.Errorf("context: %w", service.ErrInvalidStatusTransition)
+	if e := mapServiceError(wrapped, "fallback"); e.Code != CodeInvalidTransition || e.Status != http.StatusBadRequest {
+		t.Errorf("Expected INVALID_TRANSITION/400 for wrapped error, got %s/%d", e.Code, e.Status)
+	}
+
+	e := mapServiceError(errors.New("boom"), "Failed to do something")
+	if e.Code != CodeInternal || e.Status != http.StatusInternalServerError || e.Message != "Failed to do something" {
+		t.Errorf("Unexpected mapping for unknown error: %+v", e)
+	}
+}
@@ -132,6 +132,39 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// GetUserSummary godoc
+// @Summary      Сводка по пользователю
+// @Description  Незавершённые задания по статусам, закреплённые ЖЭУ и время последнего входа — перед переназначением заданий или отключением пользователя
+// @Tags         Пользователи
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "ID пользователя"
+// @Success      200 {object} models.UserSummaryResponse "Сводка по пользователю"
+// @Failure      400 {object} map[string]string "Неверный ID"
+// @Failure      401 {object} map[string]string "Не авторизован"
+// @Failure      404 {object} map[string]string "Пользователь не найден"
+// @Failure      500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Router       /admin/users/{id}/summary [get]
+func (h *UserHandler) GetUserSummary(c *gin.Context) {
+	id, err := parseID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	resp, err := h.Service.GetUserSummary(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build user summary"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // UpdateUser godoc
 // @Summary      Обновить пользователя
 // @Description  Обновление данных пользователя (email, имя, роль и т.д.)
@@ -11,8 +11,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"jkh/ent"
+	"jkh/ent/role"
+	"jkh/ent/task"
 	"jkh/pkg/models"
 	"jkh/pkg/service"
 
@@ -56,6 +59,7 @@ func setupUserTest(t *testing.T) (*gin.Engine, *ent.Client) {
 	r.POST("/api/v1/users", userHandler.CreateUser)
 	r.GET("/api/v1/users", userHandler.ListUsers)
 	r.GET("/api/v1/users/:id", userHandler.GetUser)
+	r.GET("/api/v1/users/:id/summary", userHandler.GetUserSummary)
 	r.PUT("/api/v1/users/:id", userHandler.UpdateUser)
 	r.DELETE("/api/v1/users/:id", userHandler.DeleteUser)
 
@@ -188,3 +192,72 @@ func TestUserHandler_GetUser_InvalidID(t *testing.T) {
 }
 
 
+
+func TestUserHandler_GetUserSummary_Shape(t *testing.T) {
+	r, client := setupUserTest(t)
+
+	ctx := context.Background()
+	inspectorRole := client.Role.Query().Where(role.NameEQ("Inspector")).OnlyX(ctx)
+	inspector := client.User.Create().
+		SetEmail("summary@test.com").SetLogin("summary").SetPasswordHash("hash").
+		SetFirstName("Пётр").SetLastName("Петров").SetRoleID(inspectorRole.ID).
+		SetLastLoginAt(time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)).
+		SaveX(ctx)
+
+	district := client.District.Create().SetName("Центральный").SaveX(ctx)
+	unit := client.JkhUnit.Create().SetName("ЖЭУ-1").SetDistrictID(district.ID).SaveX(ctx)
+	client.InspectorUnit.Create().SetUserID(inspector.ID).SetJkhUnitID(unit.ID).SaveX(ctx)
+	building := client.Building.Create().
+		SetAddress("ул. Тестовая, д. 1").SetDistrictID(district.ID).SetJkhUnitID(unit.ID).SaveX(ctx)
+	checklist := client.Checklist.Create().SetTitle("Весенний осмотр").SaveX(ctx)
+
+	// Два новых, одно в работе и одно утверждённое (не учитывается)
+	for _, st := range []task.Status{task.StatusNew, task.StatusNew, task.StatusInProgress, task.StatusApproved} {
+		client.Task.Create().
+			SetBuildingID(building.ID).SetChecklistID(checklist.ID).SetInspectorID(inspector.ID).
+			SetTitle("Осмотр").SetScheduledDate(time.Now()).SetStatus(st).
+			SaveX(ctx)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/users/%d/summary", inspector.ID), nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+	}
+
+	var resp models.UserSummaryResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.User.ID != inspector.ID || resp.User.RoleName != "Inspector" {
+		t.Errorf("Unexpected user in summary: %+v", resp.User)
+	}
+	if resp.ActiveTasksTotal != 3 {
+		t.Errorf("Expected 3 active tasks, got %d", resp.ActiveTasksTotal)
+	}
+	expected := []models.TaskStatusStat{{Status: "New", Count: 2}, {Status: "InProgress", Count: 1}}
+	if len(resp.ActiveTasks) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, resp.ActiveTasks)
+	}
+	for i, st := range expected {
+		if resp.ActiveTasks[i] != st {
+			t.Errorf("Expected %v at %d, got %v", st, i, resp.ActiveTasks[i])
+		}
+	}
+	if len(resp.JkhUnits) != 1 || resp.JkhUnits[0].ID != unit.ID {
+		t.Errorf("Expected unit %d in summary, got %+v", unit.ID, resp.JkhUnits)
+	}
+	if resp.LastLoginAt == nil || *resp.LastLoginAt != "2025-03-01T09:00:00Z" {
+		t.Errorf("Expected last login 2025-03-01T09:00:00Z, got %v", resp.LastLoginAt)
+	}
+
+	// Несуществующий пользователь
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/users/99999/summary", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
@@ -0,0 +1,106 @@
+// pkg/logger/logger.go
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// ============================================================================
+// ИНТЕРФЕЙС
+// ============================================================================
+
+// Logger — уровневый логгер приложения. Интерфейс минимален, чтобы в тестах
+// его было легко подменить (см. SetDefault).
+type Logger interface {
+	Debugf(format string, args ...any)
+	Infof(format string, args ...any)
+	Warnf(format string, args ...any)
+	Errorf(format string, args ...any)
+}
+
+// ============================================================================
+// РЕАЛИЗАЦИЯ (JSON через log/slog)
+// ============================================================================
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+// New создаёт логгер, пишущий JSON-записи в w, начиная с уровня level.
+func New(w io.Writer, level slog.Level) Logger {
+	h := slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})
+	return &slogLogger{l: slog.New(h)}
+}
+
+func (s *slogLogger) logf(level slog.Level, format string, args ...any) {
+	ctx := context.Background()
+	if !s.l.Enabled(ctx, level) {
+		return
+	}
+	s.l.Log(ctx, level, fmt.Sprintf(format, args...))
+}
+
+func (s *slogLogger) Debugf(format string, args ...any) { s.logf(slog.LevelDebug, format, args...) }
+func (s *slogLogger) Infof(format string, args ...any)  { s.logf(slog.LevelInfo, format, args...) }
+func (s *slogLogger) Warnf(format string, args ...any)  { s.logf(slog.LevelWarn, format, args...) }
+func (s *slogLogger) Errorf(format string, args ...any) { s.logf(slog.LevelError, format, args...) }
+
+// ============================================================================
+// КОНФИГУРАЦИЯ
+// ============================================================================
+
+// ParseLevel разбирает уровень логирования: debug, info, warn (warning), error.
+// Пустая строка соответствует info.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q", s)
+	}
+}
+
+// FromEnv создаёт логгер в stderr с уровнем из переменной окружения JKH_LOG_LEVEL.
+// При неизвестном значении используется info и выводится предупреждение.
+func FromEnv() Logger {
+	level, err := ParseLevel(os.Getenv("JKH_LOG_LEVEL"))
+	l := New(os.Stderr, level)
+	if err != nil {
+		l.Warnf("%v, falling back to info", err)
+	}
+	return l
+}
+
+// ============================================================================
+// ЛОГГЕР ПО УМОЛЧАНИЮ
+// ============================================================================
+
+var std = New(os.Stderr, slog.LevelInfo)
+
+// Default возвращает текущий логгер приложения.
+func Default() Logger { return std }
+
+// SetDefault заменяет логгер приложения и возвращает предыдущий
+// (в тестах: defer logger.SetDefault(logger.SetDefault(captured))).
+func SetDefault(l Logger) Logger {
+	prev := std
+	std = l
+	return prev
+}
+
+func Debugf(format string, args ...any) { std.Debugf(format, args...) }
+func Infof(format string, args ...any)  { std.Infof(format, args...) }
+func Warnf(format string, args ...any)  { std.Warnf(format, args...) }
+func Errorf(format string, args ...any) { std.Errorf(format, args...) }
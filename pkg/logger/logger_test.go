@@ -0,0 +1,68 @@
+// pkg/logger/logger_test.go
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogger_LevelFiltering(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, slog.LevelWarn)
+
+	l.Debugf("debug %d", 1)
+	l.Infof("Inspection act created for task %d", 1)
+	l.Warnf("warn %d", 2)
+	l.Errorf("error %d", 3)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 log lines, got %d: %s", len(lines), buf.String())
+	}
+
+	var entry struct {
+		Level string `json:"level"`
+		Msg   string `json:"msg"`
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &entry); err != nil {
+		t.Fatalf("Expected JSON log line: %v", err)
+	}
+	if entry.Level != "ERROR" || entry.Msg != "error 3" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	cases := map[string]slog.Level{
+		"":        slog.LevelInfo,
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"warning": slog.LevelWarn,
+		"error":   slog.LevelError,
+	}
+	for in, want := range cases {
+		got, err := ParseLevel(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("Expected error for unknown level")
+	}
+}
+
+func TestSetDefault_CapturesPackageLevelCalls(t *testing.T) {
+	var buf bytes.Buffer
+	defer SetDefault(SetDefault(New(&buf, slog.LevelDebug)))
+
+	Debugf("captured %s", "message")
+
+	if !strings.Contains(buf.String(), "captured message") {
+		t.Errorf("Expected captured log output, got %q", buf.String())
+	}
+}
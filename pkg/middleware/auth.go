@@ -5,6 +5,7 @@ package middleware
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 	
 	"github.com/gin-gonic/gin"
@@ -21,9 +22,48 @@ const (
 
 var jwtSecret = []byte("YOUR_ULTRA_SECURE_SECRET_KEY_12345") // Должен совпадать с ключом в pkg/auth/jwt.go
 
-// AuthRequired проверяет наличие и валидность Access Token
-func AuthRequired() gin.HandlerFunc {
+// DefaultPublicPaths — префиксы путей, доступных без токена: вход, скачивание акта
+// по подписанной ссылке (подпись проверяется в обработчике) и документация Swagger.
+var DefaultPublicPaths = []string{
+	"/api/v1/auth/login",
+	"/api/v1/acts/download",
+	"/swagger/",
+}
+
+// PublicPathsFromEnv — DefaultPublicPaths и дополнительные префиксы из переменной
+// JKH_PUBLIC_PATHS (через запятую, например "/healthz,/metrics").
+func PublicPathsFromEnv() []string {
+	paths := append([]string{}, DefaultPublicPaths...)
+	for _, p := range strings.Split(os.Getenv("JKH_PUBLIC_PATHS"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// isPublicPath — совпадает ли путь с одним из префиксов: точно или как подкаталог
+// ("/api/v1/auth/login" не открывает "/api/v1/auth/login-as").
+func isPublicPath(path string, publicPaths []string) bool {
+	for _, p := range publicPaths {
+		prefix := strings.TrimSuffix(p, "/")
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthRequired проверяет наличие и валидность Access Token.
+// Запросы к publicPaths (префиксы путей) пропускаются без проверки,
+// поэтому middleware можно подключить ко всему роутеру.
+func AuthRequired(publicPaths ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if isPublicPath(c.Request.URL.Path, publicPaths) {
+			c.Next()
+			return
+		}
+
 		tokenString := c.GetHeader("Authorization")
 		if tokenString == "" || !strings.HasPrefix(tokenString, "Bearer ") {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
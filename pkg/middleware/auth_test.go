@@ -0,0 +1,80 @@
+// pkg/middleware/auth_test.go
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"jkh/ent"
+	"jkh/pkg/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+func setupAuthTest() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(AuthRequired("/api/v1/auth/login", "/public/"))
+
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "ok") }
+	r.POST("/api/v1/auth/login", ok)
+	r.GET("/api/v1/auth/login-as", ok)
+	r.GET("/public/docs/index.html", ok)
+	r.GET("/api/v1/meta", ok)
+	return r
+}
+
+func TestAuthRequired_PublicPaths(t *testing.T) {
+	r := setupAuthTest()
+
+	cases := []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodPost, "/api/v1/auth/login", http.StatusOK},
+		{http.MethodGet, "/public/docs/index.html", http.StatusOK},
+		// Не входит в список: совпадение по префиксу строки не считается
+		{http.MethodGet, "/api/v1/auth/login-as", http.StatusUnauthorized},
+		{http.MethodGet, "/api/v1/meta", http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(tc.method, tc.path, nil))
+		if w.Code != tc.want {
+			t.Errorf("%s %s: expected %d, got %d", tc.method, tc.path, tc.want, w.Code)
+		}
+	}
+}
+
+func TestAuthRequired_ProtectedPathWithToken(t *testing.T) {
+	r := setupAuthTest()
+
+	token, _, err := auth.GenerateTokens(&ent.User{ID: 1}, RoleSpecialist)
+	if err != nil {
+		t.Fatalf("GenerateTokens failed: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/meta", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected 200 with valid token, got %d", w.Code)
+	}
+}
+
+func TestPublicPathsFromEnv(t *testing.T) {
+	t.Setenv("JKH_PUBLIC_PATHS", " /healthz, ,/metrics")
+
+	paths := PublicPathsFromEnv()
+	for _, p := range []string{"/api/v1/acts/download", "/healthz", "/metrics"} {
+		if !isPublicPath(p, paths) {
+			t.Errorf("Expected %s to be public", p)
+		}
+	}
+	if isPublicPath("/api/v1/admin/users", paths) {
+		t.Error("Expected /api/v1/admin/users to require a token")
+	}
+}
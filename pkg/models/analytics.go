@@ -8,17 +8,19 @@ import "time"
 type AnalyticsReportRequest struct {
 	From        string   `json:"from" binding:"required"`
 	To          string   `json:"to" binding:"required"`
-	Charts      []string `json:"charts" binding:"omitempty,dive,oneof=status_distribution failure_frequency inspector_performance"`
+	Charts      []string `json:"charts" binding:"omitempty,dive,oneof=status_distribution failure_frequency inspector_performance problem_rate failure_by_district"`
 	JkhUnitIDs  []int    `json:"jkh_unit_ids,omitempty"`
 	DistrictIDs []int    `json:"district_ids,omitempty"`
+	InspectorID *int     `json:"inspector_id,omitempty" binding:"omitempty,min=1"` // Только задания этого инспектора
 }
 
 // AnalyticsPreviewRequest — параметры для preview (query params)
 type AnalyticsPreviewRequest struct {
-	Chart     string `json:"chart" binding:"required,oneof=status_distribution failure_frequency inspector_performance"`
-	From      string `json:"from" binding:"required"`
-	To        string `json:"to" binding:"required"`
-	JkhUnitID *int   `json:"jkh_unit_id,omitempty"`
+	Chart       string `json:"chart" binding:"required,oneof=status_distribution failure_frequency inspector_performance problem_rate failure_by_district"`
+	From        string `json:"from" binding:"required"`
+	To          string `json:"to" binding:"required"`
+	JkhUnitID   *int   `json:"jkh_unit_id,omitempty"`
+	InspectorID *int   `json:"inspector_id,omitempty"`
 }
 
 // ===== Структуры для статистики по районам =====
@@ -67,4 +69,7 @@ type SummaryStats struct {
 	StatusBreakdown []TaskStatusStat   `json:"status_breakdown"`
 	Timeline        []TaskTimelineStat `json:"timeline"`
 	CompletionRate  float64            `json:"completion_rate"`
+
+	// Задания на проверке (OnReview) с неутверждённым актом — ожидают решения координатора
+	ActsPendingApproval int `json:"acts_pending_approval"`
 }
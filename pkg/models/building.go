@@ -7,6 +7,15 @@ type CreateBuildingRequest struct {
 	ConstructionYear int     `json:"construction_year"`
 	Description      *string `json:"description,omitempty"`   // nullable
 	Photo            *string `json:"photo_path,omitempty"`    // nullable
+
+	// Контактное лицо для согласования доступа (nullable)
+	ContactName  *string `json:"contact_name,omitempty" binding:"omitempty,max=255"`
+	ContactPhone *string `json:"contact_phone,omitempty" binding:"omitempty,max=32"`
+
+	// Этажность, число подъездов и квартир (nullable, неотрицательные)
+	Floors     *int `json:"floors,omitempty" binding:"omitempty,min=0"`
+	Entrances  *int `json:"entrances,omitempty" binding:"omitempty,min=0"`
+	Apartments *int `json:"apartments,omitempty" binding:"omitempty,min=0"`
 	
 	// Обязательные внешние ключи
 	DistrictID       int     `json:"district_id" binding:"required,min=1"`
@@ -16,6 +25,12 @@ type CreateBuildingRequest struct {
 	InspectorID      *int    `json:"inspector_id,omitempty"`
 }
 
+// TransferBuildingRequest — DTO для перевода здания в другой район/ЖЭУ.
+type TransferBuildingRequest struct {
+	DistrictID int `json:"district_id" binding:"required,min=1"` // Новый район
+	JkhUnitID  int `json:"jkh_unit_id" binding:"required,min=1"` // Новое ЖЭУ (должно относиться к району)
+}
+
 // BuildingResponse — DTO для исходящих ответов.
 // Форматируется под потребности фронтенда.
 type BuildingResponse struct {
@@ -24,9 +39,20 @@ type BuildingResponse struct {
 	ConstructionYear int       `json:"construction_year"`
 	Description      string    `json:"description"`
 	PhotoPath        string    `json:"photo_path"`
+	ContactName      string    `json:"contact_name"`
+	ContactPhone     string    `json:"contact_phone"`
+	Floors           *int      `json:"floors"`     // null — неизвестно
+	Entrances        *int      `json:"entrances"`  // null — неизвестно
+	Apartments       *int      `json:"apartments"` // null — неизвестно
 
 	// Имена связанных сущностей
 	DistrictName     string    `json:"district_name"`
 	JkhUnitName      string    `json:"jkh_unit_name"`
 	InspectorName    string    `json:"inspector_name,omitempty"`
 }
+
+// BuildingFilter — фильтры списка зданий (используются и в JSON-списке, и в CSV-экспорте).
+type BuildingFilter struct {
+	DistrictID *int // Только здания района
+	JkhUnitID  *int // Только здания ЖЭУ
+}
@@ -25,6 +25,7 @@ type ChecklistResponse struct {
     InspectionType string `json:"inspection_type"` // spring/winter/partial
     Description    string `json:"description"`
     CreatedAt      string `json:"created_at"` // ISO 8601 формат
+    ElementCount   int    `json:"element_count"` // Количество элементов в чек-листе
 }
 
 // ChecklistDetailResponse — DTO для детального ответа (чек-лист + список элементов).
@@ -40,10 +41,12 @@ type ChecklistDetailResponse struct {
 
 // ChecklistElementDetail — информация об элементе в чек-листе.
 type ChecklistElementDetail struct {
+    ChecklistElementID int `json:"checklist_element_id"` // ID элемента чек-листа (используется при сохранении результатов)
     ElementID   int    `json:"element_id"`   // ID элемента из ElementCatalog
     ElementName string `json:"element_name"` // Название элемента (например, "Кровля")
     Category    string `json:"category"`     // Категория элемента
     OrderIndex  int    `json:"order_index"`  // Порядок проверки (1, 2, 3...)
+    PhotoRequired bool `json:"photo_required"` // При проблемном состоянии обязательно фото
 }
 
 // ============================================================================
@@ -58,10 +61,16 @@ type AddElementToChecklistRequest struct {
     // Порядок проверки элемента в чек-листе (опционально).
     // Если не указан, элемент добавляется в конец списка.
     OrderIndex *int `json:"order_index,omitempty"`
+
+    // Обязательно ли фото при проблемном состоянии элемента (например, кровля, фасад).
+    PhotoRequired bool `json:"photo_required"`
 }
 
 // UpdateElementOrderRequest — DTO для изменения порядка элемента в чек-листе.
 type UpdateElementOrderRequest struct {
     // Новый порядок проверки элемента.
     OrderIndex int `json:"order_index" binding:"required,min=1"`
+
+    // Обязательность фото при проблемном состоянии (опционально; не передан — не меняется).
+    PhotoRequired *bool `json:"photo_required,omitempty"`
 }
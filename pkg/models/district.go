@@ -2,11 +2,13 @@ package models
 
 // CreateDistrictRequest — DTO для создания или обновления района
 type CreateDistrictRequest struct {
-	Name string `json:"name" binding:"required"` // Название района (обязательное поле)
+	Name        string  `json:"name" binding:"required"` // Название района (обязательное поле)
+	Description *string `json:"description,omitempty"`   // Описание (nullable; nil при обновлении очищает поле)
 }
 
 // DistrictResponse — DTO для исходящего ответа
 type DistrictResponse struct {
-	ID   int    `json:"id"`   // Уникальный идентификатор района
-	Name string `json:"name"` // Название района
+	ID          int    `json:"id"`          // Уникальный идентификатор района
+	Name        string `json:"name"`        // Название района
+	Description string `json:"description"` // Описание района
 }
@@ -7,6 +7,10 @@ type CreateElementCatalogRequest struct {
     // Поле обязательно (binding:"required") — валидация на уровне Gin.
     Name string `json:"name" binding:"required"`
     
+    // Название элемента на втором языке (опционально, для двуязычных актов).
+    // Если не задано, в англоязычном акте печатается основное название.
+    NameEn *string `json:"name_en,omitempty"` // nullable
+    
     // Категория элемента (опционально, для фильтрации и группировки).
     // Указатель (*string) позволяет отличить "не передано" от "пустая строка".
     // omitempty — если поле nil, оно не включается в JSON-ответ.
@@ -18,5 +22,21 @@ type CreateElementCatalogRequest struct {
 type ElementCatalogResponse struct {
     ID       int    `json:"id"`       // Уникальный идентификатор элемента
     Name     string `json:"name"`     // Название элемента
+    NameEn   string `json:"name_en"`  // Название на втором языке (пустая строка, если не задано)
     Category string `json:"category"` // Категория (всегда строка, даже если пустая)
 }
+
+// BulkElementResult — результат создания одного элемента в пакете.
+type BulkElementResult struct {
+    Index   int                     `json:"index"`             // Позиция элемента в запросе (с 0)
+    Name    string                  `json:"name"`              // Название из запроса
+    Element *ElementCatalogResponse `json:"element,omitempty"` // Созданный элемент (если успешно)
+    Error   string                  `json:"error,omitempty"`   // Причина отказа (например, дубликат названия)
+}
+
+// BulkCreateElementsResponse — построчный отчёт о пакетном создании элементов.
+type BulkCreateElementsResponse struct {
+    Created int                 `json:"created"` // Создано элементов
+    Failed  int                 `json:"failed"`  // Отклонено (конфликты)
+    Results []BulkElementResult `json:"results"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// ErrorResponse — формат ответа с ошибкой.
+type ErrorResponse struct {
+	Error string `json:"error"` // Человекочитаемое сообщение
+	Code  string `json:"code"`  // Машиночитаемый код (например, TASK_NOT_FOUND)
+
+	// Элементы, из-за которых запрос отклонён (например, проблемные элементы без обязательного фото)
+	Elements []string `json:"elements,omitempty"`
+}
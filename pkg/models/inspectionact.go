@@ -0,0 +1,63 @@
+package models
+
+// ============================================================================
+// DTO ДЛЯ INSPECTIONACT (Акты осмотра)
+// ============================================================================
+
+// ActorInfo — краткая информация о пользователе, выполнившем действие.
+type ActorInfo struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+// StatusHistoryEntry — запись истории переходов статуса задания.
+type StatusHistoryEntry struct {
+	FromStatus string     `json:"from_status"`
+	ToStatus   string     `json:"to_status"`
+	ChangedBy  *ActorInfo `json:"changed_by"` // null, если пользователь неизвестен
+	ChangedAt  string     `json:"changed_at"` // ISO 8601
+}
+
+// ActAuditResponse — машиночитаемая запись цепочки согласования акта (для юридического экспорта).
+type ActAuditResponse struct {
+	ActID      int    `json:"act_id"`
+	ActNumber  string `json:"act_number"`
+	TaskID     int    `json:"task_id"`
+	TaskTitle  string `json:"task_title"`
+	Status     string `json:"status"`   // создан / утверждён
+	Language   string `json:"language"` // ru / en
+	Conclusion string `json:"conclusion"`
+	CreatedAt  string `json:"created_at"`
+
+	// Кто и когда отправил задание на проверку
+	SubmittedBy *ActorInfo `json:"submitted_by"`
+	SubmittedAt *string    `json:"submitted_at"`
+
+	// Комментарий координатора при утверждении (null, если не задан)
+	ApprovalComment *string `json:"approval_comment"`
+
+	// Кто и когда утвердил акт (null, пока акт не утверждён)
+	ApprovedBy *ActorInfo `json:"approved_by"`
+	ApprovedAt *string    `json:"approved_at"`
+
+	// Полная история статусов задания
+	History []StatusHistoryEntry `json:"history"`
+}
+
+// UpdateActLanguageRequest — DTO для смены языка акта.
+type UpdateActLanguageRequest struct {
+	Language string `json:"language" binding:"required,oneof=ru en"`
+}
+
+// UpdateActIncludeUnfilledRequest — DTO для включения в акт неосмотренных элементов.
+type UpdateActIncludeUnfilledRequest struct {
+	IncludeUnfilled *bool `json:"include_unfilled" binding:"required"`
+}
+
+// ActSignedLinkResponse — подписанная ссылка на скачивание акта без JWT.
+type ActSignedLinkResponse struct {
+	URL       string `json:"url"`        // Относительный URL (/api/v1/acts/download?token=...)
+	ExpiresAt string `json:"expires_at"` // Момент истечения ссылки (RFC 3339)
+}
@@ -0,0 +1,38 @@
+package models
+
+// ============================================================================
+// DTO ДЛЯ ЭКСПОРТА ОСМОТРА
+// ============================================================================
+
+// InspectionExport — полный осмотр одним документом (формат обмена для архивации
+// и передачи в другие системы). Вложенные разделы совпадают с ответами API.
+type InspectionExport struct {
+	ExportedAt string              `json:"exported_at"` // RFC 3339
+	Task       TaskDetailResponse  `json:"task"`
+	Building   BuildingResponse    `json:"building"`
+	Checklists []ExportedChecklist `json:"checklists"` // Основной чек-лист первым, затем дополнительные
+
+	// Прогресс осмотра (как в сводке результатов)
+	TotalElements     int `json:"total_elements"`
+	CompletedElements int `json:"completed_elements"`
+	NotApplicable     int `json:"not_applicable"`
+	ProblemElements   int `json:"problem_elements"`
+
+	Act     *ActAuditResponse    `json:"act"`     // null, если акт ещё не создан
+	History []StatusHistoryEntry `json:"history"` // История статусов задания
+}
+
+// ExportedChecklist — чек-лист задания с элементами в порядке проверки.
+type ExportedChecklist struct {
+	ID             int               `json:"id"`
+	Title          string            `json:"title"`
+	InspectionType string            `json:"inspection_type"`
+	Description    string            `json:"description"`
+	Elements       []ExportedElement `json:"elements"`
+}
+
+// ExportedElement — элемент чек-листа вместе с результатом осмотра.
+type ExportedElement struct {
+	ChecklistElementDetail
+	Result *InspectionResultResponse `json:"result"` // null, если элемент не осмотрен
+}
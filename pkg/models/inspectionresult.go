@@ -10,11 +10,36 @@ type CreateInspectionResultRequest struct {
 	ChecklistElementID int `json:"checklist_element_id" binding:"required,min=1"`
 	
 	// Статус состояния элемента.
-	// Допустимые значения: "Исправное", "Удовлетворительное", "Неудовлетворительное", "Аварийное"
-	ConditionStatus string `json:"condition_status" binding:"required,oneof=Исправное Удовлетворительное Неудовлетворительное Аварийное"`
+	// Допустимые значения: "Исправное", "Удовлетворительное", "Неудовлетворительное", "Аварийное", "Неприменимо".
+	// "Неприменимо" — элемент отсутствует в здании (например, нет лифта); считается заполненным.
+	ConditionStatus string `json:"condition_status" binding:"required,oneof=Исправное Удовлетворительное Неудовлетворительное Аварийное Неприменимо"`
 	
 	// Комментарий инспектора (опционально).
 	Comment *string `json:"comment,omitempty"`
+
+	// Внутренняя заметка (опционально): не печатается в официальном акте для жильцов.
+	InternalNote *string `json:"internal_note,omitempty"`
+
+	// Пути к фотографиям элемента (опционально). Для элементов с photo_required
+	// при проблемном состоянии нужна хотя бы одна фотография.
+	// При обновлении: не передан — список не меняется, [] — очищает список.
+	PhotoPaths *[]string `json:"photo_paths,omitempty"`
+}
+
+// PatchInspectionResultRequest — DTO для частичного обновления (автосохранения) результата.
+// Передаются только изменившиеся поля; отсутствующие поля не трогаются.
+type PatchInspectionResultRequest struct {
+	// Новый статус состояния (обязателен, если результата ещё нет).
+	ConditionStatus *string `json:"condition_status,omitempty" binding:"omitempty,oneof=Исправное Удовлетворительное Неудовлетворительное Аварийное Неприменимо"`
+
+	// Новый комментарий ("" очищает комментарий).
+	Comment *string `json:"comment,omitempty"`
+
+	// Новая внутренняя заметка ("" очищает заметку).
+	InternalNote *string `json:"internal_note,omitempty"`
+
+	// Новый список фотографий ([] очищает список).
+	PhotoPaths *[]string `json:"photo_paths,omitempty"`
 }
 
 // InspectionResultResponse — DTO для исходящих ответов.
@@ -28,8 +53,10 @@ type InspectionResultResponse struct {
 	OrderIndex      int    `json:"order_index"`      // Порядок в чек-листе
 	
 	// Результат проверки
-	ConditionStatus string `json:"condition_status"`
-	Comment         string `json:"comment"`
+	ConditionStatus string   `json:"condition_status"`
+	Comment         string   `json:"comment"`
+	InternalNote    string   `json:"internal_note"` // Только для внутреннего варианта акта
+	PhotoPaths      []string `json:"photo_paths"`   // Пути к фотографиям элемента
 	
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
@@ -40,6 +67,45 @@ type TaskResultsSummary struct {
 	TaskID            int                         `json:"task_id"`
 	TaskTitle         string                      `json:"task_title"`
 	TotalElements     int                         `json:"total_elements"`      // Всего элементов в чек-листе
-	CompletedElements int                         `json:"completed_elements"`  // Заполнено результатов
+	CompletedElements int                         `json:"completed_elements"`  // Заполнено результатов (включая "Неприменимо")
+	NotApplicable     int                         `json:"not_applicable"`      // Из них отмечено как "Неприменимо"
+	ProblemElements   int                         `json:"problem_elements"`    // Неудовлетворительное + Аварийное
 	Results           []InspectionResultResponse  `json:"results"`             // Список результатов
 }
+
+// ============================================================================
+// СРАВНЕНИЕ ОСМОТРОВ
+// ============================================================================
+
+// ComparedTask — краткие сведения о задании в сравнении осмотров.
+type ComparedTask struct {
+	ID            int    `json:"id"`
+	Title         string `json:"title"`
+	Status        string `json:"status"`
+	ScheduledDate string `json:"scheduled_date"` // YYYY-MM-DD
+}
+
+// ElementComparison — состояние элемента в двух осмотрах.
+type ElementComparison struct {
+	ElementID       int    `json:"element_id"` // ID элемента справочника
+	ElementName     string `json:"element_name"`
+	ElementCategory string `json:"element_category"`
+	OrderIndex      int    `json:"order_index"`
+
+	StatusA string `json:"status_a"` // "" — элемент не осматривался в задании A
+	StatusB string `json:"status_b"` // "" — элемент не осматривался в задании B
+
+	// Изменение от A к B: improved, worsened, unchanged, not_comparable
+	Change string `json:"change"`
+}
+
+// TaskComparisonResponse — поэлементное сравнение двух осмотров одного здания.
+type TaskComparisonResponse struct {
+	BuildingID int                 `json:"building_id"`
+	TaskA      ComparedTask        `json:"task_a"`
+	TaskB      ComparedTask        `json:"task_b"`
+	Improved   int                 `json:"improved"`
+	Worsened   int                 `json:"worsened"`
+	Unchanged  int                 `json:"unchanged"`
+	Elements   []ElementComparison `json:"elements"`
+}
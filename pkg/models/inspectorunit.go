@@ -13,3 +13,31 @@ type InspectorAssignmentResponse struct {
 	InspectorID int    `json:"inspector_id"`
 	Message     string `json:"message,omitempty"`
 }
+
+// SuggestedInspectorResponse — предложенный для назначения инспектор ЖЭУ и его текущая загрузка
+type SuggestedInspectorResponse struct {
+	InspectorID int    `json:"inspector_id"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	Email       string `json:"email"`
+	ActiveTasks int    `json:"active_tasks"` // Количество активных (незавершённых) заданий
+}
+
+// BulkAssignInspectorsRequest — DTO для массового назначения инспекторов на ЖЭУ
+type BulkAssignInspectorsRequest struct {
+	InspectorIDs []int `json:"inspector_ids" binding:"required,min=1,dive,min=1"`
+}
+
+// BulkAssignResult — итог назначения одного инспектора.
+// Status: assigned | already_assigned | not_found | not_inspector
+type BulkAssignResult struct {
+	InspectorID int    `json:"inspector_id"`
+	Status      string `json:"status"`
+}
+
+// BulkAssignInspectorsResponse — ответ на массовое назначение
+type BulkAssignInspectorsResponse struct {
+	JkhUnitID int                `json:"jkh_unit_id"`
+	Assigned  int                `json:"assigned"` // Количество новых назначений
+	Results   []BulkAssignResult `json:"results"`
+}
@@ -2,8 +2,9 @@ package models
 
 // CreateJkhUnitRequest — DTO для создания или обновления ЖЭУ
 type CreateJkhUnitRequest struct {
-	Name       string `json:"name" binding:"required"`
-	DistrictID int    `json:"district_id" binding:"required,min=1"`
+	Name        string  `json:"name" binding:"required"`
+	DistrictID  int     `json:"district_id" binding:"required,min=1"`
+	Description *string `json:"description,omitempty"` // nullable; nil при обновлении очищает поле
 }
 
 // JkhUnitResponse — DTO ответа
@@ -12,4 +13,5 @@ type JkhUnitResponse struct {
 	Name         string `json:"name"`
 	DistrictID   int    `json:"district_id"`
 	DistrictName string `json:"district_name"`
+	Description  string `json:"description"`
 }
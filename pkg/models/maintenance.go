@@ -0,0 +1,24 @@
+// pkg/models/maintenance.go
+
+package models
+
+// PurgeResult — итог очистки устаревших данных
+type PurgeResult struct {
+	TasksDeleted   int   `json:"tasks_deleted"`   // Удалено отменённых заданий
+	ActsDeleted    int   `json:"acts_deleted"`    // Удалено черновых актов
+	ResultsDeleted int   `json:"results_deleted"` // Удалено результатов осмотра
+	TaskIDs        []int `json:"task_ids"`        // ID удалённых заданий
+}
+
+// BackfilledAct — акт, получивший номер при дозаполнении
+type BackfilledAct struct {
+	ActID     int    `json:"act_id"`
+	TaskID    int    `json:"task_id"`
+	ActNumber string `json:"act_number"`
+}
+
+// BackfillActNumbersResult — итог присвоения номеров старым утверждённым актам
+type BackfillActNumbersResult struct {
+	ActsNumbered int             `json:"acts_numbered"` // Сколько актов получили номер (0 при повторном запуске)
+	Acts         []BackfilledAct `json:"acts"`          // Перенумерованные акты в порядке присвоения номеров
+}
@@ -0,0 +1,18 @@
+// pkg/models/meta.go
+
+package models
+
+// EnumOption — значение перечисления и его подпись для выпадающих списков
+type EnumOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// MetaResponse — справочник всех перечислений приложения (единый источник для фронтенда)
+type MetaResponse struct {
+	TaskStatuses      []EnumOption `json:"task_statuses"`
+	TaskPriorities    []EnumOption `json:"task_priorities"`
+	ConditionStatuses []EnumOption `json:"condition_statuses"`
+	InspectionTypes   []EnumOption `json:"inspection_types"`
+	ActLanguages      []EnumOption `json:"act_languages"`
+}
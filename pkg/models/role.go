@@ -0,0 +1,9 @@
+// pkg/models/role.go
+
+package models
+
+// RoleResponse — DTO роли пользователя (для выпадающего списка при создании пользователя)
+type RoleResponse struct {
+	ID   int    `json:"id"`   // Уникальный идентификатор роли
+	Name string `json:"name"` // Название роли (Specialist, Coordinator, Inspector)
+}
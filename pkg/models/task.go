@@ -12,6 +12,9 @@ type CreateTaskRequest struct {
     // ID чек-листа для использования (обязательно).
     ChecklistID int `json:"checklist_id" binding:"required,min=1"`
     
+    // Дополнительные чек-листы (опционально), например противопожарный к конструктивному.
+    AdditionalChecklistIDs []int `json:"additional_checklist_ids,omitempty" binding:"omitempty,dive,min=1"`
+    
     // ID назначенного инспектора (обязательно).
     InspectorID int `json:"inspector_id" binding:"required,min=1"`
     
@@ -58,6 +61,9 @@ type TaskDetailResponse struct {
     Building  BuildingInfo  `json:"building"`
     Checklist ChecklistInfo `json:"checklist"`
     Inspector InspectorInfo `json:"inspector"`
+    
+    // Дополнительные чек-листы задания (кроме основного)
+    AdditionalChecklists []ChecklistInfo `json:"additional_checklists"`
 }
 
 // Вспомогательные структуры для детального ответа
@@ -82,9 +88,44 @@ type InspectorInfo struct {
 // UpdateTaskStatusRequest — DTO для изменения статуса задания.
 type UpdateTaskStatusRequest struct {
     Status string `json:"status" binding:"required,oneof=Pending InProgress OnReview ForRevision Approved Canceled"`
+    
+    // Комментарий координатора к утверждению (только для статуса Approved, опционально).
+    ApprovalComment *string `json:"approval_comment,omitempty"`
 }
 
 // AssignInspectorRequest — DTO для переназначения инспектора.
 type AssignInspectorRequest struct {
     InspectorID int `json:"inspector_id" binding:"required,min=1"`
 }
+
+// TaskListFilter — параметры фильтрации и пагинации списка заданий.
+type TaskListFilter struct {
+    Status   *string // Фильтр по статусу (опционально)
+    Page     int     // Номер страницы (с 1)
+    PageSize int     // Размер страницы
+}
+
+// TaskListResponse — DTO для постраничного списка заданий.
+type TaskListResponse struct {
+    Items    []*TaskResponse `json:"items"`
+    Total    int             `json:"total"` // Общее количество заданий (без учёта пагинации)
+    Page     int             `json:"page"`
+    PageSize int             `json:"page_size"`
+}
+
+// OverdueTaskResponse — задание, не принятое инспектором в установленный срок.
+type OverdueTaskResponse struct {
+    Task         *TaskResponse `json:"task"`
+    PendingSince string        `json:"pending_since"` // С какого момента задание ожидает принятия (ISO 8601)
+    OverdueHours int           `json:"overdue_hours"` // На сколько часов превышен срок принятия
+}
+
+// ReviewQueueGroup — задания инспектора, ожидающие утверждения координатором.
+type ReviewQueueGroup struct {
+    InspectorID       int             `json:"inspector_id"`
+    InspectorName     string          `json:"inspector_name"`
+    Count             int             `json:"count"`               // Заданий на проверке
+    OldestSubmittedAt string          `json:"oldest_submitted_at"` // Самая давняя отправка на проверку (ISO 8601)
+    OldestAgeHours    int             `json:"oldest_age_hours"`    // Сколько часов ждёт самое давнее задание
+    Tasks             []*TaskResponse `json:"tasks"`               // От старых к новым
+}
@@ -33,4 +33,13 @@ type UpdateUserRequest struct {
 	FirstName *string `json:"first_name,omitempty"`
 	LastName  *string `json:"last_name,omitempty"`
 	RoleName  *string `json:"role_name,omitempty" binding:"omitempty,oneof=Coordinator Inspector"`
-}
\ No newline at end of file
+}
+
+// UserSummaryResponse — сводка по пользователю перед переназначением заданий или увольнением
+type UserSummaryResponse struct {
+	User             UserResponse       `json:"user"`
+	ActiveTasks      []TaskStatusStat   `json:"active_tasks"`       // Незавершённые задания по статусам
+	ActiveTasksTotal int                `json:"active_tasks_total"` // Всего незавершённых заданий
+	JkhUnits         []*JkhUnitResponse `json:"jkh_units"`          // ЖЭУ, за которые отвечает пользователь
+	LastLoginAt      *string            `json:"last_login_at"`      // ISO 8601, null — ещё не входил
+}
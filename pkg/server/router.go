@@ -17,6 +17,10 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 	//создаёт движок Gin и включает стандартные middleware (логирование и обработку паник)
 	r := gin.Default()
 
+	// Проверка токена для всех маршрутов, кроме публичных (список — в middleware.DefaultPublicPaths
+	// и JKH_PUBLIC_PATHS); новые публичные маршруты добавляются в этот список, а не в разводку групп
+	r.Use(middleware.AuthRequired(middleware.PublicPathsFromEnv()...))
+
 	// Swagger UI — документация API доступна по адресу /swagger/index.html
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -26,6 +30,9 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 	userService := service.NewUserService(client)
 	userHandler := handlers.NewUserHandler(userService)
 
+	roleService := service.NewRoleService(client)
+	roleHandler := handlers.NewRoleHandler(roleService)
+
 	districtService := service.NewDistrictService(client)
 	districtHandler := handlers.NewDistrictHandler(districtService)
 
@@ -38,10 +45,18 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 	elementCatalogService := service.NewElementCatalogService(client)
 	elementCatalogHandler := handlers.NewElementCatalogHandler(elementCatalogService)
 
+	// Поля страницы для всех генерируемых PDF (акты, бланки, отчёты)
+	pdfLayout := service.PDFLayoutFromEnv()
+
 	checklistService := service.NewChecklistService(client)
+	checklistService.Layout = pdfLayout
 	checklistHandler := handlers.NewChecklistHandler(checklistService)
 
 	taskService := service.NewTaskService(client)
+	taskService.AcceptanceSLA = service.AcceptanceSLAFromEnv()
+	taskService.DefaultPriority = service.DefaultPriorityFromEnv()
+	taskService.AutoDraftAct = service.AutoDraftActFromEnv()
+	taskService.ActNumberPrefix = service.ActNumberPrefixFromEnv()
 	taskHandler := handlers.NewTaskHandler(taskService)
 
 	inspectionResultService := service.NewInspectionResultService(client)
@@ -49,27 +64,43 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 
 	// InspectionAct (PDF generation)
 	inspectionActService := service.NewInspectionActService(client, "storage/acts")
+	inspectionActService.Layout = pdfLayout
+	inspectionActService.LinkSecret = service.ActLinkSecretFromEnv()
 	inspectionActHandler := handlers.NewInspectionActHandler(inspectionActService)
 
 	// InspectorUnit service/handler (assign inspectors to JKH units)
 	inspectorUnitService := service.NewInspectorUnitService(client)
 	inspectorUnitHandler := handlers.NewInspectorUnitHandler(inspectorUnitService)
 
+	// Обслуживание (очистка устаревших данных)
+	maintenanceService := service.NewMaintenanceService(client)
+	maintenanceService.ActNumberPrefix = taskService.ActNumberPrefix
+	maintenanceHandler := handlers.NewMaintenanceHandler(maintenanceService)
+
 	// Аналитика (preview и генерация PDF)
 	analyticsService := service.NewAnalyticsService(client)
+	analyticsService.Layout = pdfLayout
 	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
 
+	// Справочник перечислений для фронтенда
+	metaHandler := handlers.NewMetaHandler(service.NewMetaService())
+
 	v1 := r.Group("/api/v1")
 	{
-		// --- 1. ПУБЛИЧНЫЕ МАРШРУТЫ (БЕЗ ТОКЕНА) ---
+		// --- 1. ПУБЛИЧНЫЕ МАРШРУТЫ (БЕЗ ТОКЕНА, см. middleware.DefaultPublicPaths) ---
 		auth := v1.Group("/auth")
 		{
 			auth.POST("/login", authHandler.Login)
 		}
 
-		// --- 2. ЗАЩИЩЁННЫЕ МАРШРУТЫ ---
+		// Скачивание акта по подписанной ссылке (токен проверяется в обработчике)
+		v1.GET("/acts/download", inspectionActHandler.DownloadSignedAct)
+
+		// --- 2. ЗАЩИЩЁННЫЕ МАРШРУТЫ (токен проверяет AuthRequired на уровне роутера) ---
 		protected := v1.Group("/")
-		protected.Use(middleware.AuthRequired())
+
+		// Доступно любой роли
+		protected.GET("/meta", metaHandler.GetMeta)
 
 		// --- A. Администратор / Специалист ---
 		specialist := protected.Group("/admin")
@@ -79,9 +110,13 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 			specialist.POST("/users", userHandler.CreateUser)
 			specialist.GET("/users", userHandler.ListUsers)
 			specialist.GET("/users/:id", userHandler.GetUser)
+			specialist.GET("/users/:id/summary", userHandler.GetUserSummary)
 			specialist.PUT("/users/:id", userHandler.UpdateUser)
 			specialist.DELETE("/users/:id", userHandler.DeleteUser)
 
+			// справочник ролей (для формы создания пользователя)
+			specialist.GET("/roles", roleHandler.ListRoles)
+
 			specialist.POST("/districts", districtHandler.CreateDistrict)
 			specialist.GET("/districts", districtHandler.ListDistricts)
 			specialist.GET("/districts/:id", districtHandler.GetDistrict)
@@ -96,18 +131,29 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 
 			// Управление назначениями инспекторов на ЖЭУ
 			specialist.POST("/jkhunits/:id/inspectors", inspectorUnitHandler.AssignInspector)
+			specialist.POST("/jkhunits/:id/inspectors/bulk", inspectorUnitHandler.AssignInspectorsBulk)
 			specialist.GET("/jkhunits/:id/inspectors", inspectorUnitHandler.ListInspectorsForUnit)
+			specialist.GET("/jkhunits/:id/inspectors/suggested", inspectorUnitHandler.SuggestInspectorForUnit)
 			specialist.DELETE("/jkhunits/:id/inspectors/:inspector_id", inspectorUnitHandler.UnassignInspector)
+
+			// Задания по ЖЭУ (для операционного контроля на уровне ЖЭУ)
+			specialist.GET("/jkhunits/:id/tasks", taskHandler.ListTasksByUnit)
+
 			// Список ЖЭУ для инспектора
 			specialist.GET("/users/:id/jkhunits", inspectorUnitHandler.ListUnitsForInspector)
 
 			specialist.POST("/buildings", buildingHandler.CreateBuilding)
 			specialist.GET("/buildings", buildingHandler.ListBuildings)
+			specialist.GET("/buildings.csv", buildingHandler.ExportBuildingsCSV)
 			specialist.GET("/buildings/:id", buildingHandler.GetBuilding)
 			specialist.PUT("/buildings/:id", buildingHandler.UpdateBuilding)
+			specialist.POST("/buildings/:id/transfer", buildingHandler.TransferBuilding)
+			specialist.GET("/buildings/:id/acts/archive", inspectionActHandler.DownloadBuildingActsArchive)
+			specialist.GET("/buildings/:id/compare", inspectionResultHandler.CompareTasks)
 			specialist.DELETE("/buildings/:id", buildingHandler.DeleteBuilding)
 
 			specialist.POST("/elements", elementCatalogHandler.CreateElement)
+			specialist.POST("/elements/bulk", elementCatalogHandler.BulkCreateElements)
 			specialist.GET("/elements", elementCatalogHandler.ListElements)
 			specialist.GET("/elements/:id", elementCatalogHandler.GetElement)
 			specialist.PUT("/elements/:id", elementCatalogHandler.UpdateElement)
@@ -117,6 +163,7 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 			specialist.POST("/checklists", checklistHandler.CreateChecklist)
 			specialist.GET("/checklists", checklistHandler.ListChecklists)
 			specialist.GET("/checklists/:id", checklistHandler.GetChecklist)
+			specialist.GET("/checklists/:id/blank-form", checklistHandler.DownloadBlankForm)
 			specialist.PUT("/checklists/:id", checklistHandler.UpdateChecklist)
 			specialist.DELETE("/checklists/:id", checklistHandler.DeleteChecklist)
 			// Управление элементами в чек-листах
@@ -126,18 +173,30 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 
 			specialist.DELETE("/tasks/:id", taskHandler.DeleteTask)
 
+			// Очистка отменённых заданий и черновых актов старше срока хранения
+			specialist.POST("/maintenance/purge", maintenanceHandler.PurgeOldData)
+			specialist.POST("/maintenance/backfill-act-numbers", maintenanceHandler.BackfillActNumbers)
+
 		}
 
 		// --- B. Координатор ---
 		coordinator := protected.Group("/tasks")
 		coordinator.Use(middleware.RBACMiddleware(middleware.RoleCoordinator))
 		{
-			coordinator.POST("/", taskHandler.CreateTask)                // Создать задание
-			coordinator.GET("/", taskHandler.ListAllTasks)               // Список всех заданий
-			coordinator.GET("/:id", taskHandler.GetTask)                 // Детали задания
-			coordinator.PUT("/:id/status", taskHandler.UpdateTaskStatus) // Изменить статус
-			coordinator.PUT("/:id/assign", taskHandler.AssignInspector)  // Переназначить инспектора
-
+			coordinator.POST("/", taskHandler.CreateTask)                                               // Создать задание
+			coordinator.GET("/", taskHandler.ListAllTasks)                                              // Список всех заданий
+			coordinator.GET("/pending-overdue", taskHandler.ListPendingOverdue)                         // Не принятые в срок
+			coordinator.GET("/review-queue/by-inspector", taskHandler.ListReviewQueueByInspector)       // Очередь на утверждение по инспекторам
+			coordinator.GET("/assignable-inspectors", taskHandler.ListAssignableInspectors)             // Инспекторы ЖЭУ здания
+			coordinator.GET("/:id", taskHandler.GetTask)                                                // Детали задания
+			coordinator.GET("/:id/export", taskHandler.ExportInspection)                                // Полный осмотр в JSON
+			coordinator.PUT("/:id/status", taskHandler.UpdateTaskStatus)                                // Изменить статус
+			coordinator.PUT("/:id/assign", taskHandler.AssignInspector)                                 // Переназначить инспектора
+			coordinator.GET("/:id/act/audit", inspectionActHandler.GetActAudit)                         // Цепочка согласования акта (JSON)
+			coordinator.PUT("/:id/act/language", inspectionActHandler.UpdateActLanguage)                // Язык акта (ru/en)
+			coordinator.PUT("/:id/act/include-unfilled", inspectionActHandler.UpdateActIncludeUnfilled) // Неосмотренные элементы в акте
+
+			coordinator.GET("/analytics/summary", analyticsHandler.GetSummary)
 			coordinator.GET("/analytics/preview", analyticsHandler.PreviewChart)
 			coordinator.POST("/analytics/report", analyticsHandler.GenerateReport)
 		}
@@ -153,9 +212,13 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 
 			inspector.POST("/tasks/:id/results", inspectionResultHandler.CreateOrUpdateResult)       //Создать/обновить результат проверки
 			inspector.GET("/tasks/:id/results", inspectionResultHandler.GetTaskResults)              //Получить все результаты задания
+			inspector.GET("/tasks/:id/results/:element_id", inspectionResultHandler.GetResult)       //Получить результат по элементу
+			inspector.PATCH("/tasks/:id/results/:element_id", inspectionResultHandler.PatchResult)   //Автосохранение отдельных полей результата
 			inspector.DELETE("/tasks/:id/results/:element_id", inspectionResultHandler.DeleteResult) //Удалить результат
+			inspector.GET("/tasks/:id/missing", inspectionResultHandler.GetMissingElements)          //Незаполненные элементы чек-листа
 
-			inspector.GET("/tasks/:id/act", inspectionActHandler.DownloadAct) //Скачивание акта осмотра (PDF)
+			inspector.GET("/tasks/:id/act", inspectionActHandler.DownloadAct)         //Скачивание акта осмотра (PDF)
+			inspector.POST("/tasks/:id/act/link", inspectionActHandler.CreateActLink) //Подписанная ссылка на акт (без JWT)
 		}
 	}
 
@@ -0,0 +1,118 @@
+// pkg/service/actarchive.go
+
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"jkh/ent"
+	"jkh/ent/building"
+	"jkh/ent/inspectionact"
+	"jkh/ent/task"
+)
+
+// ============================================================================
+// АРХИВ АКТОВ ЗДАНИЯ
+// ============================================================================
+
+// buildingArchiveManifest — имя файла-описи внутри архива.
+const buildingArchiveManifest = "manifest.csv"
+
+// BuildingActsArchive — ZIP со всеми утверждёнными актами по заданиям здания (для дела дома)
+// и описью manifest.csv (UTF-8 с BOM). Задания без утверждённого акта пропускаются.
+// Колонки описи: file, act_number, task_id, task_title, scheduled_date, approved_at, inspector.
+func (s *InspectionActService) BuildingActsArchive(ctx context.Context, buildingID int) ([]byte, string, error) {
+	exists, err := s.Client.Building.Query().Where(building.IDEQ(buildingID)).Exist(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("database error: %w", err)
+	}
+	if !exists {
+		return nil, "", ErrBuildingNotFound
+	}
+
+	acts, err := s.Client.InspectionAct.Query().
+		Where(
+			inspectionact.StatusEQ("утверждён"),
+			inspectionact.HasTaskWith(task.BuildingIDEQ(buildingID)),
+		).
+		WithTask(func(tq *ent.TaskQuery) {
+			tq.WithInspector()
+		}).
+		Order(ent.Asc(inspectionact.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("database error: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+
+	manifest := new(bytes.Buffer)
+	manifest.WriteString("\uFEFF") // UTF-8 BOM
+	mw := csv.NewWriter(manifest)
+	mw.Write([]string{"file", "act_number", "task_id", "task_title", "scheduled_date", "approved_at", "inspector"})
+
+	for _, act := range acts {
+		pdfData, _, err := s.GeneratePDFForAct(ctx, act.TaskID)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get PDF of act %d: %w", act.ID, err)
+		}
+
+		name := fmt.Sprintf("act_%s.pdf", actNumber(act))
+		f, err := zw.Create(name)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to write archive: %w", err)
+		}
+		if _, err := f.Write(pdfData); err != nil {
+			return nil, "", fmt.Errorf("failed to write archive: %w", err)
+		}
+
+		title, scheduled, inspector := "", "", ""
+		if t := act.Edges.Task; t != nil {
+			title = t.Title
+			scheduled = t.ScheduledDate.Format("2006-01-02")
+			if t.Edges.Inspector != nil {
+				inspector = fmt.Sprintf("%s %s", t.Edges.Inspector.FirstName, t.Edges.Inspector.LastName)
+			}
+		}
+		approvedAt := ""
+		if !act.ApprovedAt.IsZero() {
+			approvedAt = act.ApprovedAt.Format(time.RFC3339)
+		}
+
+		mw.Write([]string{
+			name,
+			actNumber(act),
+			strconv.Itoa(act.TaskID),
+			title,
+			scheduled,
+			approvedAt,
+			inspector,
+		})
+	}
+
+	mw.Flush()
+	if err := mw.Error(); err != nil {
+		return nil, "", fmt.Errorf("failed to write manifest: %w", err)
+	}
+	f, err := zw.Create(buildingArchiveManifest)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to write archive: %w", err)
+	}
+	if _, err := f.Write(manifest.Bytes()); err != nil {
+		return nil, "", fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	filename := fmt.Sprintf("building_%d_acts.zip", buildingID)
+	return buf.Bytes(), filename, nil
+}
@@ -0,0 +1,97 @@
+// pkg/service/actarchive_test.go
+
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"jkh/pkg/testutil"
+)
+
+func TestInspectionActService_BuildingActsArchive_ApprovedOnly(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	dir := t.TempDir()
+
+	// Два задания с утверждёнными актами и одно с черновиком (в архив не попадает)
+	for i, status := range []string{"утверждён", "утверждён", "создан"} {
+		tk := f.createTask(t, client, fmt.Sprintf("Осмотр %d", i+1))
+		path := filepath.Join(dir, fmt.Sprintf("stored_%d.pdf", i+1))
+		if err := os.WriteFile(path, []byte("%PDF-1.3 act"), 0o644); err != nil {
+			t.Fatalf("write pdf: %v", err)
+		}
+		client.InspectionAct.Create().
+			SetTaskID(tk.ID).
+			SetStatus(status).
+			SetDocumentPath(path).
+			SaveX(ctx)
+	}
+
+	svc := NewInspectionActService(client, dir)
+	data, filename, err := svc.BuildingActsArchive(ctx, f.Building.ID)
+	if err != nil {
+		t.Fatalf("BuildingActsArchive failed: %v", err)
+	}
+	if filename != fmt.Sprintf("building_%d_acts.zip", f.Building.ID) {
+		t.Errorf("Unexpected filename %s", filename)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Invalid zip: %v", err)
+	}
+
+	var pdfs []string
+	var manifest []byte
+	for _, zf := range zr.File {
+		rc, err := zf.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", zf.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+
+		switch {
+		case zf.Name == buildingArchiveManifest:
+			manifest = content
+		case strings.HasSuffix(zf.Name, ".pdf"):
+			pdfs = append(pdfs, zf.Name)
+			if !bytes.HasPrefix(content, []byte("%PDF")) {
+				t.Errorf("%s: expected PDF content", zf.Name)
+			}
+		}
+	}
+
+	if len(pdfs) != 2 {
+		t.Fatalf("Expected 2 PDFs in archive, got %v", pdfs)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(manifest, []byte("\uFEFF")))).ReadAll()
+	if err != nil {
+		t.Fatalf("Invalid manifest: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("Expected header + 2 manifest rows, got %d", len(rows))
+	}
+	for i, row := range rows[1:] {
+		if row[0] != pdfs[i] {
+			t.Errorf("Manifest row %d: expected file %s, got %s", i+1, pdfs[i], row[0])
+		}
+	}
+
+	if _, _, err := svc.BuildingActsArchive(ctx, 99999); err != ErrBuildingNotFound {
+		t.Errorf("Expected ErrBuildingNotFound, got %v", err)
+	}
+}
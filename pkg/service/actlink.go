@@ -0,0 +1,150 @@
+// pkg/service/actlink.go
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"jkh/ent"
+	"jkh/ent/inspectionact"
+	"jkh/pkg/logger"
+	"jkh/pkg/models"
+)
+
+// ============================================================================
+// ОШИБКИ
+// ============================================================================
+
+var (
+	// Подпись ссылки не сходится или токен повреждён (403 Forbidden).
+	ErrInvalidActLink = errors.New("invalid act download link")
+	// Срок действия ссылки истёк (410 Gone).
+	ErrActLinkExpired = errors.New("act download link expired")
+	// Недопустимый срок действия ссылки (400 Bad Request).
+	ErrInvalidLinkTTL = errors.New("invalid link ttl")
+)
+
+// ============================================================================
+// ПОДПИСАННЫЕ ССЫЛКИ НА АКТ
+// ============================================================================
+
+const (
+	// DefaultActLinkTTL — срок действия ссылки, если он не указан.
+	DefaultActLinkTTL = 15 * time.Minute
+	// MaxActLinkTTL — ссылки живут недолго: не дольше суток.
+	MaxActLinkTTL = 24 * time.Hour
+
+	actDownloadPath = "/api/v1/acts/download"
+)
+
+// ActLinkSecretFromEnv — ключ подписи ссылок из переменной JKH_ACT_LINK_SECRET.
+// Если ключ не задан, генерируется случайный: ранее выданные ссылки перестанут работать после перезапуска.
+func ActLinkSecretFromEnv() []byte {
+	if raw := os.Getenv("JKH_ACT_LINK_SECRET"); raw != "" {
+		return []byte(raw)
+	}
+	logger.Warnf("JKH_ACT_LINK_SECRET is not set, using a random key (act links will not survive a restart)")
+	return randomLinkSecret()
+}
+
+func randomLinkSecret() []byte {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(fmt.Sprintf("failed to generate act link secret: %v", err))
+	}
+	return secret
+}
+
+// signActToken — токен "<act_id>.<expires_unix>.<hmac>": ссылка действует только для одного акта.
+func (s *InspectionActService) signActToken(actID int, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%d.%d", actID, expiresAt.Unix())
+	return payload + "." + s.actTokenMAC(payload)
+}
+
+func (s *InspectionActService) actTokenMAC(payload string) string {
+	mac := hmac.New(sha256.New, s.LinkSecret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyActToken — проверка подписи и срока действия; возвращает ID акта.
+func (s *InspectionActService) verifyActToken(token string) (int, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, ErrInvalidActLink
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(s.actTokenMAC(payload))) {
+		return 0, ErrInvalidActLink
+	}
+
+	actID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, ErrInvalidActLink
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, ErrInvalidActLink
+	}
+	if time.Now().Unix() > expires {
+		return 0, ErrActLinkExpired
+	}
+
+	return actID, nil
+}
+
+// CreateSignedLink — короткоживущая ссылка на скачивание акта задания без JWT
+// (например, для встроенного просмотрщика PDF на фронтенде).
+func (s *InspectionActService) CreateSignedLink(ctx context.Context, taskID int, ttl time.Duration) (*models.ActSignedLinkResponse, error) {
+	if ttl <= 0 || ttl > MaxActLinkTTL {
+		return nil, ErrInvalidLinkTTL
+	}
+
+	act, err := s.Client.InspectionAct.Query().
+		Where(inspectionact.TaskIDEQ(taskID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrActNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	token := s.signActToken(act.ID, expiresAt)
+
+	return &models.ActSignedLinkResponse{
+		URL:       actDownloadPath + "?token=" + url.QueryEscape(token),
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// DownloadBySignedToken — PDF акта по подписанной ссылке.
+func (s *InspectionActService) DownloadBySignedToken(ctx context.Context, token string) ([]byte, string, error) {
+	actID, err := s.verifyActToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	act, err := s.Client.InspectionAct.Get(ctx, actID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", ErrActNotFound
+		}
+		return nil, "", fmt.Errorf("database error: %w", err)
+	}
+
+	return s.GeneratePDFForAct(ctx, act.TaskID)
+}
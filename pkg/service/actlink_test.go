@@ -0,0 +1,116 @@
+// pkg/service/actlink_test.go
+
+package service
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"jkh/ent"
+	"jkh/pkg/testutil"
+)
+
+// newSignedLinkFixture — задание с актом, PDF которого уже лежит на диске.
+func newSignedLinkFixture(t *testing.T, client *ent.Client) (*InspectionActService, *ent.InspectionAct) {
+	t.Helper()
+	f := newTaskFixture(t, client)
+	tk := f.createTask(t, client, "Осмотр")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "act_1.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-1.3 test"), 0o644); err != nil {
+		t.Fatalf("write pdf: %v", err)
+	}
+	act := client.InspectionAct.Create().SetTaskID(tk.ID).SetDocumentPath(path).SaveX(context.Background())
+
+	return NewInspectionActService(client, dir), act
+}
+
+func tokenFromLink(t *testing.T, link string) string {
+	t.Helper()
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("parse link %q: %v", link, err)
+	}
+	return u.Query().Get("token")
+}
+
+func TestInspectionActService_SignedLink_Valid(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	svc, act := newSignedLinkFixture(t, client)
+
+	link, err := svc.CreateSignedLink(ctx, act.TaskID, DefaultActLinkTTL)
+	if err != nil {
+		t.Fatalf("CreateSignedLink failed: %v", err)
+	}
+	if !strings.HasPrefix(link.URL, actDownloadPath+"?token=") {
+		t.Errorf("Unexpected link URL %q", link.URL)
+	}
+
+	data, filename, err := svc.DownloadBySignedToken(ctx, tokenFromLink(t, link.URL))
+	if err != nil {
+		t.Fatalf("DownloadBySignedToken failed: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "%PDF") {
+		t.Errorf("Expected PDF data, got %q", data)
+	}
+	if filename != "act_1.pdf" {
+		t.Errorf("Expected filename act_1.pdf, got %s", filename)
+	}
+
+	if _, err := svc.CreateSignedLink(ctx, act.TaskID, MaxActLinkTTL+time.Minute); err != ErrInvalidLinkTTL {
+		t.Errorf("Expected ErrInvalidLinkTTL, got %v", err)
+	}
+	if _, err := svc.CreateSignedLink(ctx, act.TaskID+100, DefaultActLinkTTL); err != ErrActNotFound {
+		t.Errorf("Expected ErrActNotFound, got %v", err)
+	}
+}
+
+func TestInspectionActService_SignedLink_Expired(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	svc, act := newSignedLinkFixture(t, client)
+
+	token := svc.signActToken(act.ID, time.Now().Add(-time.Minute))
+	if _, _, err := svc.DownloadBySignedToken(context.Background(), token); err != ErrActLinkExpired {
+		t.Errorf("Expected ErrActLinkExpired, got %v", err)
+	}
+}
+
+func TestInspectionActService_SignedLink_Tampered(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	svc, act := newSignedLinkFixture(t, client)
+
+	token := svc.signActToken(act.ID, time.Now().Add(time.Hour))
+	parts := strings.Split(token, ".")
+
+	cases := map[string]string{
+		"другой акт":      "999." + parts[1] + "." + parts[2],
+		"продлённый срок": parts[0] + ".9999999999." + parts[2],
+		"без подписи":     parts[0] + "." + parts[1],
+		"мусор":           "not-a-token",
+	}
+	for name, tampered := range cases {
+		if _, _, err := svc.DownloadBySignedToken(ctx, tampered); err != ErrInvalidActLink {
+			t.Errorf("%s: expected ErrInvalidActLink, got %v", name, err)
+		}
+	}
+
+	// Ссылка, подписанная другим ключом, тоже недействительна
+	other := NewInspectionActService(client, t.TempDir())
+	if _, _, err := other.DownloadBySignedToken(ctx, token); err != ErrInvalidActLink {
+		t.Errorf("Expected ErrInvalidActLink for foreign secret, got %v", err)
+	}
+}
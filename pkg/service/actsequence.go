@@ -0,0 +1,113 @@
+// pkg/service/actsequence.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"jkh/ent"
+	"jkh/ent/actsequence"
+)
+
+// ============================================================================
+// НУМЕРАЦИЯ АКТОВ
+// ============================================================================
+
+var errActSequenceMissing = errors.New("act sequence row is missing")
+
+// ActNumberPrefixFromEnv — префикс номера акта из переменной JKH_ACT_NUMBER_PREFIX
+// (например, "ЖКХ-" даёт номера вида "ЖКХ-2025-0001"). По умолчанию префикса нет.
+func ActNumberPrefixFromEnv() string {
+	return os.Getenv("JKH_ACT_NUMBER_PREFIX")
+}
+
+// formatActNumber — номер акта вида "<префикс><год>-<порядковый номер>".
+func formatActNumber(prefix string, year, seq int) string {
+	return fmt.Sprintf("%s%d-%04d", prefix, year, seq)
+}
+
+// ensureActSequence — создаёт строку счётчика за год, если её ещё нет.
+// Выполняется вне транзакции утверждения: если две реплики создают строку одновременно,
+// одна из вставок завершится нарушением уникальности — это не ошибка.
+func ensureActSequence(ctx context.Context, client *ent.Client, year int) error {
+	exists, err := client.ActSequence.Query().Where(actsequence.YearEQ(year)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	if err := client.ActSequence.Create().SetYear(year).Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+		return fmt.Errorf("failed to create act sequence for %d: %w", year, err)
+	}
+	return nil
+}
+
+// nextActSequence — следующий порядковый номер акта за год.
+// Вызывается внутри транзакции утверждения: UPDATE ... SET value = value + 1 блокирует
+// строку года до конца транзакции, поэтому параллельные утверждения (в том числе
+// на разных репликах) получают разные последовательные номера.
+func nextActSequence(ctx context.Context, tx *ent.Tx, year int) (int, error) {
+	n, err := tx.ActSequence.Update().
+		Where(actsequence.YearEQ(year)).
+		AddValue(1).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment act sequence: %w", err)
+	}
+	if n == 0 {
+		return 0, errActSequenceMissing
+	}
+
+	seq, err := tx.ActSequence.Query().Where(actsequence.YearEQ(year)).Only(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+	return seq.Value, nil
+}
+
+// approveActRecord — перевод акта в статус "утверждён" и присвоение номера за год
+// в одной транзакции со счётчиком. Уже присвоенный номер не меняется.
+func (s *InspectionActService) approveActRecord(ctx context.Context, act *ent.InspectionAct, now time.Time, conclusion string, approvalComment *string) (*ent.InspectionAct, error) {
+	year := now.Year()
+	if act.ActNumber == nil {
+		if err := ensureActSequence(ctx, s.Client, year); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	update := tx.InspectionAct.UpdateOne(act).
+		SetApprovedAt(now).
+		SetStatus("утверждён").
+		SetConclusion(conclusion).
+		SetNillableApprovalComment(approvalComment)
+
+	if act.ActNumber == nil {
+		seq, err := nextActSequence(ctx, tx, year)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		update.SetActNumber(formatActNumber(s.ActNumberPrefix, year, seq))
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to approve inspection act: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,79 @@
+// pkg/service/actsequence_test.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"jkh/ent/inspectionact"
+	"jkh/pkg/testutil"
+)
+
+func TestInspectionActService_ApproveAct_ConcurrentNumbers(t *testing.T) {
+	client := testutil.SetupConcurrentTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	var taskIDs []int
+	for i := 0; i < 2; i++ {
+		tk := f.createTask(t, client, fmt.Sprintf("Осмотр %d", i+1))
+		client.InspectionAct.Create().SetTaskID(tk.ID).SaveX(ctx)
+		taskIDs = append(taskIDs, tk.ID)
+	}
+
+	svc := NewInspectionActService(client, t.TempDir())
+	svc.Layout.FontDir = "../../storage/fonts"
+
+	// Два координатора утверждают акты одновременно
+	var wg sync.WaitGroup
+	errs := make(chan error, len(taskIDs))
+	for _, id := range taskIDs {
+		wg.Add(1)
+		go func(taskID int) {
+			defer wg.Done()
+			errs <- svc.ApproveAct(ctx, taskID, nil)
+		}(id)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("ApproveAct failed: %v", err)
+		}
+	}
+
+	acts := client.InspectionAct.Query().Where(inspectionact.TaskIDIn(taskIDs...)).AllX(ctx)
+	var numbers []string
+	for _, a := range acts {
+		if a.ActNumber == nil {
+			t.Fatalf("Expected act %d to get a number", a.ID)
+		}
+		numbers = append(numbers, *a.ActNumber)
+	}
+	sort.Strings(numbers)
+
+	year := time.Now().Year()
+	want := []string{formatActNumber("", year, 1), formatActNumber("", year, 2)}
+	if fmt.Sprint(numbers) != fmt.Sprint(want) {
+		t.Errorf("Expected distinct consecutive numbers %v, got %v", want, numbers)
+	}
+
+	// Следующее утверждение продолжает последовательность года
+	third := f.createTask(t, client, "Осмотр 3")
+	client.InspectionAct.Create().SetTaskID(third.ID).SaveX(ctx)
+	svc.ActNumberPrefix = "ЖКХ-"
+	if err := svc.ApproveAct(ctx, third.ID, nil); err != nil {
+		t.Fatalf("ApproveAct failed: %v", err)
+	}
+	act := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(third.ID)).OnlyX(ctx)
+	if got, want := actNumber(act), formatActNumber("ЖКХ-", year, 3); got != want {
+		t.Errorf("Expected act number %s, got %s", want, got)
+	}
+}
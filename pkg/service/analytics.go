@@ -11,8 +11,12 @@ import (
 	"time"
 
 	"jkh/ent"
+	"jkh/ent/inspectionact"
 	"jkh/ent/inspectionresult"
+	"jkh/ent/predicate"
 	"jkh/ent/task"
+	"jkh/ent/user"
+	"jkh/pkg/models"
 
 	"github.com/jung-kurt/gofpdf"
 
@@ -26,17 +30,144 @@ import (
 // AnalyticsService отвечает за агрегации, построение графиков и генерацию PDF-отчётов
 type AnalyticsService struct {
 	Client *ent.Client
+	Layout PDFLayout // Поля страницы и каталог шрифтов для PDF-отчёта
 }
 
 func NewAnalyticsService(client *ent.Client) *AnalyticsService {
-	return &AnalyticsService{Client: client}
+	return &AnalyticsService{Client: client, Layout: DefaultPDFLayout()}
+}
+
+// periodTaskPredicates — задания, созданные за период; при inspectorID > 0 — только задания этого инспектора.
+func periodTaskPredicates(from, to time.Time, inspectorID int) []predicate.Task {
+	preds := []predicate.Task{task.CreatedAtGTE(from), task.CreatedAtLTE(to)}
+	if inspectorID > 0 {
+		preds = append(preds, task.InspectorIDEQ(inspectorID))
+	}
+	return preds
+}
+
+// chartTitle — заголовок графика; при фильтре по инспектору к нему добавляется имя инспектора.
+// Если инспектор не найден, возвращается ErrUserNotFound.
+func (s *AnalyticsService) chartTitle(ctx context.Context, title string, inspectorID int) (string, error) {
+	if inspectorID <= 0 {
+		return title, nil
+	}
+	name, err := s.inspectorName(ctx, inspectorID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s — %s", title, name), nil
+}
+
+// inspectorName — "Имя Фамилия" инспектора для подписей отчёта.
+func (s *AnalyticsService) inspectorName(ctx context.Context, inspectorID int) (string, error) {
+	u, err := s.Client.User.Query().Where(user.IDEQ(inspectorID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrUserNotFound
+		}
+		return "", fmt.Errorf("database error: %w", err)
+	}
+	return fmt.Sprintf("%s %s", u.FirstName, u.LastName), nil
+}
+
+// summaryTimelineDays — глубина ленты "создано/завершено" в сводке (в днях, включая сегодня).
+const summaryTimelineDays = 30
+
+// GetSummary — сводка для панели координатора: распределение заданий по статусам,
+// доля завершённых, лента за последние дни и количество актов, ожидающих утверждения.
+func (s *AnalyticsService) GetSummary(ctx context.Context) (*models.SummaryStats, error) {
+	// 1. Распределение по статусам
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+	if err := s.Client.Task.Query().
+		GroupBy(task.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	summary := &models.SummaryStats{
+		StatusBreakdown: make([]models.TaskStatusStat, 0, len(rows)),
+		Timeline:        make([]models.TaskTimelineStat, 0, summaryTimelineDays),
+	}
+	approved := 0
+	for _, r := range rows {
+		summary.TotalTasks += r.Count
+		summary.StatusBreakdown = append(summary.StatusBreakdown, models.TaskStatusStat{Status: r.Status, Count: r.Count})
+		if task.Status(r.Status) == task.StatusApproved {
+			approved = r.Count
+		}
+	}
+	sort.Slice(summary.StatusBreakdown, func(i, j int) bool {
+		return summary.StatusBreakdown[i].Status < summary.StatusBreakdown[j].Status
+	})
+	if summary.TotalTasks > 0 {
+		summary.CompletionRate = float64(approved) / float64(summary.TotalTasks) * 100
+	}
+
+	// 2. Акты, ожидающие утверждения: задание на проверке + акт в статусе "создан"
+	pending, err := s.Client.Task.Query().
+		Where(
+			task.StatusEQ(task.StatusOnReview),
+			task.HasActWith(inspectionact.StatusEQ("создан")),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	summary.ActsPendingApproval = pending
+
+	// 3. Лента: создано / утверждено по дням
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).
+		AddDate(0, 0, -(summaryTimelineDays - 1))
+
+	recent, err := s.Client.Task.Query().
+		Where(task.Or(
+			task.CreatedAtGTE(start),
+			task.And(task.StatusEQ(task.StatusApproved), task.UpdatedAtGTE(start)),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	created := map[string]int{}
+	completed := map[string]int{}
+	for _, t := range recent {
+		if !t.CreatedAt.Before(start) {
+			created[t.CreatedAt.In(now.Location()).Format("2006-01-02")]++
+		}
+		if t.Status == task.StatusApproved && !t.UpdatedAt.Before(start) {
+			completed[t.UpdatedAt.In(now.Location()).Format("2006-01-02")]++
+		}
+	}
+	for day := start; !day.After(now); day = day.AddDate(0, 0, 1) {
+		key := day.Format("2006-01-02")
+		summary.Timeline = append(summary.Timeline, models.TaskTimelineStat{
+			Date:           day,
+			TasksCreated:   created[key],
+			TasksCompleted: completed[key],
+		})
+	}
+
+	return summary, nil
 }
 
 // GenerateInspectorPerformancePNG — простой пример: количество завершённых заданий по инспекторам
-func (s *AnalyticsService) GenerateInspectorPerformancePNG(ctx context.Context, from, to time.Time) ([]byte, error) {
+func (s *AnalyticsService) GenerateInspectorPerformancePNG(ctx context.Context, from, to time.Time, inspectorID int) ([]byte, error) {
+	title, err := s.chartTitle(ctx, "Inspector Performance", inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Получаем задачи Approved за период с edge Inspector
 	tasks, err := s.Client.Task.Query().
-		Where(task.StatusEQ(task.StatusApproved), task.CreatedAtGTE(from), task.CreatedAtLTE(to)).
+		Where(task.StatusEQ(task.StatusApproved)).
+		Where(periodTaskPredicates(from, to, inspectorID)...).
 		WithInspector().
 		All(ctx)
 	if err != nil {
@@ -61,7 +192,7 @@ func (s *AnalyticsService) GenerateInspectorPerformancePNG(ctx context.Context,
 	}
 
 	p := plot.New()
-	p.Title.Text = "Inspector Performance"
+	p.Title.Text = title
 	if len(labels) > 0 {
 		p.NominalX(labels...)
 	}
@@ -89,11 +220,18 @@ func (s *AnalyticsService) GenerateInspectorPerformancePNG(ctx context.Context,
 	return buf.Bytes(), nil
 }
 
-// GenerateStatusDistributionPNG — распределение статусов заданий по районам
-func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, from, to time.Time) ([]byte, error) {
+// districtStatusStats — количество заданий района по статусам.
+type districtStatusStats struct {
+	name   string
+	counts map[task.Status]int
+}
+
+// statusCountsByDistrict — задания за период (при inspectorID > 0 — только этого инспектора),
+// сгруппированные по районам и статусам. Районы отсортированы по имени.
+func (s *AnalyticsService) statusCountsByDistrict(ctx context.Context, from, to time.Time, inspectorID int) ([]*districtStatusStats, error) {
 	// Получаем задания за период с связями Building -> District
 	tasks, err := s.Client.Task.Query().
-		Where(task.CreatedAtGTE(from), task.CreatedAtLTE(to)).
+		Where(periodTaskPredicates(from, to, inspectorID)...).
 		WithBuilding(func(bq *ent.BuildingQuery) {
 			bq.WithDistrict()
 		}).
@@ -103,11 +241,7 @@ func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, fr
 	}
 
 	// Группируем: district -> status -> count
-	type districtStats struct {
-		name   string
-		counts map[task.Status]int
-	}
-	districtMap := make(map[int]*districtStats)
+	districtMap := make(map[int]*districtStatusStats)
 
 	for _, t := range tasks {
 		if t.Edges.Building == nil || t.Edges.Building.Edges.District == nil {
@@ -115,7 +249,7 @@ func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, fr
 		}
 		d := t.Edges.Building.Edges.District
 		if _, ok := districtMap[d.ID]; !ok {
-			districtMap[d.ID] = &districtStats{
+			districtMap[d.ID] = &districtStatusStats{
 				name:   d.Name,
 				counts: make(map[task.Status]int),
 			}
@@ -124,7 +258,7 @@ func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, fr
 	}
 
 	// Собираем названия районов и статусы
-	var districts []*districtStats
+	districts := make([]*districtStatusStats, 0, len(districtMap))
 	for _, ds := range districtMap {
 		districts = append(districts, ds)
 	}
@@ -133,6 +267,21 @@ func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, fr
 		return districts[i].name < districts[j].name
 	})
 
+	return districts, nil
+}
+
+// GenerateStatusDistributionPNG — распределение статусов заданий по районам
+func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, from, to time.Time, inspectorID int) ([]byte, error) {
+	title, err := s.chartTitle(ctx, "Распределение статусов заданий по районам", inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	districts, err := s.statusCountsByDistrict(ctx, from, to, inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Определяем все возможные статусы
 	allStatuses := []task.Status{
 		task.StatusNew,
@@ -146,7 +295,7 @@ func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, fr
 
 	// Создаём график
 	p := plot.New()
-	p.Title.Text = "Распределение статусов заданий по районам"
+	p.Title.Text = title
 	p.Y.Label.Text = "Количество заданий"
 
 	// Подготовка данных для групповой столбчатой диаграммы
@@ -215,17 +364,17 @@ func (s *AnalyticsService) GenerateStatusDistributionPNG(ctx context.Context, fr
 }
 
 // GenerateFailureFrequencyPNG — частота "Аварийных" и "Неудовлетворительных" статусов по элементам
-func (s *AnalyticsService) GenerateFailureFrequencyPNG(ctx context.Context, from, to time.Time) ([]byte, error) {
+func (s *AnalyticsService) GenerateFailureFrequencyPNG(ctx context.Context, from, to time.Time, inspectorID int) ([]byte, error) {
+	title, err := s.chartTitle(ctx, "Частота проблемных состояний по элементам", inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Получаем результаты осмотра за период
 	results, err := s.Client.InspectionResult.Query().
-		Where(
-			inspectionresult.Or(
-				inspectionresult.ConditionStatusEQ(inspectionresult.ConditionStatusАварийное),
-				inspectionresult.ConditionStatusEQ(inspectionresult.ConditionStatusНеудовлетворительное),
-			),
-		).
+		Where(inspectionresult.ConditionStatusIn(problemConditionStatuses...)).
 		WithTask(func(tq *ent.TaskQuery) {
-			tq.Where(task.CreatedAtGTE(from), task.CreatedAtLTE(to))
+			tq.Where(periodTaskPredicates(from, to, inspectorID)...)
 		}).
 		WithChecklistElement(func(ceq *ent.ChecklistElementQuery) {
 			ceq.WithElementCatalog()
@@ -283,7 +432,7 @@ func (s *AnalyticsService) GenerateFailureFrequencyPNG(ctx context.Context, from
 
 	// Создаём график
 	p := plot.New()
-	p.Title.Text = "Частота проблемных состояний по элементам"
+	p.Title.Text = title
 	p.Y.Label.Text = "Количество"
 
 	elementNames := make([]string, len(elements))
@@ -344,18 +493,261 @@ func (s *AnalyticsService) GenerateFailureFrequencyPNG(ctx context.Context, from
 	return buf.Bytes(), nil
 }
 
-// GenerateReportPDF — сборка PDF с графиками
-func (s *AnalyticsService) GenerateReportPDF(ctx context.Context, from, to time.Time, charts []string) ([]byte, string, error) {
-	pdf := gofpdf.New("P", "mm", "A4", "")
-	// Подключаем шрифты, если указаны
-	pdf.AddUTF8Font("Times", "", "storage/fonts/timesnewromanpsmt.ttf")  // Путь к обычному шрифту
-    if err := pdf.Error(); err != nil {
-        return nil, "", fmt.Errorf("failed to load regular font: %w", err)
-    }
-    pdf.AddUTF8Font("Times", "B", "storage/fonts/ofont.ru_Times New Roman.ttf")  // Путь к жирному шрифту
-    if err := pdf.Error(); err != nil {
-        return nil, "", fmt.Errorf("failed to load bold font: %w", err)
-    }
+// districtFailureStats — число проблемных результатов осмотра в районе.
+type districtFailureStats struct {
+	name           string
+	unsatisfactory int // "Неудовлетворительное"
+	emergency      int // "Аварийное"
+}
+
+// failureCountsByDistrict — проблемные результаты по районам (задание → здание → район) для заданий,
+// созданных за период (при inspectorID > 0 — только заданий этого инспектора).
+// Сортировка — по убыванию общего числа проблем, затем по названию района.
+func (s *AnalyticsService) failureCountsByDistrict(ctx context.Context, from, to time.Time, inspectorID int) ([]*districtFailureStats, error) {
+	results, err := s.Client.InspectionResult.Query().
+		Where(
+			inspectionresult.ConditionStatusIn(problemConditionStatuses...),
+			inspectionresult.HasTaskWith(periodTaskPredicates(from, to, inspectorID)...),
+		).
+		WithTask(func(tq *ent.TaskQuery) {
+			tq.WithBuilding(func(bq *ent.BuildingQuery) {
+				bq.WithDistrict()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	byDistrict := make(map[int]*districtFailureStats)
+	for _, r := range results {
+		t := r.Edges.Task
+		if t == nil || t.Edges.Building == nil || t.Edges.Building.Edges.District == nil {
+			continue
+		}
+		d := t.Edges.Building.Edges.District
+		stat, ok := byDistrict[d.ID]
+		if !ok {
+			stat = &districtFailureStats{name: d.Name}
+			byDistrict[d.ID] = stat
+		}
+		switch r.ConditionStatus {
+		case inspectionresult.ConditionStatusНеудовлетворительное:
+			stat.unsatisfactory++
+		case inspectionresult.ConditionStatusАварийное:
+			stat.emergency++
+		}
+	}
+
+	stats := make([]*districtFailureStats, 0, len(byDistrict))
+	for _, stat := range byDistrict {
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		totalI := stats[i].unsatisfactory + stats[i].emergency
+		totalJ := stats[j].unsatisfactory + stats[j].emergency
+		if totalI != totalJ {
+			return totalI > totalJ
+		}
+		return stats[i].name < stats[j].name
+	})
+
+	return stats, nil
+}
+
+// GenerateFailureByDistrictPNG — проблемные результаты по районам (столбцы "Неудовлетворительное" + "Аварийное" в стопке)
+func (s *AnalyticsService) GenerateFailureByDistrictPNG(ctx context.Context, from, to time.Time, inspectorID int) ([]byte, error) {
+	title, err := s.chartTitle(ctx, "Проблемные состояния элементов по районам", inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.failureCountsByDistrict(ctx, from, to, inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := plot.New()
+	p.Title.Text = title
+	p.Y.Label.Text = "Количество"
+
+	if len(stats) > 0 {
+		names := make([]string, len(stats))
+		unsatisfactoryVals := make(plotter.Values, len(stats))
+		emergencyVals := make(plotter.Values, len(stats))
+		for i, st := range stats {
+			names[i] = st.name
+			unsatisfactoryVals[i] = float64(st.unsatisfactory)
+			emergencyVals[i] = float64(st.emergency)
+		}
+		p.NominalX(names...)
+
+		barWidth := vg.Points(25)
+
+		barUnsatisfactory, err := plotter.NewBarChart(unsatisfactoryVals, barWidth)
+		if err != nil {
+			return nil, err
+		}
+		barUnsatisfactory.Color = color.RGBA{R: 255, G: 165, B: 0, A: 255} // Orange
+
+		barEmergency, err := plotter.NewBarChart(emergencyVals, barWidth)
+		if err != nil {
+			return nil, err
+		}
+		barEmergency.Color = color.RGBA{R: 220, G: 20, B: 60, A: 255} // Crimson
+		barEmergency.StackOn(barUnsatisfactory)
+
+		p.Add(barUnsatisfactory, barEmergency)
+		p.Legend.Add("Неудовлетворительное", barUnsatisfactory)
+		p.Legend.Add("Аварийное", barEmergency)
+	}
+
+	p.Legend.Top = true
+
+	// Render into PNG buffer
+	width := vg.Inch * 10
+	height := vg.Inch * 5
+	img := vgimg.New(width, height)
+	dc := draw.New(img)
+	p.Draw(dc)
+
+	buf := &bytes.Buffer{}
+	pngCanvas := vgimg.PngCanvas{Canvas: img}
+	if _, err := pngCanvas.WriteTo(buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// inspectorProblemRate — доля проблемных результатов осмотра одного инспектора.
+type inspectorProblemRate struct {
+	name     string
+	total    int     // Всего результатов (без "Неприменимо")
+	problems int     // "Неудовлетворительное" + "Аварийное"
+	rate     float64 // Доля проблемных, %
+}
+
+// problemRateByInspector — доля проблемных результатов по инспекторам для заданий, созданных за период.
+// Результаты "Неприменимо" в долю не входят; инспекторы без оцениваемых результатов в выборку
+// не попадают (иначе они выглядели бы как не фиксирующие проблем). Сортировка — по возрастанию доли:
+// первыми идут инспекторы, которые почти не фиксируют проблем. При inspectorID > 0 — только этот инспектор.
+func (s *AnalyticsService) problemRateByInspector(ctx context.Context, from, to time.Time, inspectorID int) ([]*inspectorProblemRate, error) {
+	tasks, err := s.Client.Task.Query().
+		Where(periodTaskPredicates(from, to, inspectorID)...).
+		Where(task.HasInspector()).
+		WithInspector().
+		WithResults().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	byInspector := make(map[int]*inspectorProblemRate)
+	for _, t := range tasks {
+		ins := t.Edges.Inspector
+		if ins == nil {
+			continue
+		}
+		stat, ok := byInspector[ins.ID]
+		if !ok {
+			stat = &inspectorProblemRate{name: fmt.Sprintf("%s %s", ins.FirstName, ins.LastName)}
+			byInspector[ins.ID] = stat
+		}
+		for _, r := range t.Edges.Results {
+			if r.ConditionStatus == inspectionresult.ConditionStatusНеприменимо {
+				continue
+			}
+			stat.total++
+			if isProblemCondition(r.ConditionStatus) {
+				stat.problems++
+			}
+		}
+	}
+
+	rates := make([]*inspectorProblemRate, 0, len(byInspector))
+	for _, stat := range byInspector {
+		if stat.total == 0 {
+			continue
+		}
+		stat.rate = float64(stat.problems) / float64(stat.total) * 100
+		rates = append(rates, stat)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		if rates[i].rate != rates[j].rate {
+			return rates[i].rate < rates[j].rate
+		}
+		return rates[i].name < rates[j].name
+	})
+
+	return rates, nil
+}
+
+// GenerateProblemRatePNG — доля проблемных результатов ("Неудовлетворительное"/"Аварийное") по инспекторам
+func (s *AnalyticsService) GenerateProblemRatePNG(ctx context.Context, from, to time.Time, inspectorID int) ([]byte, error) {
+	title, err := s.chartTitle(ctx, "Доля проблемных результатов по инспекторам", inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.problemRateByInspector(ctx, from, to, inspectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := plot.New()
+	p.Title.Text = title
+	p.Y.Label.Text = "% результатов"
+	p.Y.Min = 0
+	p.Y.Max = 100
+
+	labels := make([]string, len(rates))
+	vals := make(plotter.Values, len(rates))
+	for i, r := range rates {
+		labels[i] = fmt.Sprintf("%s (%d)", r.name, r.total)
+		vals[i] = r.rate
+	}
+
+	if len(labels) > 0 {
+		p.NominalX(labels...)
+
+		bar, err := plotter.NewBarChart(vals, vg.Points(20))
+		if err != nil {
+			return nil, err
+		}
+		bar.Color = color.RGBA{R: 220, G: 20, B: 60, A: 255} // Crimson
+		p.Add(bar)
+	}
+
+	// Render into PNG buffer
+	width := vg.Inch * 10
+	height := vg.Inch * 5
+	img := vgimg.New(width, height)
+	dc := draw.New(img)
+	p.Draw(dc)
+
+	buf := &bytes.Buffer{}
+	pngCanvas := vgimg.PngCanvas{Canvas: img}
+	if _, err := pngCanvas.WriteTo(buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GenerateReportPDF — сборка PDF с графиками (при inspectorID > 0 — только по заданиям этого инспектора)
+func (s *AnalyticsService) GenerateReportPDF(ctx context.Context, from, to time.Time, charts []string, inspectorID int) ([]byte, string, error) {
+	var inspector string
+	if inspectorID > 0 {
+		name, err := s.inspectorName(ctx, inspectorID)
+		if err != nil {
+			return nil, "", err
+		}
+		inspector = name
+	}
+
+	pdf, err := newPDFDocument(s.Layout, fmt.Sprintf("Аналитический отчёт за %s — %s", from.Format("02.01.2006"), to.Format("02.01.2006")))
+	if err != nil {
+		return nil, "", err
+	}
 
 	// Титульная страница
 	pdf.AddPage()
@@ -364,12 +756,17 @@ func (s *AnalyticsService) GenerateReportPDF(ctx context.Context, from, to time.
 	pdf.Ln(12)
 	pdf.SetFont("Times", "", 11)
 	pdf.CellFormat(0, 6, fmt.Sprintf("Период: %s — %s", from.Format("02.01.2006"), to.Format("02.01.2006")), "", 1, "L", false, 0, "")
+	if inspector != "" {
+		pdf.CellFormat(0, 6, "Инспектор: "+inspector, "", 1, "L", false, 0, "")
+	}
 
 	// Маппинг названий графиков для PDF
 	chartTitles := map[string]string{
 		"inspector_performance": "Производительность инспекторов",
 		"status_distribution":   "Распределение статусов заданий по районам",
 		"failure_frequency":     "Частота проблемных состояний элементов",
+		"problem_rate":          "Доля проблемных результатов по инспекторам",
+		"failure_by_district":   "Проблемные состояния элементов по районам",
 	}
 
 	for _, ch := range charts {
@@ -378,11 +775,15 @@ func (s *AnalyticsService) GenerateReportPDF(ctx context.Context, from, to time.
 
 		switch ch {
 		case "inspector_performance":
-			img, err = s.GenerateInspectorPerformancePNG(ctx, from, to)
+			img, err = s.GenerateInspectorPerformancePNG(ctx, from, to, inspectorID)
 		case "status_distribution":
-			img, err = s.GenerateStatusDistributionPNG(ctx, from, to)
+			img, err = s.GenerateStatusDistributionPNG(ctx, from, to, inspectorID)
 		case "failure_frequency":
-			img, err = s.GenerateFailureFrequencyPNG(ctx, from, to)
+			img, err = s.GenerateFailureFrequencyPNG(ctx, from, to, inspectorID)
+		case "problem_rate":
+			img, err = s.GenerateProblemRatePNG(ctx, from, to, inspectorID)
+		case "failure_by_district":
+			img, err = s.GenerateFailureByDistrictPNG(ctx, from, to, inspectorID)
 		default:
 			// Пропускаем неподдерживаемые
 			continue
@@ -401,6 +802,9 @@ func (s *AnalyticsService) GenerateReportPDF(ctx context.Context, from, to time.
 		if title == "" {
 			title = ch
 		}
+		if inspector != "" {
+			title += " — " + inspector
+		}
 		pdf.CellFormat(0, 10, title, "", 1, "L", false, 0, "")
 		pdf.ImageOptions(name, 10, 30, 190, 0, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
 	}
@@ -0,0 +1,247 @@
+// pkg/service/analytics_test.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"jkh/ent"
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/testutil"
+)
+
+func TestAnalyticsService_GetSummary_ActsPendingApproval(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	// Два задания на проверке с актами "создан"
+	for _, title := range []string{"Осмотр 1", "Осмотр 2"} {
+		tk := f.createTask(t, client, title)
+		client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusOnReview).ExecX(ctx)
+		client.InspectionAct.Create().SetTaskID(tk.ID).SaveX(ctx)
+	}
+
+	// Утверждённое задание и задание в работе в счётчик не входят
+	approved := f.createTask(t, client, "Утверждённое")
+	client.Task.UpdateOneID(approved.ID).SetStatus(task.StatusApproved).ExecX(ctx)
+	client.InspectionAct.Create().SetTaskID(approved.ID).SetStatus("утверждён").SaveX(ctx)
+	f.createTask(t, client, "Новое")
+
+	summary, err := NewAnalyticsService(client).GetSummary(ctx)
+	if err != nil {
+		t.Fatalf("GetSummary failed: %v", err)
+	}
+
+	if summary.ActsPendingApproval != 2 {
+		t.Errorf("Expected 2 acts pending approval, got %d", summary.ActsPendingApproval)
+	}
+	if summary.TotalTasks != 4 {
+		t.Errorf("Expected 4 tasks, got %d", summary.TotalTasks)
+	}
+	if summary.CompletionRate != 25 {
+		t.Errorf("Expected completion rate 25%%, got %v", summary.CompletionRate)
+	}
+	if len(summary.Timeline) != summaryTimelineDays {
+		t.Errorf("Expected %d timeline days, got %d", summaryTimelineDays, len(summary.Timeline))
+	}
+	if last := summary.Timeline[len(summary.Timeline)-1]; last.TasksCreated != 4 || last.TasksCompleted != 1 {
+		t.Errorf("Expected today's 4 created / 1 completed, got %+v", last)
+	}
+}
+
+func TestAnalyticsService_ProblemRateByInspector(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	// Один инспектор: 4 оцениваемых результата, из них 2 проблемных → 50% ("Неприменимо" не учитывается)
+	tk := f.createTask(t, client, "Осмотр")
+	statuses := []inspectionresult.ConditionStatus{
+		inspectionresult.ConditionStatusИсправное,
+		inspectionresult.ConditionStatusНеудовлетворительное,
+		inspectionresult.ConditionStatusАварийное,
+		inspectionresult.ConditionStatusУдовлетворительное,
+		inspectionresult.ConditionStatusНеприменимо,
+	}
+	for i, st := range statuses {
+		ce := f.addElement(t, client, fmt.Sprintf("Элемент %d", i+1), i+1)
+		client.InspectionResult.Create().
+			SetTaskID(tk.ID).
+			SetChecklistElementID(ce.ID).
+			SetConditionStatus(st).
+			SaveX(ctx)
+	}
+
+	// Второй инспектор с заданием, но без результатов — не попадает в выборку с нулевой долей
+	idle := createTestUser(t, client, "Inspector", "idle")
+	empty := f.createTask(t, client, "Без результатов")
+	client.Task.UpdateOneID(empty.ID).SetInspectorID(idle.ID).ExecX(ctx)
+
+	svc := NewAnalyticsService(client)
+	from, to := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	rates, err := svc.problemRateByInspector(ctx, from, to, 0)
+	if err != nil {
+		t.Fatalf("problemRateByInspector failed: %v", err)
+	}
+	if len(rates) != 1 {
+		t.Fatalf("Expected only the inspector with results, got %d", len(rates))
+	}
+	if rates[0].total != 4 || rates[0].problems != 2 || rates[0].rate != 50 {
+		t.Errorf("Expected 2 of 4 problem results (50%%), got %+v", rates[0])
+	}
+
+	// Фильтр по инспектору без результатов — пустая выборка
+	rates, err = svc.problemRateByInspector(ctx, from, to, idle.ID)
+	if err != nil {
+		t.Fatalf("problemRateByInspector(idle) failed: %v", err)
+	}
+	if len(rates) != 0 {
+		t.Errorf("Expected no rates for inspector without results, got %+v", rates[0])
+	}
+
+	img, err := svc.GenerateProblemRatePNG(ctx, from, to, 0)
+	if err != nil {
+		t.Fatalf("GenerateProblemRatePNG failed: %v", err)
+	}
+	if len(img) == 0 {
+		t.Error("Expected non-empty PNG")
+	}
+}
+
+func TestAnalyticsService_FailureCountsByDistrict(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	north := client.District.Create().SetName("Северный").SaveX(ctx)
+	northUnit := client.JkhUnit.Create().SetName("ЖЭУ-С").SetDistrictID(north.ID).SaveX(ctx)
+	northBuilding := client.Building.Create().
+		SetAddress("ул. Северная, д. 5").
+		SetDistrictID(north.ID).
+		SetJkhUnitID(northUnit.ID).
+		SaveX(ctx)
+
+	elements := make([]*ent.ChecklistElement, 3)
+	for i := range elements {
+		elements[i] = f.addElement(t, client, fmt.Sprintf("Элемент %d", i+1), i+1)
+	}
+	addResults := func(taskID int, statuses ...inspectionresult.ConditionStatus) {
+		for i, st := range statuses {
+			client.InspectionResult.Create().
+				SetTaskID(taskID).
+				SetChecklistElementID(elements[i].ID).
+				SetConditionStatus(st).
+				SaveX(ctx)
+		}
+	}
+
+	// Центральный: 1 неудовлетворительное + 1 исправное (не учитывается)
+	central := f.createTask(t, client, "Центр")
+	addResults(central.ID,
+		inspectionresult.ConditionStatusНеудовлетворительное,
+		inspectionresult.ConditionStatusИсправное,
+	)
+
+	// Северный: 2 аварийных + 1 неудовлетворительное
+	northTask := f.createTask(t, client, "Север")
+	client.Task.UpdateOneID(northTask.ID).SetBuildingID(northBuilding.ID).ExecX(ctx)
+	addResults(northTask.ID,
+		inspectionresult.ConditionStatusАварийное,
+		inspectionresult.ConditionStatusАварийное,
+		inspectionresult.ConditionStatusНеудовлетворительное,
+	)
+
+	svc := NewAnalyticsService(client)
+	from, to := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	stats, err := svc.failureCountsByDistrict(ctx, from, to, 0)
+	if err != nil {
+		t.Fatalf("failureCountsByDistrict failed: %v", err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 districts, got %d", len(stats))
+	}
+
+	// Худший район — первым
+	if stats[0].name != "Северный" || stats[0].emergency != 2 || stats[0].unsatisfactory != 1 {
+		t.Errorf("Expected Северный with 2 emergency / 1 unsatisfactory, got %+v", stats[0])
+	}
+	if stats[1].name != "Центральный" || stats[1].emergency != 0 || stats[1].unsatisfactory != 1 {
+		t.Errorf("Expected Центральный with 0 emergency / 1 unsatisfactory, got %+v", stats[1])
+	}
+
+	img, err := svc.GenerateFailureByDistrictPNG(ctx, from, to, 0)
+	if err != nil {
+		t.Fatalf("GenerateFailureByDistrictPNG failed: %v", err)
+	}
+	if len(img) == 0 {
+		t.Error("Expected non-empty PNG")
+	}
+}
+
+func TestAnalyticsService_StatusDistribution_FilteredByInspector(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	// Задания инспектора из фикстуры: одно новое, одно утверждённое
+	f.createTask(t, client, "Новое")
+	approved := f.createTask(t, client, "Утверждённое")
+	client.Task.UpdateOneID(approved.ID).SetStatus(task.StatusApproved).ExecX(ctx)
+
+	// Задание другого инспектора не должно попасть в график
+	other := createTestUser(t, client, "Inspector", "other")
+	foreign := f.createTask(t, client, "Чужое")
+	client.Task.UpdateOneID(foreign.ID).SetInspectorID(other.ID).ExecX(ctx)
+
+	svc := NewAnalyticsService(client)
+	from, to := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	districts, err := svc.statusCountsByDistrict(ctx, from, to, f.Inspector.ID)
+	if err != nil {
+		t.Fatalf("statusCountsByDistrict failed: %v", err)
+	}
+	if len(districts) != 1 {
+		t.Fatalf("Expected 1 district, got %d", len(districts))
+	}
+	counts := districts[0].counts
+	if counts[task.StatusNew] != 1 || counts[task.StatusApproved] != 1 || len(counts) != 2 {
+		t.Errorf("Expected 1 New and 1 Approved task of the inspector, got %v", counts)
+	}
+
+	// Без фильтра учитываются все задания
+	all, err := svc.statusCountsByDistrict(ctx, from, to, 0)
+	if err != nil {
+		t.Fatalf("statusCountsByDistrict failed: %v", err)
+	}
+	if all[0].counts[task.StatusNew] != 2 {
+		t.Errorf("Expected 2 New tasks without filter, got %d", all[0].counts[task.StatusNew])
+	}
+
+	title, err := svc.chartTitle(ctx, "Распределение", f.Inspector.ID)
+	if err != nil {
+		t.Fatalf("chartTitle failed: %v", err)
+	}
+	if !strings.Contains(title, f.Inspector.LastName) {
+		t.Errorf("Expected title to contain inspector name, got %q", title)
+	}
+
+	if _, err := svc.GenerateStatusDistributionPNG(ctx, from, to, 99999); err != ErrUserNotFound {
+		t.Errorf("Expected ErrUserNotFound for unknown inspector, got %v", err)
+	}
+}
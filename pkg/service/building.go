@@ -1,16 +1,20 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"errors"
 	"fmt"
-	"log"
+	"regexp"
+	"strconv"
 
 	"jkh/ent"
 	"jkh/ent/building"
 	"jkh/ent/district"
 	"jkh/ent/jkhunit"
 	"jkh/ent/user"
+	"jkh/pkg/logger"
 	"jkh/pkg/models"
 )
 
@@ -19,6 +23,12 @@ var (
 	ErrBuildingNotFound = errors.New("building not found")
 	ErrBuildingConflict = errors.New("building address already exists")
 	ErrFKNotFound       = errors.New("one or more foreign keys not found (District, JKH Unit, or Inspector)")
+	// ЖЭУ относится к другому району (400 Bad Request).
+	ErrDistrictUnitMismatch = errors.New("jkh unit does not belong to the specified district")
+	// Телефон контактного лица в недопустимом формате (400 Bad Request).
+	ErrInvalidContactPhone = errors.New("invalid contact phone")
+	// Отрицательная этажность, число подъездов или квартир (400 Bad Request).
+	ErrInvalidBuildingSize = errors.New("floors, entrances and apartments must be non-negative")
 )
 
 // BuildingService — слой бизнес-логики.
@@ -39,6 +49,11 @@ func (s *BuildingService) toBuildingResponse(b *ent.Building) *models.BuildingRe
 		ConstructionYear: b.ConstructionYear,
 		Description:      b.Description,
 		PhotoPath:        b.Photo,
+		ContactName:      b.ContactName,
+		ContactPhone:     b.ContactPhone,
+		Floors:           b.Floors,
+		Entrances:        b.Entrances,
+		Apartments:       b.Apartments,
 	}
 
 	// Добавляем имена FK. Работает только если было WithDistrict / WithJkhUnit / WithInspector.
@@ -57,6 +72,27 @@ func (s *BuildingService) toBuildingResponse(b *ent.Building) *models.BuildingRe
 	return resp
 }
 
+// contactPhonePattern — цифры, пробелы, скобки, дефисы и ведущий "+" (например, "+7 (342) 200-00-00").
+var contactPhonePattern = regexp.MustCompile(`^\+?[0-9][0-9 ()\-]{4,30}$`)
+
+// validateContact — проверка формата телефона контактного лица (если он указан).
+func validateContact(req models.CreateBuildingRequest) error {
+	if req.ContactPhone != nil && *req.ContactPhone != "" && !contactPhonePattern.MatchString(*req.ContactPhone) {
+		return ErrInvalidContactPhone
+	}
+	return nil
+}
+
+// validateBuildingSize — этажность, число подъездов и квартир не могут быть отрицательными.
+func validateBuildingSize(req models.CreateBuildingRequest) error {
+	for _, v := range []*int{req.Floors, req.Entrances, req.Apartments} {
+		if v != nil && *v < 0 {
+			return ErrInvalidBuildingSize
+		}
+	}
+	return nil
+}
+
 // checkFKs — это обеспечивает ссылочную целостность.
 // Я добавил обработку ошибок.
 func (s *BuildingService) checkFKs(ctx context.Context, districtID, jkhUnitID int, inspectorID *int) error {
@@ -67,15 +103,20 @@ func (s *BuildingService) checkFKs(ctx context.Context, districtID, jkhUnitID in
 	}
 
 	// Проверка JKH Unit
-	jExists, err := s.Client.JkhUnit.Query().Where(jkhunit.IDEQ(jkhUnitID)).Exist(ctx)
-	if err != nil {
+	unit, err := s.Client.JkhUnit.Query().Where(jkhunit.IDEQ(jkhUnitID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
 		return err
 	}
 
-	if !dExists || !jExists {
+	if !dExists || unit == nil {
 		return ErrFKNotFound
 	}
 
+	// ЖЭУ должно относиться к тому же району, что и здание
+	if unit.DistrictID != districtID {
+		return ErrDistrictUnitMismatch
+	}
+
 	// Проверка Inspector (nullable)
 	if inspectorID != nil {
 		iExists, err := s.Client.User.Query().Where(user.IDEQ(*inspectorID)).Exist(ctx)
@@ -92,6 +133,13 @@ func (s *BuildingService) checkFKs(ctx context.Context, districtID, jkhUnitID in
 
 // CreateBuilding — создание объекта.
 func (s *BuildingService) CreateBuilding(ctx context.Context, req models.CreateBuildingRequest) (*models.BuildingResponse, error) {
+	if err := validateContact(req); err != nil {
+		return nil, err
+	}
+	if err := validateBuildingSize(req); err != nil {
+		return nil, err
+	}
+
 	// Проверка FK
 	if err := s.checkFKs(ctx, req.DistrictID, req.JkhUnitID, req.InspectorID); err != nil {
 		return nil, err
@@ -113,13 +161,22 @@ func (s *BuildingService) CreateBuilding(ctx context.Context, req models.CreateB
 	if req.InspectorID != nil {
 		create.SetInspectorID(*req.InspectorID)
 	}
+	if req.ContactName != nil {
+		create.SetContactName(*req.ContactName)
+	}
+	if req.ContactPhone != nil {
+		create.SetContactPhone(*req.ContactPhone)
+	}
+	create.SetNillableFloors(req.Floors).
+		SetNillableEntrances(req.Entrances).
+		SetNillableApartments(req.Apartments)
 
 	b, err := create.Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
 			return nil, ErrBuildingConflict
 		}
-		log.Printf("DB error creating building: %v", err)
+		logger.Errorf("DB error creating building: %v", err)
 		return nil, fmt.Errorf("database error")
 	}
 
@@ -137,13 +194,28 @@ func (s *BuildingService) CreateBuilding(ctx context.Context, req models.CreateB
 	return s.toBuildingResponse(b), nil
 }
 
-// ListBuildings — исправлено полностью
-func (s *BuildingService) ListBuildings(ctx context.Context) ([]*models.BuildingResponse, error) {
-	buildings, err := s.Client.Building.Query().
+// queryBuildings — запрос зданий с фильтрами и загруженными связями.
+func (s *BuildingService) queryBuildings(ctx context.Context, filter models.BuildingFilter) ([]*ent.Building, error) {
+	query := s.Client.Building.Query()
+
+	if filter.DistrictID != nil {
+		query = query.Where(building.DistrictIDEQ(*filter.DistrictID))
+	}
+	if filter.JkhUnitID != nil {
+		query = query.Where(building.JkhUnitIDEQ(*filter.JkhUnitID))
+	}
+
+	return query.
 		WithDistrict().
 		WithJkhUnit().
 		WithInspector().
+		Order(ent.Asc(building.FieldID)).
 		All(ctx)
+}
+
+// ListBuildings — исправлено полностью
+func (s *BuildingService) ListBuildings(ctx context.Context, filter models.BuildingFilter) ([]*models.BuildingResponse, error) {
+	buildings, err := s.queryBuildings(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("database error")
 	}
@@ -156,6 +228,47 @@ func (s *BuildingService) ListBuildings(ctx context.Context) ([]*models.Building
 	return resp, nil
 }
 
+// ExportCSV — выгрузка реестра зданий в CSV (UTF-8 с BOM, чтобы Excel корректно открывал кириллицу).
+// Колонки: id, address, district, jkh_unit, construction_year, has_inspector.
+func (s *BuildingService) ExportCSV(ctx context.Context, filter models.BuildingFilter) ([]byte, error) {
+	buildings, err := s.queryBuildings(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("\uFEFF") // UTF-8 BOM
+
+	w := csv.NewWriter(buf)
+	w.Write([]string{"id", "address", "district", "jkh_unit", "construction_year", "has_inspector"})
+
+	for _, b := range buildings {
+		districtName, unitName := "", ""
+		if b.Edges.District != nil {
+			districtName = b.Edges.District.Name
+		}
+		if b.Edges.JkhUnit != nil {
+			unitName = b.Edges.JkhUnit.Name
+		}
+
+		w.Write([]string{
+			strconv.Itoa(b.ID),
+			b.Address,
+			districtName,
+			unitName,
+			strconv.Itoa(b.ConstructionYear),
+			strconv.FormatBool(b.Edges.Inspector != nil),
+		})
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
 // RetrieveBuilding — получить по ID.
 func (s *BuildingService) RetrieveBuilding(ctx context.Context, id int) (*models.BuildingResponse, error) {
 	b, err := s.Client.Building.Query().
@@ -175,6 +288,13 @@ func (s *BuildingService) RetrieveBuilding(ctx context.Context, id int) (*models
 
 // UpdateBuilding — обновление.
 func (s *BuildingService) UpdateBuilding(ctx context.Context, id int, req models.CreateBuildingRequest) (*models.BuildingResponse, error) {
+	if err := validateContact(req); err != nil {
+		return nil, err
+	}
+	if err := validateBuildingSize(req); err != nil {
+		return nil, err
+	}
+
 	if err := s.checkFKs(ctx, req.DistrictID, req.JkhUnitID, req.InspectorID); err != nil {
 		return nil, err
 	}
@@ -200,6 +320,31 @@ func (s *BuildingService) UpdateBuilding(ctx context.Context, id int, req models
 	} else {
 		update.ClearInspector()
 	}
+	if req.ContactName != nil {
+		update.SetContactName(*req.ContactName)
+	} else {
+		update.ClearContactName()
+	}
+	if req.ContactPhone != nil {
+		update.SetContactPhone(*req.ContactPhone)
+	} else {
+		update.ClearContactPhone()
+	}
+	if req.Floors != nil {
+		update.SetFloors(*req.Floors)
+	} else {
+		update.ClearFloors()
+	}
+	if req.Entrances != nil {
+		update.SetEntrances(*req.Entrances)
+	} else {
+		update.ClearEntrances()
+	}
+	if req.Apartments != nil {
+		update.SetApartments(*req.Apartments)
+	} else {
+		update.ClearApartments()
+	}
 
 	b, err := update.Save(ctx)
 	if err != nil {
@@ -226,6 +371,45 @@ func (s *BuildingService) UpdateBuilding(ctx context.Context, id int, req models
 	return s.toBuildingResponse(b), nil
 }
 
+// Transfer — перевод здания в другой район/ЖЭУ (при изменении административных границ).
+// ЖЭУ должно относиться к указанному району. Изменение записывается в лог.
+func (s *BuildingService) Transfer(ctx context.Context, buildingID, newDistrictID, newUnitID int) (*models.BuildingResponse, error) {
+	b, err := s.Client.Building.Get(ctx, buildingID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBuildingNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	// Проверка FK и соответствия ЖЭУ новому району
+	if err := s.checkFKs(ctx, newDistrictID, newUnitID, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.Client.Building.UpdateOneID(buildingID).
+		SetDistrictID(newDistrictID).
+		SetJkhUnitID(newUnitID).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	logger.Infof("Building %d transferred: district %d -> %d, jkh unit %d -> %d",
+		buildingID, b.DistrictID, newDistrictID, b.JkhUnitID, newUnitID)
+
+	b, err = s.Client.Building.Query().
+		Where(building.IDEQ(buildingID)).
+		WithDistrict().
+		WithJkhUnit().
+		WithInspector().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transferred building: %w", err)
+	}
+
+	return s.toBuildingResponse(b), nil
+}
+
 // DeleteBuilding — удаление.
 func (s *BuildingService) DeleteBuilding(ctx context.Context, id int) error {
 	err := s.Client.Building.DeleteOneID(id).Exec(ctx)
@@ -3,7 +3,10 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"strings"
 	"testing"
 
 	"jkh/pkg/models"
@@ -121,7 +124,7 @@ func TestBuildingService_ListBuildings(t *testing.T) {
 		}
 	}
 
-	list, err := svc.ListBuildings(ctx)
+	list, err := svc.ListBuildings(ctx, models.BuildingFilter{})
 	if err != nil {
 		t.Fatalf("ListBuildings failed: %v", err)
 	}
@@ -235,3 +238,271 @@ func TestBuildingService_DeleteBuilding_Success(t *testing.T) {
 		t.Errorf("Expected building to be deleted")
 	}
 }
+
+func TestBuildingService_ExportCSV(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	districtSvc := NewDistrictService(client)
+	central, _ := districtSvc.CreateDistrict(ctx, models.CreateDistrictRequest{Name: "Центральный"})
+	northern, _ := districtSvc.CreateDistrict(ctx, models.CreateDistrictRequest{Name: "Северный"})
+
+	jkhSvc := NewJkhUnitService(client)
+	unit, _ := jkhSvc.CreateJkhUnit(ctx, models.CreateJkhUnitRequest{Name: "ЖЭУ-1", DistrictID: central.ID})
+	northUnit, _ := jkhSvc.CreateJkhUnit(ctx, models.CreateJkhUnitRequest{Name: "ЖЭУ-2", DistrictID: northern.ID})
+
+	svc := NewBuildingService(client)
+	for _, req := range []models.CreateBuildingRequest{
+		{Address: "ул. Ленина, 1", DistrictID: central.ID, JkhUnitID: unit.ID, ConstructionYear: 1975},
+		{Address: "ул. Мира, 2", DistrictID: northern.ID, JkhUnitID: northUnit.ID},
+	} {
+		if _, err := svc.CreateBuilding(ctx, req); err != nil {
+			t.Fatalf("CreateBuilding failed: %v", err)
+		}
+	}
+
+	data, err := svc.ExportCSV(ctx, models.BuildingFilter{})
+	if err != nil {
+		t.Fatalf("ExportCSV failed: %v", err)
+	}
+
+	if !bytes.HasPrefix(data, []byte("\xEF\xBB\xBF")) {
+		t.Error("Expected UTF-8 BOM at the beginning of CSV")
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF")))).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to parse CSV: %v", err)
+	}
+
+	header := strings.Join(records[0], ",")
+	if header != "id,address,district,jkh_unit,construction_year,has_inspector" {
+		t.Errorf("Unexpected header: %s", header)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected header + 2 rows, got %d records", len(records))
+	}
+	if records[1][2] != "Центральный" || records[2][2] != "Северный" {
+		t.Errorf("Expected Cyrillic district names, got %q and %q", records[1][2], records[2][2])
+	}
+	if records[1][4] != "1975" || records[1][5] != "false" {
+		t.Errorf("Unexpected row: %v", records[1])
+	}
+
+	// Фильтр по району
+	data, err = svc.ExportCSV(ctx, models.BuildingFilter{DistrictID: &northern.ID})
+	if err != nil {
+		t.Fatalf("ExportCSV with filter failed: %v", err)
+	}
+	if lines := strings.Count(strings.TrimSpace(string(data)), "\n"); lines != 1 {
+		t.Errorf("Expected header + 1 row for district filter, got %d data lines", lines)
+	}
+}
+
+func TestBuildingService_Transfer(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	north := client.District.Create().SetName("Северный").SaveX(ctx)
+	northUnit := client.JkhUnit.Create().SetName("ЖЭУ-С").SetDistrictID(north.ID).SaveX(ctx)
+
+	svc := NewBuildingService(client)
+	resp, err := svc.Transfer(ctx, f.Building.ID, north.ID, northUnit.ID)
+	if err != nil {
+		t.Fatalf("Transfer failed: %v", err)
+	}
+	if resp.DistrictName != "Северный" || resp.JkhUnitName != "ЖЭУ-С" {
+		t.Errorf("Expected building in Северный/ЖЭУ-С, got %s/%s", resp.DistrictName, resp.JkhUnitName)
+	}
+
+	stored := client.Building.GetX(ctx, f.Building.ID)
+	if stored.DistrictID != north.ID || stored.JkhUnitID != northUnit.ID {
+		t.Errorf("Expected stored FKs %d/%d, got %d/%d", north.ID, northUnit.ID, stored.DistrictID, stored.JkhUnitID)
+	}
+}
+
+func TestBuildingService_Transfer_DistrictUnitMismatch(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	// ЖЭУ фикстуры относится к району "Центральный", а не к "Северный"
+	north := client.District.Create().SetName("Северный").SaveX(ctx)
+
+	svc := NewBuildingService(client)
+	if _, err := svc.Transfer(ctx, f.Building.ID, north.ID, f.JkhUnit.ID); err != ErrDistrictUnitMismatch {
+		t.Fatalf("Expected ErrDistrictUnitMismatch, got %v", err)
+	}
+
+	stored := client.Building.GetX(ctx, f.Building.ID)
+	if stored.DistrictID != f.District.ID {
+		t.Errorf("Expected building to stay in district %d, got %d", f.District.ID, stored.DistrictID)
+	}
+
+	if _, err := svc.Transfer(ctx, 99999, north.ID, f.JkhUnit.ID); err != ErrBuildingNotFound {
+		t.Errorf("Expected ErrBuildingNotFound, got %v", err)
+	}
+}
+
+func TestBuildingService_DistrictUnitConsistency(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	districtSvc := NewDistrictService(client)
+	central, _ := districtSvc.CreateDistrict(ctx, models.CreateDistrictRequest{Name: "Центральный"})
+	northern, _ := districtSvc.CreateDistrict(ctx, models.CreateDistrictRequest{Name: "Северный"})
+
+	jkhSvc := NewJkhUnitService(client)
+	centralUnit, _ := jkhSvc.CreateJkhUnit(ctx, models.CreateJkhUnitRequest{Name: "ЖЭУ-1", DistrictID: central.ID})
+	northUnit, _ := jkhSvc.CreateJkhUnit(ctx, models.CreateJkhUnitRequest{Name: "ЖЭУ-2", DistrictID: northern.ID})
+
+	svc := NewBuildingService(client)
+
+	// ЖЭУ из того же района — здание создаётся
+	created, err := svc.CreateBuilding(ctx, models.CreateBuildingRequest{
+		Address: "ул. Ленина, 1", DistrictID: central.ID, JkhUnitID: centralUnit.ID,
+	})
+	if err != nil {
+		t.Fatalf("CreateBuilding with matching district/unit failed: %v", err)
+	}
+
+	// ЖЭУ из другого района — отказ при создании
+	_, err = svc.CreateBuilding(ctx, models.CreateBuildingRequest{
+		Address: "ул. Мира, 2", DistrictID: central.ID, JkhUnitID: northUnit.ID,
+	})
+	if err != ErrDistrictUnitMismatch {
+		t.Errorf("Expected ErrDistrictUnitMismatch on create, got %v", err)
+	}
+
+	// ...и при обновлении
+	_, err = svc.UpdateBuilding(ctx, created.ID, models.CreateBuildingRequest{
+		Address: "ул. Ленина, 1", DistrictID: northern.ID, JkhUnitID: centralUnit.ID,
+	})
+	if err != ErrDistrictUnitMismatch {
+		t.Errorf("Expected ErrDistrictUnitMismatch on update, got %v", err)
+	}
+
+	// Согласованная пара при обновлении принимается
+	updated, err := svc.UpdateBuilding(ctx, created.ID, models.CreateBuildingRequest{
+		Address: "ул. Ленина, 1", DistrictID: northern.ID, JkhUnitID: northUnit.ID,
+	})
+	if err != nil {
+		t.Fatalf("UpdateBuilding with matching district/unit failed: %v", err)
+	}
+	if updated.DistrictName != "Северный" || updated.JkhUnitName != "ЖЭУ-2" {
+		t.Errorf("Expected Северный/ЖЭУ-2, got %s/%s", updated.DistrictName, updated.JkhUnitName)
+	}
+}
+
+func TestBuildingService_Contact_SetAndClear(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	svc := NewBuildingService(client)
+
+	name, phone := "Иванова Мария (старшая по дому)", "+7 (342) 200-00-00"
+	created, err := svc.CreateBuilding(ctx, models.CreateBuildingRequest{
+		Address:      "ул. Мира, 2",
+		DistrictID:   f.District.ID,
+		JkhUnitID:    f.JkhUnit.ID,
+		ContactName:  &name,
+		ContactPhone: &phone,
+	})
+	if err != nil {
+		t.Fatalf("CreateBuilding failed: %v", err)
+	}
+	if created.ContactName != name || created.ContactPhone != phone {
+		t.Errorf("Expected contact %q / %q, got %q / %q", name, phone, created.ContactName, created.ContactPhone)
+	}
+
+	// Недопустимый телефон отклоняется
+	bad := "позвонить вечером"
+	_, err = svc.UpdateBuilding(ctx, created.ID, models.CreateBuildingRequest{
+		Address:      "ул. Мира, 2",
+		DistrictID:   f.District.ID,
+		JkhUnitID:    f.JkhUnit.ID,
+		ContactPhone: &bad,
+	})
+	if err != ErrInvalidContactPhone {
+		t.Errorf("Expected ErrInvalidContactPhone, got %v", err)
+	}
+
+	// nil при обновлении очищает контакт
+	updated, err := svc.UpdateBuilding(ctx, created.ID, models.CreateBuildingRequest{
+		Address:    "ул. Мира, 2",
+		DistrictID: f.District.ID,
+		JkhUnitID:  f.JkhUnit.ID,
+	})
+	if err != nil {
+		t.Fatalf("UpdateBuilding failed: %v", err)
+	}
+	if updated.ContactName != "" || updated.ContactPhone != "" {
+		t.Errorf("Expected contact to be cleared, got %q / %q", updated.ContactName, updated.ContactPhone)
+	}
+}
+
+func TestBuildingService_Size_SetAndValidate(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	svc := NewBuildingService(client)
+
+	floors, entrances, apartments := 9, 4, 144
+	created, err := svc.CreateBuilding(ctx, models.CreateBuildingRequest{
+		Address:    "ул. Мира, 2",
+		DistrictID: f.District.ID,
+		JkhUnitID:  f.JkhUnit.ID,
+		Floors:     &floors,
+		Entrances:  &entrances,
+		Apartments: &apartments,
+	})
+	if err != nil {
+		t.Fatalf("CreateBuilding failed: %v", err)
+	}
+	if created.Floors == nil || *created.Floors != 9 ||
+		created.Entrances == nil || *created.Entrances != 4 ||
+		created.Apartments == nil || *created.Apartments != 144 {
+		t.Errorf("Expected size 9/4/144, got %v/%v/%v", created.Floors, created.Entrances, created.Apartments)
+	}
+
+	// Отрицательные значения отклоняются
+	negative := -1
+	_, err = svc.UpdateBuilding(ctx, created.ID, models.CreateBuildingRequest{
+		Address:    "ул. Мира, 2",
+		DistrictID: f.District.ID,
+		JkhUnitID:  f.JkhUnit.ID,
+		Entrances:  &negative,
+	})
+	if err != ErrInvalidBuildingSize {
+		t.Errorf("Expected ErrInvalidBuildingSize, got %v", err)
+	}
+
+	// nil при обновлении очищает значение
+	updated, err := svc.UpdateBuilding(ctx, created.ID, models.CreateBuildingRequest{
+		Address:    "ул. Мира, 2",
+		DistrictID: f.District.ID,
+		JkhUnitID:  f.JkhUnit.ID,
+		Floors:     &floors,
+	})
+	if err != nil {
+		t.Fatalf("UpdateBuilding failed: %v", err)
+	}
+	if updated.Floors == nil || *updated.Floors != 9 || updated.Entrances != nil || updated.Apartments != nil {
+		t.Errorf("Expected only floors to remain, got %v/%v/%v", updated.Floors, updated.Entrances, updated.Apartments)
+	}
+}
@@ -4,12 +4,13 @@ import (
     "context"
     "errors"
     "fmt"
-    "log"
 
     "jkh/ent"
     "jkh/ent/checklist"
     "jkh/ent/checklistelement"
     "jkh/ent/elementcatalog"
+    "jkh/ent/inspectionresult"
+    "jkh/pkg/logger"
     "jkh/pkg/models"
 )
 
@@ -29,6 +30,9 @@ var (
     
     // Связь checklist-element не найдена (404 Not Found).
     ErrChecklistElementNotFound = errors.New("element not found in this checklist")
+
+    // По элементу чек-листа уже есть результаты осмотра — удаление сломает акты (409 Conflict).
+    ErrElementHasResults = errors.New("checklist element is referenced by inspection results")
 )
 
 // ============================================================================
@@ -38,10 +42,11 @@ var (
 // ChecklistService — слой бизнес-логики для работы с чек-листами.
 type ChecklistService struct {
     Client *ent.Client
+    Layout PDFLayout // Поля страницы и каталог шрифтов для бланка осмотра
 }
 
 func NewChecklistService(client *ent.Client) *ChecklistService {
-    return &ChecklistService{Client: client}
+    return &ChecklistService{Client: client, Layout: DefaultPDFLayout()}
 }
 
 // ============================================================================
@@ -59,6 +64,28 @@ func (s *ChecklistService) toChecklistResponse(c *ent.Checklist) *models.Checkli
     }
 }
 
+// checklistElementCounts — количество элементов в каждом чек-листе (один запрос с GROUP BY).
+// Чек-листы без элементов в результат не попадают (их счётчик равен 0).
+func checklistElementCounts(ctx context.Context, client *ent.Client) (map[int]int, error) {
+    var rows []struct {
+        ChecklistID int `json:"checklist_id"`
+        Count       int `json:"count"`
+    }
+    err := client.ChecklistElement.Query().
+        GroupBy(checklistelement.FieldChecklistID).
+        Aggregate(ent.Count()).
+        Scan(ctx, &rows)
+    if err != nil {
+        return nil, err
+    }
+
+    counts := make(map[int]int, len(rows))
+    for _, r := range rows {
+        counts[r.ChecklistID] = r.Count
+    }
+    return counts, nil
+}
+
 // toChecklistDetailResponse — преобразует чек-лист + элементы в детальный DTO.
 func (s *ChecklistService) toChecklistDetailResponse(c *ent.Checklist) *models.ChecklistDetailResponse {
     resp := &models.ChecklistDetailResponse{
@@ -73,24 +100,31 @@ func (s *ChecklistService) toChecklistDetailResponse(c *ent.Checklist) *models.C
     // Преобразуем связи ChecklistElement → ElementCatalog в DTO
     if c.Edges.Elements != nil {
         for _, ce := range c.Edges.Elements {
-            elem := models.ChecklistElementDetail{
-                ElementID:  ce.ElementID,
-                OrderIndex: ce.OrderIndex,
-            }
-            
-            // Если загружен ElementCatalog (через WithElementCatalog()), добавляем его данные
-            if ce.Edges.ElementCatalog != nil {
-                elem.ElementName = ce.Edges.ElementCatalog.Name
-                elem.Category = ce.Edges.ElementCatalog.Category
-            }
-            
-            resp.Elements = append(resp.Elements, elem)
+            resp.Elements = append(resp.Elements, toChecklistElementDetail(ce))
         }
     }
 
     return resp
 }
 
+// toChecklistElementDetail — преобразует элемент чек-листа (с загруженным ElementCatalog) в DTO.
+func toChecklistElementDetail(ce *ent.ChecklistElement) models.ChecklistElementDetail {
+    elem := models.ChecklistElementDetail{
+        ChecklistElementID: ce.ID,
+        ElementID:          ce.ElementID,
+        OrderIndex:         ce.OrderIndex,
+        PhotoRequired:      ce.PhotoRequired,
+    }
+
+    // Если загружен ElementCatalog (через WithElementCatalog()), добавляем его данные
+    if ce.Edges.ElementCatalog != nil {
+        elem.ElementName = ce.Edges.ElementCatalog.Name
+        elem.Category = ce.Edges.ElementCatalog.Category
+    }
+
+    return elem
+}
+
 // ============================================================================
 // CRUD ДЛЯ CHECKLIST
 // ============================================================================
@@ -113,7 +147,7 @@ func (s *ChecklistService) CreateChecklist(ctx context.Context, req models.Creat
         if ent.IsConstraintError(err) {
             return nil, ErrChecklistConflict // Название уже существует
         }
-        log.Printf("DB error creating checklist: %v", err)
+        logger.Errorf("DB error creating checklist: %v", err)
         return nil, fmt.Errorf("database error")
     }
 
@@ -127,9 +161,16 @@ func (s *ChecklistService) ListChecklists(ctx context.Context) ([]*models.Checkl
         return nil, fmt.Errorf("database error")
     }
 
+    // Количество элементов — одним агрегирующим запросом, без N+1
+    counts, err := checklistElementCounts(ctx, s.Client)
+    if err != nil {
+        return nil, fmt.Errorf("database error")
+    }
+
     resp := make([]*models.ChecklistResponse, len(checklists))
     for i, c := range checklists {
         resp[i] = s.toChecklistResponse(c)
+        resp[i].ElementCount = counts[c.ID]
     }
 
     return resp, nil
@@ -256,13 +297,14 @@ func (s *ChecklistService) AddElementToChecklist(ctx context.Context, checklistI
         SetChecklistID(checklistID).
         SetElementID(req.ElementID).
         SetOrderIndex(orderIndex).
+        SetPhotoRequired(req.PhotoRequired).
         Save(ctx)
 
     if err != nil {
         if ent.IsConstraintError(err) {
             return ErrElementAlreadyInChecklist
         }
-        log.Printf("DB error adding element to checklist: %v", err)
+        logger.Errorf("DB error adding element to checklist: %v", err)
         return fmt.Errorf("database error")
     }
 
@@ -270,7 +312,22 @@ func (s *ChecklistService) AddElementToChecklist(ctx context.Context, checklistI
 }
 
 // RemoveElementFromChecklist — удаление элемента из чек-листа.
+// Элемент, по которому уже внесены результаты осмотра, не удаляется (ErrElementHasResults):
+// на него ссылаются акты прошлых осмотров.
 func (s *ChecklistService) RemoveElementFromChecklist(ctx context.Context, checklistID, elementID int) error {
+    used, err := s.Client.InspectionResult.Query().
+        Where(inspectionresult.HasChecklistElementWith(
+            checklistelement.ChecklistIDEQ(checklistID),
+            checklistelement.ElementIDEQ(elementID),
+        )).
+        Count(ctx)
+    if err != nil {
+        return fmt.Errorf("database error: %w", err)
+    }
+    if used > 0 {
+        return ErrElementHasResults
+    }
+
     // Удаление записи из ChecklistElement по композитному ключу
     deleted, err := s.Client.ChecklistElement.Delete().
         Where(
@@ -291,15 +348,20 @@ func (s *ChecklistService) RemoveElementFromChecklist(ctx context.Context, check
 }
 
 // UpdateElementOrder — изменение порядка элемента в чек-листе.
-func (s *ChecklistService) UpdateElementOrder(ctx context.Context, checklistID, elementID, newOrder int) error {
+// Если передан PhotoRequired, заодно меняется обязательность фото для элемента.
+func (s *ChecklistService) UpdateElementOrder(ctx context.Context, checklistID, elementID int, req models.UpdateElementOrderRequest) error {
     // Обновление order_index для конкретной записи ChecklistElement
-    updated, err := s.Client.ChecklistElement.Update().
+    update := s.Client.ChecklistElement.Update().
         Where(
             checklistelement.ChecklistIDEQ(checklistID),
             checklistelement.ElementIDEQ(elementID),
         ).
-        SetOrderIndex(newOrder).
-        Save(ctx)
+        SetOrderIndex(req.OrderIndex)
+    if req.PhotoRequired != nil {
+        update.SetPhotoRequired(*req.PhotoRequired)
+    }
+
+    updated, err := update.Save(ctx)
 
     if err != nil {
         return fmt.Errorf("database error: %w", err)
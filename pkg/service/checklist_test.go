@@ -3,9 +3,14 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	"jkh/ent"
+	"jkh/ent/checklist"
+	"jkh/ent/checklistelement"
+	"jkh/ent/inspectionresult"
 	"jkh/pkg/models"
 	"jkh/pkg/testutil"
 )
@@ -251,3 +256,107 @@ func TestChecklistService_RemoveElementFromChecklist_Success(t *testing.T) {
 		t.Errorf("Expected 0 elements, got %d", len(retrieved.Elements))
 	}
 }
+
+func TestChecklistService_RemoveElementFromChecklist_HasResults(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	ce := f.addElement(t, client, "Кровля", 1)
+	tk := f.createTask(t, client, "Осмотр")
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(ce.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+		SaveX(ctx)
+
+	checklistSvc := NewChecklistService(client)
+	err := checklistSvc.RemoveElementFromChecklist(ctx, f.Checklist.ID, ce.ElementID)
+	if err != ErrElementHasResults {
+		t.Fatalf("Expected ErrElementHasResults, got %v", err)
+	}
+
+	// Элемент остался в чек-листе
+	if !client.ChecklistElement.Query().Where(checklistelement.IDEQ(ce.ID)).ExistX(ctx) {
+		t.Error("Expected checklist element to be kept")
+	}
+}
+
+func TestChecklistService_ListChecklists_ElementCount(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	for i, name := range []string{"Фундамент", "Стены", "Кровля"} {
+		f.addElement(t, client, name, i+1)
+	}
+	empty := client.Checklist.Create().SetTitle("Пустой").SaveX(ctx)
+
+	resp, err := NewChecklistService(client).ListChecklists(ctx)
+	if err != nil {
+		t.Fatalf("ListChecklists failed: %v", err)
+	}
+
+	counts := map[int]int{}
+	for _, c := range resp {
+		counts[c.ID] = c.ElementCount
+	}
+	if counts[f.Checklist.ID] != 3 {
+		t.Errorf("Expected 3 elements, got %d", counts[f.Checklist.ID])
+	}
+	if counts[empty.ID] != 0 {
+		t.Errorf("Expected 0 elements in empty checklist, got %d", counts[empty.ID])
+	}
+}
+
+func TestChecklistService_BlankForm_AllElementsAsRows(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	// Порядок добавления отличается от порядка проверки
+	f.addElement(t, client, "Кровля", 3)
+	f.addElement(t, client, "Фундамент", 1)
+	f.addElement(t, client, "Стены", 2)
+
+	svc := NewChecklistService(client)
+	svc.Layout.FontDir = "../../storage/fonts" // шрифты лежат в корне репозитория
+
+	c := client.Checklist.Query().
+		Where(checklist.IDEQ(f.Checklist.ID)).
+		WithElements(func(q *ent.ChecklistElementQuery) { q.WithElementCatalog() }).
+		OnlyX(ctx)
+
+	rows := blankFormRows(c)
+	expected := []string{"Фундамент", "Стены", "Кровля"}
+	if len(rows) != len(expected) {
+		t.Fatalf("Expected %d rows, got %d", len(expected), len(rows))
+	}
+	for i, name := range expected {
+		if rows[i].ElementName != name || rows[i].Status != "" || rows[i].Comment != "" {
+			t.Errorf("Expected blank row %d for %s, got %+v", i+1, name, rows[i])
+		}
+	}
+
+	pdf, err := svc.renderBlankForm(c)
+	if err != nil {
+		t.Fatalf("renderBlankForm failed: %v", err)
+	}
+	pdf.SetCompression(false)
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+	for _, name := range expected {
+		if !bytes.Contains(buf.Bytes(), utf16be(name)) {
+			t.Errorf("Expected element %s in blank form PDF", name)
+		}
+	}
+
+	if _, _, err := svc.BlankFormPDF(ctx, 99999); err != ErrChecklistNotFound {
+		t.Errorf("Expected ErrChecklistNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+// pkg/service/checklistform.go
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"jkh/ent"
+	"jkh/ent/checklist"
+	"jkh/ent/checklistelement"
+	"jkh/ent/inspectionact"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// ============================================================================
+// БЛАНК ОСМОТРА (PDF)
+// ============================================================================
+
+// BlankFormPDF — пустой бланк осмотра по чек-листу для работы на бумаге (там, где нет связи):
+// шапка с полями для заполнения и элементы в порядке проверки с пустыми колонками статуса и примечания.
+func (s *ChecklistService) BlankFormPDF(ctx context.Context, checklistID int) ([]byte, string, error) {
+	c, err := s.Client.Checklist.Query().
+		Where(checklist.IDEQ(checklistID)).
+		WithElements(func(q *ent.ChecklistElementQuery) {
+			q.WithElementCatalog().
+				Order(ent.Asc(checklistelement.FieldOrderIndex))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", ErrChecklistNotFound
+		}
+		return nil, "", fmt.Errorf("database error: %w", err)
+	}
+
+	pdf, err := s.renderBlankForm(c)
+	if err != nil {
+		return nil, "", err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := pdf.Output(buf); err != nil {
+		return nil, "", fmt.Errorf("failed to generate PDF: %w", err)
+	}
+
+	filename := fmt.Sprintf("blank_form_checklist_%d.pdf", c.ID)
+	return buf.Bytes(), filename, nil
+}
+
+// blankFormRows — строки бланка: элементы чек-листа в порядке проверки, без статуса и примечания.
+func blankFormRows(c *ent.Checklist) []actResultRow {
+	elements := append([]*ent.ChecklistElement(nil), c.Edges.Elements...)
+	sort.SliceStable(elements, func(i, j int) bool {
+		return elements[i].OrderIndex < elements[j].OrderIndex
+	})
+
+	rows := make([]actResultRow, len(elements))
+	for i, ce := range elements {
+		rows[i] = actResultRow{ElementName: elementDisplayName(ce.Edges.ElementCatalog, inspectionact.LanguageRu)}
+	}
+	return rows
+}
+
+// renderBlankForm — вёрстка бланка (шрифты и таблица — как в акте осмотра).
+func (s *ChecklistService) renderBlankForm(c *ent.Checklist) (*gofpdf.Fpdf, error) {
+	pdf, err := newPDFDocument(s.Layout, "Бланк осмотра: "+c.Title)
+	if err != nil {
+		return nil, err
+	}
+	pdf.AddPage()
+
+	// Заголовок
+	pdf.SetFont("Times", "B", 16)
+	pdf.CellFormat(0, 10, "БЛАНК ОСМОТРА ЗДАНИЯ", "", 0, "C", false, 0, "")
+	pdf.Ln(13)
+
+	pdf.SetFont("Times", "", 11)
+	pdf.CellFormat(55, 6, "Чек-лист:", "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 6, c.Title, "", 0, "L", false, 0, "")
+	pdf.Ln(6)
+
+	pdf.CellFormat(55, 6, "Тип осмотра:", "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 6, string(c.InspectionType), "", 0, "L", false, 0, "")
+	pdf.Ln(6)
+
+	// Поля для заполнения от руки
+	for _, label := range []string{"Адрес здания:", "Дата осмотра:", "Инспектор:"} {
+		pdf.CellFormat(55, 8, label, "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 8, "", "B", 0, "L", false, 0, "")
+		pdf.Ln(8)
+	}
+
+	pdf.Ln(5)
+
+	// Таблица элементов: статус и примечание заполняются вручную
+	pdf.SetFont("Times", "B", 12)
+	pdf.CellFormat(0, 8, "РЕЗУЛЬТАТЫ ОСМОТРА", "", 0, "L", false, 0, "")
+	pdf.Ln(8)
+	pdf.SetFont("Times", "", 9)
+
+	drawResultTableHeader(pdf)
+	for i, row := range blankFormRows(c) {
+		drawResultTableRow(pdf, 10, i+1, row, false)
+	}
+
+	pdf.Ln(4)
+	pdf.SetFont("Times", "", 9)
+	pdf.MultiCell(0, 5, "Статус: Исправное / Удовлетворительное / Неудовлетворительное / Аварийное / Неприменимо", "", "L", false)
+	pdf.Ln(8)
+
+	// Подпись
+	pdf.SetFont("Times", "", 10)
+	pdf.CellFormat(90, 6, "Подпись инспектора: ____________________", "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 6, "Дата: ______________", "", 1, "L", false, 0, "")
+
+	return pdf, nil
+}
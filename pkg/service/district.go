@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 
 	"jkh/ent"
 	"jkh/ent/district"
+	"jkh/pkg/logger"
 	"jkh/pkg/models"
 )
 
@@ -29,8 +29,9 @@ func NewDistrictService(client *ent.Client) *DistrictService {
 // Преобразование Ent-сущности в DTO
 func (s *DistrictService) toDistrictResponse(d *ent.District) *models.DistrictResponse {
 	return &models.DistrictResponse{
-		ID:   d.ID,
-		Name: d.Name,
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
 	}
 }
 
@@ -38,12 +39,13 @@ func (s *DistrictService) toDistrictResponse(d *ent.District) *models.DistrictRe
 func (s *DistrictService) CreateDistrict(ctx context.Context, req models.CreateDistrictRequest) (*models.DistrictResponse, error) {
 	d, err := s.Client.District.Create().
 		SetName(req.Name).
+		SetNillableDescription(req.Description).
 		Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
 			return nil, ErrDistrictConflict
 		}
-		log.Printf("DB error creating district: %v", err)
+		logger.Errorf("DB error creating district: %v", err)
 		return nil, fmt.Errorf("database error")
 	}
 	return s.toDistrictResponse(d), nil
@@ -53,7 +55,7 @@ func (s *DistrictService) CreateDistrict(ctx context.Context, req models.CreateD
 func (s *DistrictService) ListDistricts(ctx context.Context) ([]*models.DistrictResponse, error) {
 	districts, err := s.Client.District.Query().All(ctx)
 	if err != nil {
-		log.Printf("DB error listing districts: %v", err)
+		logger.Errorf("DB error listing districts: %v", err)
 		return nil, fmt.Errorf("database error")
 	}
 
@@ -73,7 +75,7 @@ func (s *DistrictService) RetrieveDistrict(ctx context.Context, id int) (*models
 		if ent.IsNotFound(err) {
 			return nil, ErrDistrictNotFound
 		}
-		log.Printf("DB error retrieving district %d: %v", id, err)
+		logger.Errorf("DB error retrieving district %d: %v", id, err)
 		return nil, fmt.Errorf("database error")
 	}
 	return s.toDistrictResponse(d), nil
@@ -81,9 +83,16 @@ func (s *DistrictService) RetrieveDistrict(ctx context.Context, id int) (*models
 
 // UpdateDistrict — обновление района
 func (s *DistrictService) UpdateDistrict(ctx context.Context, id int, req models.CreateDistrictRequest) (*models.DistrictResponse, error) {
-	d, err := s.Client.District.UpdateOneID(id).
-		SetName(req.Name).
-		Save(ctx)
+	update := s.Client.District.UpdateOneID(id).
+		SetName(req.Name)
+
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	} else {
+		update.ClearDescription()
+	}
+
+	d, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, ErrDistrictNotFound
@@ -91,7 +100,7 @@ func (s *DistrictService) UpdateDistrict(ctx context.Context, id int, req models
 		if ent.IsConstraintError(err) {
 			return nil, ErrDistrictConflict
 		}
-		log.Printf("DB error updating district %d: %v", id, err)
+		logger.Errorf("DB error updating district %d: %v", id, err)
 		return nil, fmt.Errorf("database error")
 	}
 	return s.toDistrictResponse(d), nil
@@ -107,7 +116,7 @@ func (s *DistrictService) DeleteDistrict(ctx context.Context, id int) error {
 		if ent.IsConstraintError(err) {
 			return errors.New("district has active dependencies (JKH units or buildings)")
 		}
-		log.Printf("DB error deleting district %d: %v", id, err)
+		logger.Errorf("DB error deleting district %d: %v", id, err)
 		return fmt.Errorf("database error")
 	}
 	return nil
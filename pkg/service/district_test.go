@@ -184,3 +184,39 @@ func TestDistrictService_DeleteDistrict_NotFound(t *testing.T) {
 	}
 }
 
+
+func TestDistrictService_Description_SetAndClear(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	svc := NewDistrictService(client)
+	ctx := context.Background()
+
+	desc := "Границы: ул. Ленина — р. Кама"
+	created, err := svc.CreateDistrict(ctx, models.CreateDistrictRequest{Name: "Центральный", Description: &desc})
+	if err != nil {
+		t.Fatalf("CreateDistrict failed: %v", err)
+	}
+	if created.Description != desc {
+		t.Errorf("Expected description %q, got %q", desc, created.Description)
+	}
+
+	// Уникально только название — одинаковое описание допустимо
+	if _, err := svc.CreateDistrict(ctx, models.CreateDistrictRequest{Name: "Северный", Description: &desc}); err != nil {
+		t.Fatalf("Expected duplicate description to be allowed, got %v", err)
+	}
+
+	// nil при обновлении очищает описание
+	updated, err := svc.UpdateDistrict(ctx, created.ID, models.CreateDistrictRequest{Name: "Центральный"})
+	if err != nil {
+		t.Fatalf("UpdateDistrict failed: %v", err)
+	}
+	if updated.Description != "" {
+		t.Errorf("Expected description to be cleared, got %q", updated.Description)
+	}
+
+	stored, _ := svc.RetrieveDistrict(ctx, created.ID)
+	if stored.Description != "" {
+		t.Errorf("Expected stored description to be cleared, got %q", stored.Description)
+	}
+}
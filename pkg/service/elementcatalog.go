@@ -4,10 +4,10 @@ import (
     "context"
     "errors"
     "fmt"
-    "log"
 
     "jkh/ent"
     "jkh/ent/elementcatalog" // Сгенерированный Ent-пакет для работы с ElementCatalog
+    "jkh/pkg/logger"
     "jkh/pkg/models"
 )
 
@@ -50,10 +50,30 @@ func (s *ElementCatalogService) toElementResponse(e *ent.ElementCatalog) *models
     return &models.ElementCatalogResponse{
         ID:       e.ID,
         Name:     e.Name,
+        NameEn:   e.NameEn,
         Category: e.Category, // Ent возвращает пустую строку, если поле было NULL
     }
 }
 
+// newElementCreate — билдер создания элемента по DTO (используется и для одиночного, и для пакетного создания).
+func newElementCreate(c *ent.ElementCatalogClient, req models.CreateElementCatalogRequest) *ent.ElementCatalogCreate {
+    create := c.Create().
+        SetName(req.Name) // Обязательное поле
+
+    // Если категория передана (не nil), устанавливаем её
+    if req.Category != nil {
+        create.SetCategory(*req.Category)
+    }
+    // Если req.Category == nil, Ent установит значение по умолчанию (пустая строка)
+
+    // Перевод названия (опционально)
+    if req.NameEn != nil && *req.NameEn != "" {
+        create.SetNameEn(*req.NameEn)
+    }
+
+    return create
+}
+
 // ============================================================================
 // CRUD-ОПЕРАЦИИ
 // ============================================================================
@@ -68,25 +88,15 @@ func (s *ElementCatalogService) toElementResponse(e *ent.ElementCatalog) *models
 //   - *models.ElementCatalogResponse: созданный элемент с ID
 //   - error: ошибка (ErrElementConflict, если имя уже существует)
 func (s *ElementCatalogService) CreateElement(ctx context.Context, req models.CreateElementCatalogRequest) (*models.ElementCatalogResponse, error) {
-    // Инициализация билдера для создания записи в БД
-    create := s.Client.ElementCatalog.Create().
-        SetName(req.Name) // Обязательное поле
-
-    // Если категория передана (не nil), устанавливаем её
-    if req.Category != nil {
-        create.SetCategory(*req.Category)
-    }
-    // Если req.Category == nil, Ent установит значение по умолчанию (пустая строка)
-
     // Выполнение запроса к БД
-    e, err := create.Save(ctx)
+    e, err := newElementCreate(s.Client.ElementCatalog, req).Save(ctx)
     if err != nil {
         // Проверка на ошибку уникальности (UNIQUE constraint violation)
         if ent.IsConstraintError(err) {
             return nil, ErrElementConflict
         }
         // Логируем внутреннюю ошибку БД для отладки
-        log.Printf("DB error creating element: %v", err)
+        logger.Errorf("DB error creating element: %v", err)
         return nil, fmt.Errorf("database error")
     }
 
@@ -94,6 +104,84 @@ func (s *ElementCatalogService) CreateElement(ctx context.Context, req models.Cr
     return s.toElementResponse(e), nil
 }
 
+// BulkCreate — пакетное создание элементов справочника (например, при первичном наполнении).
+//
+// Элементы, название которых уже есть в справочнике или повторяется внутри пакета,
+// не создаются и отмечаются в отчёте. Остальные создаются в одной транзакции.
+// Если atomic == true, при любом конфликте не создаётся ни один элемент.
+//
+// Возвращает:
+//   - *models.BulkCreateElementsResponse: построчный отчёт (созданный элемент или ошибка)
+//   - error: ошибка БД
+func (s *ElementCatalogService) BulkCreate(ctx context.Context, reqs []models.CreateElementCatalogRequest, atomic bool) (*models.BulkCreateElementsResponse, error) {
+    resp := &models.BulkCreateElementsResponse{
+        Results: make([]models.BulkElementResult, len(reqs)),
+    }
+
+    // 1. Названия, уже существующие в справочнике
+    names := make([]string, len(reqs))
+    for i, req := range reqs {
+        names[i] = req.Name
+    }
+    existing, err := s.Client.ElementCatalog.Query().
+        Where(elementcatalog.NameIn(names...)).
+        Select(elementcatalog.FieldName).
+        Strings(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("database error: %w", err)
+    }
+
+    taken := make(map[string]bool, len(existing))
+    for _, name := range existing {
+        taken[name] = true
+    }
+
+    // 2. Построчная проверка конфликтов (включая повторы внутри пакета)
+    for i, req := range reqs {
+        resp.Results[i] = models.BulkElementResult{Index: i, Name: req.Name}
+        if taken[req.Name] {
+            resp.Results[i].Error = ErrElementConflict.Error()
+            resp.Failed++
+            continue
+        }
+        taken[req.Name] = true
+    }
+
+    if resp.Failed > 0 && atomic {
+        return resp, nil
+    }
+
+    // 3. Создание остальных элементов в одной транзакции
+    tx, err := s.Client.Tx(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("starting transaction: %w", err)
+    }
+
+    for i, req := range reqs {
+        if resp.Results[i].Error != "" {
+            continue
+        }
+        e, err := newElementCreate(tx.ElementCatalog, req).Save(ctx)
+        if err != nil {
+            tx.Rollback()
+            // Название заняли параллельным запросом — весь пакет откатывается
+            if ent.IsConstraintError(err) {
+                return nil, ErrElementConflict
+            }
+            logger.Errorf("DB error bulk creating elements: %v", err)
+            return nil, fmt.Errorf("database error")
+        }
+        resp.Results[i].Element = s.toElementResponse(e)
+    }
+
+    if err := tx.Commit(); err != nil {
+        return nil, fmt.Errorf("committing transaction: %w", err)
+    }
+
+    resp.Created = len(reqs) - resp.Failed
+    return resp, nil
+}
+
 // ListElements — получение списка всех элементов справочника.
 //
 // Возвращает:
@@ -163,6 +251,13 @@ func (s *ElementCatalogService) UpdateElement(ctx context.Context, id int, req m
         update.ClearCategory() // Очищаем поле (устанавливаем пустую строку)
     }
 
+    // Перевод названия: пустое значение или его отсутствие очищает поле
+    if req.NameEn != nil && *req.NameEn != "" {
+        update.SetNameEn(*req.NameEn)
+    } else {
+        update.ClearNameEn()
+    }
+
     // Выполнение запроса
     e, err := update.Save(ctx)
     if err != nil {
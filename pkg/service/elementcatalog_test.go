@@ -172,3 +172,69 @@ func TestElementCatalogService_DeleteElement_NotFound(t *testing.T) {
 		t.Errorf("Expected ErrElementNotFound, got %v", err)
 	}
 }
+
+func TestElementCatalogService_BulkCreate_ReportsDuplicate(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	svc := NewElementCatalogService(client)
+	ctx := context.Background()
+
+	if _, err := svc.CreateElement(ctx, models.CreateElementCatalogRequest{Name: "Кровля"}); err != nil {
+		t.Fatalf("CreateElement failed: %v", err)
+	}
+
+	reqs := []models.CreateElementCatalogRequest{
+		{Name: "Фундамент", Category: ptr("Несущие конструкции")},
+		{Name: "Стены"},
+		{Name: "Кровля"}, // уже есть в справочнике
+		{Name: "Окна"},
+		{Name: "Двери"},
+	}
+
+	resp, err := svc.BulkCreate(ctx, reqs, false)
+	if err != nil {
+		t.Fatalf("BulkCreate failed: %v", err)
+	}
+
+	if resp.Created != 4 || resp.Failed != 1 {
+		t.Errorf("Expected 4 created and 1 failed, got %d/%d", resp.Created, resp.Failed)
+	}
+	if resp.Results[2].Error == "" || resp.Results[2].Element != nil {
+		t.Errorf("Expected duplicate 'Кровля' to be reported, got %+v", resp.Results[2])
+	}
+	if resp.Results[0].Element == nil || resp.Results[0].Element.Category != "Несущие конструкции" {
+		t.Errorf("Expected 'Фундамент' to be created with category, got %+v", resp.Results[0])
+	}
+
+	if count := client.ElementCatalog.Query().CountX(ctx); count != 5 {
+		t.Errorf("Expected 5 elements in catalog, got %d", count)
+	}
+}
+
+func TestElementCatalogService_BulkCreate_AtomicAbortsOnDuplicate(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	svc := NewElementCatalogService(client)
+	ctx := context.Background()
+
+	// Повтор внутри пакета
+	reqs := []models.CreateElementCatalogRequest{
+		{Name: "Фундамент"},
+		{Name: "Стены"},
+		{Name: "Фундамент"},
+	}
+
+	resp, err := svc.BulkCreate(ctx, reqs, true)
+	if err != nil {
+		t.Fatalf("BulkCreate failed: %v", err)
+	}
+
+	if resp.Created != 0 || resp.Failed != 1 || resp.Results[2].Error == "" {
+		t.Errorf("Expected nothing created and the repeat reported, got %+v", resp)
+	}
+	if count := client.ElementCatalog.Query().CountX(ctx); count != 0 {
+		t.Errorf("Expected empty catalog, got %d elements", count)
+	}
+}
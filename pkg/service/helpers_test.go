@@ -0,0 +1,90 @@
+// pkg/service/helpers_test.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"jkh/ent"
+	"jkh/ent/role"
+)
+
+// taskFixture — минимальный набор связанных сущностей для тестов заданий:
+// район, ЖЭУ, здание, чек-лист, инспектор (закреплён за ЖЭУ) и координатор.
+type taskFixture struct {
+	District    *ent.District
+	JkhUnit     *ent.JkhUnit
+	Building    *ent.Building
+	Checklist   *ent.Checklist
+	Inspector   *ent.User
+	Coordinator *ent.User
+}
+
+// newTaskFixture создаёт сущности, необходимые для создания заданий.
+func newTaskFixture(t *testing.T, client *ent.Client) *taskFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	f := &taskFixture{}
+	f.District = client.District.Create().SetName("Центральный").SaveX(ctx)
+	f.JkhUnit = client.JkhUnit.Create().SetName("ЖЭУ-1").SetDistrictID(f.District.ID).SaveX(ctx)
+	f.Building = client.Building.Create().
+		SetAddress("ул. Тестовая, д. 1").
+		SetDistrictID(f.District.ID).
+		SetJkhUnitID(f.JkhUnit.ID).
+		SaveX(ctx)
+	f.Checklist = client.Checklist.Create().SetTitle("Весенний осмотр").SaveX(ctx)
+	f.Inspector = createTestUser(t, client, "Inspector", "inspector")
+	f.Coordinator = createTestUser(t, client, "Coordinator", "coordinator")
+
+	client.InspectorUnit.Create().
+		SetUserID(f.Inspector.ID).
+		SetJkhUnitID(f.JkhUnit.ID).
+		SaveX(ctx)
+
+	return f
+}
+
+// createTestUser создаёт пользователя с указанной ролью (роль должна существовать).
+func createTestUser(t *testing.T, client *ent.Client, roleName, login string) *ent.User {
+	t.Helper()
+	ctx := context.Background()
+
+	r := client.Role.Query().Where(role.NameEQ(roleName)).OnlyX(ctx)
+	return client.User.Create().
+		SetEmail(fmt.Sprintf("%s@example.com", login)).
+		SetLogin(login).
+		SetPasswordHash("hash").
+		SetFirstName("Имя " + login).
+		SetLastName("Фамилия " + login).
+		SetRoleID(r.ID).
+		SaveX(ctx)
+}
+
+// createTask создаёт задание в статусе New для здания и инспектора из фикстуры.
+func (f *taskFixture) createTask(t *testing.T, client *ent.Client, title string) *ent.Task {
+	t.Helper()
+	return client.Task.Create().
+		SetBuildingID(f.Building.ID).
+		SetChecklistID(f.Checklist.ID).
+		SetInspectorID(f.Inspector.ID).
+		SetTitle(title).
+		SetScheduledDate(time.Now().Add(24 * time.Hour)).
+		SaveX(context.Background())
+}
+
+// addElement добавляет элемент справочника в чек-лист фикстуры.
+func (f *taskFixture) addElement(t *testing.T, client *ent.Client, name string, order int) *ent.ChecklistElement {
+	t.Helper()
+	ctx := context.Background()
+
+	e := client.ElementCatalog.Create().SetName(name).SaveX(ctx)
+	return client.ChecklistElement.Create().
+		SetChecklistID(f.Checklist.ID).
+		SetElementID(e.ID).
+		SetOrderIndex(order).
+		SaveX(ctx)
+}
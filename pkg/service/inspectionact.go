@@ -7,14 +7,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"jkh/ent"
 	"jkh/ent/inspectionact"
 	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/pkg/logger"
+	"jkh/pkg/models"
 
 	"github.com/jung-kurt/gofpdf"
 )
@@ -24,7 +29,9 @@ import (
 // ============================================================================
 
 var (
-	ErrActNotFound = errors.New("inspection act not found")
+	ErrActNotFound        = errors.New("inspection act not found")
+	ErrInvalidActLanguage = errors.New("invalid act language")
+	ErrInvalidActVariant  = errors.New("invalid act variant")
 )
 
 // ============================================================================
@@ -33,17 +40,24 @@ var (
 
 type InspectionActService struct {
 	Client      *ent.Client
-	StoragePath string // Путь для сохранения PDF (например, "storage/acts")
+	StoragePath string    // Путь для сохранения PDF (например, "storage/acts")
+	Layout      PDFLayout // Поля страницы и каталог шрифтов
+	LinkSecret  []byte    // Ключ подписи ссылок на скачивание акта без JWT
+
+	// ActNumberPrefix — префикс номера акта, присваиваемого при утверждении.
+	ActNumberPrefix string
 }
 
 func NewInspectionActService(client *ent.Client, storagePath string) *InspectionActService {
 	// Создаём директорию, если её нет
 	if err := os.MkdirAll(storagePath, 0755); err != nil {
-		log.Printf("failed to create storage directory %s: %v", storagePath, err)
+		logger.Errorf("failed to create storage directory %s: %v", storagePath, err)
 	}
 	return &InspectionActService{
 		Client:      client,
 		StoragePath: storagePath,
+		Layout:      DefaultPDFLayout(),
+		LinkSecret:  randomLinkSecret(),
 	}
 }
 
@@ -52,8 +66,10 @@ func NewInspectionActService(client *ent.Client, storagePath string) *Inspection
 // ============================================================================
 
 // CreateOrUpdateAct — создаёт или обновляет запись акта для задания.
-// Вызывается, когда инспектор отправляет задание на проверку (InProgress → OnReview).
-func (s *InspectionActService) CreateOrUpdateAct(ctx context.Context, taskID int, conclusion string) (*ent.InspectionAct, error) {
+// Вызывается, когда инспектор отправляет задание на проверку (InProgress → OnReview)
+// со статусом "создан", а при включённом TaskService.AutoDraftAct — ещё и при начале
+// работы (Pending → InProgress) со статусом "черновик".
+func (s *InspectionActService) CreateOrUpdateAct(ctx context.Context, taskID int, conclusion, status string) (*ent.InspectionAct, error) {
 	// Проверяем, есть ли уже акт для этого задания
 	act, err := s.Client.InspectionAct.Query().
 		Where(inspectionact.TaskIDEQ(taskID)).
@@ -64,9 +80,14 @@ func (s *InspectionActService) CreateOrUpdateAct(ctx context.Context, taskID int
 	}
 
 	if act != nil {
-        //  Просто обновляем conclusion, PDF не трогаем
+        // Обновляем conclusion и статус; сохранённый PDF черновика сбрасываем,
+        // чтобы предпросмотр не показывал устаревшее заключение
+        if err := s.InvalidateDraftPDF(ctx, taskID); err != nil {
+            logger.Warnf("failed to invalidate draft PDF for task %d: %v", taskID, err)
+        }
         act, err = s.Client.InspectionAct.UpdateOne(act).
             SetConclusion(conclusion).
+            SetStatus(status).
             Save(ctx)
         if err != nil {
             return nil, fmt.Errorf("failed to update inspection act: %w", err)
@@ -77,20 +98,21 @@ func (s *InspectionActService) CreateOrUpdateAct(ctx context.Context, taskID int
 	// Создаём новый акт
 	act, err = s.Client.InspectionAct.Create().
 		SetTaskID(taskID).
-		SetStatus("создан").
+		SetStatus(status).
 		SetConclusion(conclusion).
 		Save(ctx)
 
 	if err != nil {
-		log.Printf("DB error creating inspection act: %v", err)
+		logger.Errorf("DB error creating inspection act: %v", err)
 		return nil, fmt.Errorf("database error")
 	}
 
 	return act, nil
 }
 
-// ApproveAct — Перегенерировать PDF с датой утверждения
-func (s *InspectionActService) ApproveAct(ctx context.Context, taskID int) error {
+// ApproveAct — Перегенерировать PDF с датой утверждения.
+// approvalComment (опционально) сохраняется на акте и печатается в заключении.
+func (s *InspectionActService) ApproveAct(ctx context.Context, taskID int, approvalComment *string) error {
     //1. Загружаем акт со всеми связями
 	act, err := s.Client.InspectionAct.Query().
         Where(inspectionact.TaskIDEQ(taskID)).
@@ -103,6 +125,7 @@ func (s *InspectionActService) ApproveAct(ctx context.Context, taskID int) error
                     ceq.WithElementCatalog()
                 })
             }).
+            WithExtraChecklists(withExtraChecklistElements).
             WithInspector()
         }).
         Only(ctx)
@@ -116,26 +139,23 @@ func (s *InspectionActService) ApproveAct(ctx context.Context, taskID int) error
 
     now := time.Now()
     
-    // 2. Обновляем статус, дату И заключение в БД
-    _, err = s.Client.InspectionAct.UpdateOne(act).
-        SetApprovedAt(now).
-        SetStatus("утверждён").
-        SetConclusion("Акт осмотра утверждён координатором.").  // ← ✅ ДОБАВЛЕНО!
-        Save(ctx)
-        
+    // 2. Обновляем статус, дату, заключение и номер акта в БД (в одной транзакции со счётчиком)
+    updated, err := s.approveActRecord(ctx, act, now, "Акт осмотра утверждён координатором.", approvalComment)
     if err != nil {
-        return fmt.Errorf("failed to approve inspection act: %w", err)
+        return err
     }
 
 	// 3. Обновляем act вручную (для generatePDF)
 	act.ApprovedAt = now
     act.Status = "утверждён"
     act.Conclusion = "Акт осмотра утверждён координатором."
+    act.ApprovalComment = approvalComment
+    act.ActNumber = updated.ActNumber
 
     // 4. Удаляем старый PDF (черновик)
     if act.DocumentPath != "" {
         if err := os.Remove(act.DocumentPath); err != nil {
-            log.Printf("failed to delete draft PDF: %v", err)
+            logger.Warnf("failed to delete draft PDF: %v", err)
         }
     }
 
@@ -148,21 +168,21 @@ func (s *InspectionActService) ApproveAct(ctx context.Context, taskID int) error
         All(ctx)
 
     if err != nil {
-        log.Printf("failed to fetch results for approved PDF: %v", err)
+        logger.Errorf("failed to fetch results for approved PDF: %v", err)
         return nil // Не критично, основная задача выполнена
     }
 
     // 6. Генерируем ФИНАЛЬНЫЙ утверждённый PDF
-    pdfData, filename, err := s.generatePDF(act, results)
+    pdfData, filename, err := s.generatePDF(act, results, ActVariantOfficial)
     if err != nil {
-        log.Printf("failed to generate approved PDF: %v", err)
+        logger.Errorf("failed to generate approved PDF: %v", err)
         return nil // Не критично
     }
 
     // 7. Сохраняем финальный PDF
     fullPath := filepath.Join(s.StoragePath, filename)
     if err := os.WriteFile(fullPath, pdfData, 0644); err != nil {
-        log.Printf("failed to save approved PDF: %v", err)
+        logger.Errorf("failed to save approved PDF: %v", err)
         return nil
     }
 
@@ -171,43 +191,360 @@ func (s *InspectionActService) ApproveAct(ctx context.Context, taskID int) error
         SetDocumentPath(fullPath).
         Save(ctx)
     if err != nil {
-        log.Printf("failed to update document_path: %v", err)
+        logger.Errorf("failed to update document_path: %v", err)
     }
 
-    log.Printf("Approved PDF generated for task %d", taskID)
+    logger.Infof("Approved PDF generated for task %d", taskID)
     return nil
 }
 
 
+// InvalidateDraftPDF — сброс сохранённого PDF неутверждённого акта (статус "черновик" или "создан"),
+// чтобы при следующем скачивании акт был сформирован заново с актуальными данными.
+// Утверждённые акты не изменяются.
+func (s *InspectionActService) InvalidateDraftPDF(ctx context.Context, taskID int) error {
+	act, err := s.Client.InspectionAct.Query().
+		Where(inspectionact.TaskIDEQ(taskID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil // Акта ещё нет — сбрасывать нечего
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	if (act.Status != "черновик" && act.Status != "создан") || act.DocumentPath == "" {
+		return nil
+	}
+
+	if err := s.Client.InspectionAct.UpdateOne(act).ClearDocumentPath().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear document_path: %w", err)
+	}
+
+	if err := os.Remove(act.DocumentPath); err != nil && !os.IsNotExist(err) {
+		logger.Warnf("failed to delete draft PDF %s: %v", act.DocumentPath, err)
+	}
+
+	return nil
+}
+
 // ============================================================================
-// ГЕНЕРАЦИЯ / ВОЗВРАТ PDF
+// АУДИТ (ЦЕПОЧКА СОГЛАСОВАНИЯ)
 // ============================================================================
 
-// GeneratePDFForAct — генерирует PDF (если нужно) и возвращает []byte + имя файла.
-// Если document_path уже заполнен и файл существует — просто читает его.
-func (s *InspectionActService) GeneratePDFForAct(ctx context.Context, taskID int) ([]byte, string, error) {
-	// 1. Получаем акт вместе с задачей
+// GetActAudit — структурированная запись о согласовании акта: кто отправил,
+// кто утвердил, отметки времени, номер акта, заключение и история статусов задания.
+func (s *InspectionActService) GetActAudit(ctx context.Context, taskID int) (*models.ActAuditResponse, error) {
 	act, err := s.Client.InspectionAct.Query().
 		Where(inspectionact.TaskIDEQ(taskID)).
 		WithTask(func(tq *ent.TaskQuery) {
-			tq.
-				WithBuilding(func(bq *ent.BuildingQuery) {
-					bq.WithDistrict().WithJkhUnit()
-				}).
-				WithChecklist(func(cq *ent.ChecklistQuery) {
-					cq.WithElements(func(ceq *ent.ChecklistElementQuery) {
-						ceq.WithElementCatalog()
-					})
-				}).
-				WithInspector()
+			tq.WithInspector()
 		}).
 		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrActNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
 
+	history, err := queryStatusHistory(ctx, s.Client, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	resp := &models.ActAuditResponse{
+		ActID:      act.ID,
+		ActNumber:  actNumber(act),
+		TaskID:     act.TaskID,
+		Status:     act.Status,
+		Language:   string(act.Language),
+		Conclusion: act.Conclusion,
+		CreatedAt:  act.CreatedAt.Format(time.RFC3339),
+
+		ApprovalComment: act.ApprovalComment,
+		History:    make([]models.StatusHistoryEntry, 0, len(history)),
+	}
+	if act.Edges.Task != nil {
+		resp.TaskTitle = act.Edges.Task.Title
+	}
+
+	// Последние переходы в OnReview / Approved определяют отправителя и утвердившего
+	for _, h := range history {
+		resp.History = append(resp.History, toStatusHistoryEntry(h))
+
+		changedAt := h.ChangedAt.Format(time.RFC3339)
+		switch task.Status(h.ToStatus) {
+		case task.StatusOnReview:
+			resp.SubmittedBy = toActorInfo(h.Edges.ChangedByUser)
+			resp.SubmittedAt = &changedAt
+		case task.StatusApproved:
+			resp.ApprovedBy = toActorInfo(h.Edges.ChangedByUser)
+			resp.ApprovedAt = &changedAt
+		}
+	}
+
+	// Акты, созданные до появления истории статусов: акт создаётся при отправке
+	// на проверку, поэтому отправителем считаем назначенного инспектора.
+	if resp.SubmittedAt == nil {
+		submittedAt := act.CreatedAt.Format(time.RFC3339)
+		resp.SubmittedAt = &submittedAt
+		if act.Edges.Task != nil {
+			resp.SubmittedBy = toActorInfo(act.Edges.Task.Edges.Inspector)
+		}
+	}
+	if !act.ApprovedAt.IsZero() {
+		approvedAt := act.ApprovedAt.Format(time.RFC3339)
+		resp.ApprovedAt = &approvedAt
+	}
+
+	return resp, nil
+}
+
+// actNumber — номер акта, печатаемый в PDF и отдаваемый в API.
+// До утверждения (и у старых актов без номера) используется ID акта.
+func actNumber(act *ent.InspectionAct) string {
+	if act.ActNumber != nil && *act.ActNumber != "" {
+		return *act.ActNumber
+	}
+	return fmt.Sprintf("%d", act.ID)
+}
+
+// ============================================================================
+// ЯЗЫК АКТА
+// ============================================================================
+
+// SetActLanguage — смена языка акта ("ru" / "en").
+// Сохранённый PDF сбрасывается, чтобы при следующем скачивании акт был сформирован на выбранном языке.
+func (s *InspectionActService) SetActLanguage(ctx context.Context, taskID int, lang string) error {
+	language := inspectionact.Language(lang)
+	if err := inspectionact.LanguageValidator(language); err != nil {
+		return ErrInvalidActLanguage
+	}
+
+	act, err := s.Client.InspectionAct.Query().
+		Where(inspectionact.TaskIDEQ(taskID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrActNotFound
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	if act.Language == language {
+		return nil
+	}
+
+	err = s.Client.InspectionAct.UpdateOne(act).
+		SetLanguage(language).
+		ClearDocumentPath().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update act language: %w", err)
+	}
+
+	if act.DocumentPath != "" {
+		if err := os.Remove(act.DocumentPath); err != nil && !os.IsNotExist(err) {
+			logger.Warnf("failed to delete PDF after language change: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// SetActIncludeUnfilled — печатать ли в акте все элементы чек-листов, включая неосмотренные.
+// Сохранённый PDF сбрасывается, чтобы при следующем скачивании таблица была сформирована заново.
+func (s *InspectionActService) SetActIncludeUnfilled(ctx context.Context, taskID int, include bool) error {
+	act, err := s.Client.InspectionAct.Query().
+		Where(inspectionact.TaskIDEQ(taskID)).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, "", ErrActNotFound
+			return ErrActNotFound
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	if act.IncludeUnfilled == include {
+		return nil
+	}
+
+	err = s.Client.InspectionAct.UpdateOne(act).
+		SetIncludeUnfilled(include).
+		ClearDocumentPath().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update act include_unfilled: %w", err)
+	}
+
+	if act.DocumentPath != "" {
+		if err := os.Remove(act.DocumentPath); err != nil && !os.IsNotExist(err) {
+			logger.Warnf("failed to delete PDF after include_unfilled change: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// actResultRow — строка таблицы результатов осмотра в PDF-акте.
+type actResultRow struct {
+	ElementName   string
+	Status        string
+	Comment       string
+	InternalNote  string // Печатается только во внутреннем варианте акта
+	NotApplicable bool   // Элемент отсутствует в здании — строка выделяется в акте
+	Unfilled      bool   // Результат по элементу не внесён (акт с IncludeUnfilled)
+}
+
+// unfilledStatusLabel — подпись статуса элемента без результата осмотра.
+const unfilledStatusLabel = "Не осмотрено"
+
+// conditionStatusLabel — подпись статуса состояния в акте.
+func conditionStatusLabel(status inspectionresult.ConditionStatus) string {
+	if status == inspectionresult.ConditionStatusНеприменимо {
+		return "Не применимо"
+	}
+	return string(status)
+}
+
+// elementDisplayName — название элемента на языке акта.
+// Если перевод не задан, используется основное название.
+func elementDisplayName(e *ent.ElementCatalog, lang inspectionact.Language) string {
+	if e == nil {
+		return ""
+	}
+	if lang == inspectionact.LanguageEn && e.NameEn != "" {
+		return e.NameEn
+	}
+	return e.Name
+}
+
+// actResultRows — строки таблицы результатов для акта (с учётом языка акта).
+func actResultRows(act *ent.InspectionAct, results []*ent.InspectionResult) []actResultRow {
+	rows := make([]actResultRow, len(results))
+	for i, r := range results {
+		rows[i] = actResultRowFor(act, r)
+	}
+	return rows
+}
+
+func actResultRowFor(act *ent.InspectionAct, r *ent.InspectionResult) actResultRow {
+	var elem *ent.ElementCatalog
+	if r.Edges.ChecklistElement != nil {
+		elem = r.Edges.ChecklistElement.Edges.ElementCatalog
+	}
+	return actResultRow{
+		ElementName:   elementDisplayName(elem, act.Language),
+		Status:        conditionStatusLabel(r.ConditionStatus),
+		Comment:       r.Comment,
+		InternalNote:  r.InternalNote,
+		NotApplicable: r.ConditionStatus == inspectionresult.ConditionStatusНеприменимо,
+	}
+}
+
+// actTableRows — строки таблицы акта. Без IncludeUnfilled — только внесённые результаты;
+// с ним — все элементы чек-листов задания (основной, затем дополнительные, каждый по order_index),
+// элементы без результата помечаются "Не осмотрено".
+func actTableRows(act *ent.InspectionAct, results []*ent.InspectionResult) []actResultRow {
+	t := act.Edges.Task
+	if !act.IncludeUnfilled || t == nil {
+		return actResultRows(act, results)
+	}
+
+	byElement := make(map[int]*ent.InspectionResult, len(results))
+	for _, r := range results {
+		byElement[r.ChecklistElementID] = r
+	}
+
+	checklists := []*ent.Checklist{t.Edges.Checklist}
+	for _, tc := range t.Edges.ExtraChecklists {
+		checklists = append(checklists, tc.Edges.Checklist)
+	}
+
+	var rows []actResultRow
+	for _, c := range checklists {
+		if c == nil {
+			continue
+		}
+		elements := append([]*ent.ChecklistElement(nil), c.Edges.Elements...)
+		sort.SliceStable(elements, func(i, j int) bool {
+			return elements[i].OrderIndex < elements[j].OrderIndex
+		})
+		for _, ce := range elements {
+			if r, ok := byElement[ce.ID]; ok {
+				rows = append(rows, actResultRowFor(act, r))
+				delete(byElement, ce.ID)
+				continue
+			}
+			rows = append(rows, actResultRow{
+				ElementName: elementDisplayName(ce.Edges.ElementCatalog, act.Language),
+				Status:      unfilledStatusLabel,
+				Unfilled:    true,
+			})
+		}
+	}
+
+	// Результаты по элементам, которых уже нет в чек-листах, — в конце таблицы
+	for _, r := range results {
+		if _, ok := byElement[r.ChecklistElementID]; ok {
+			rows = append(rows, actResultRowFor(act, r))
 		}
-		return nil, "", fmt.Errorf("database error: %w", err)
+	}
+	return rows
+}
+
+// actConclusion — текст раздела "Заключение" акта (с комментарием координатора, если он задан).
+func actConclusion(act *ent.InspectionAct) string {
+	conclusion := act.Conclusion
+	if conclusion == "" {
+		conclusion = "Осмотр выполнен. Результаты представлены в таблице выше."
+	}
+	if act.ApprovalComment != nil && *act.ApprovalComment != "" {
+		conclusion += "\n\nКомментарий координатора: " + *act.ApprovalComment
+	}
+	return conclusion
+}
+
+// ============================================================================
+// ГЕНЕРАЦИЯ / ВОЗВРАТ PDF
+// ============================================================================
+
+// Варианты PDF-акта
+const (
+	ActVariantOfficial = "official" // Официальный акт для жильцов — без внутренних заметок
+	ActVariantInternal = "internal" // Внутренний экземпляр — с заметками инспектора
+)
+
+// GenerateActVariantPDF — PDF акта в указанном варианте.
+// Официальный вариант сохраняется на диске (см. GeneratePDFForAct); внутренний формируется
+// при каждом запросе и не сохраняется, чтобы заметки не попали в общий файл акта.
+func (s *InspectionActService) GenerateActVariantPDF(ctx context.Context, taskID int, variant string) ([]byte, string, error) {
+	if variant == ActVariantOfficial {
+		return s.GeneratePDFForAct(ctx, taskID)
+	}
+	if variant != ActVariantInternal {
+		return nil, "", ErrInvalidActVariant
+	}
+
+	act, err := s.loadActForPDF(ctx, taskID)
+	if err != nil {
+		return nil, "", err
+	}
+	results, err := s.loadActResults(ctx, taskID)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.generatePDF(act, results, ActVariantInternal)
+}
+
+// GeneratePDFForAct — генерирует PDF (если нужно) и возвращает []byte + имя файла.
+// Если document_path уже заполнен и файл существует — просто читает его.
+func (s *InspectionActService) GeneratePDFForAct(ctx context.Context, taskID int) ([]byte, string, error) {
+	// 1. Получаем акт вместе с задачей
+	act, err := s.loadActForPDF(ctx, taskID)
+	if err != nil {
+		return nil, "", err
 	}
 
 	// 2. Если PDF уже есть на диске — читаем и возвращаем
@@ -217,23 +554,17 @@ func (s *InspectionActService) GeneratePDFForAct(ctx context.Context, taskID int
 			filename := filepath.Base(act.DocumentPath)
 			return data, filename, nil
 		}
-		log.Printf("failed to read existing PDF, will regenerate: %v", err)
+		logger.Warnf("failed to read existing PDF, will regenerate: %v", err)
 	}
 
 	// 3. Получаем результаты осмотра
-	results, err := s.Client.InspectionResult.Query().
-		Where(inspectionresult.TaskIDEQ(taskID)).
-		WithChecklistElement(func(ceq *ent.ChecklistElementQuery) {
-			ceq.WithElementCatalog()
-		}).
-		All(ctx)
-
+	results, err := s.loadActResults(ctx, taskID)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to fetch inspection results: %w", err)
+		return nil, "", err
 	}
 
 	// 4. Генерируем PDF в памяти
-	pdfData, filename, err := s.generatePDF(act, results)
+	pdfData, filename, err := s.generatePDF(act, results, ActVariantOfficial)
 	if err != nil {
 		return nil, "", err
 	}
@@ -241,7 +572,7 @@ func (s *InspectionActService) GeneratePDFForAct(ctx context.Context, taskID int
 	// 5. Сохраняем PDF на диск
 	fullPath := filepath.Join(s.StoragePath, filename)
 	if err := os.WriteFile(fullPath, pdfData, 0644); err != nil {
-		log.Printf("failed to save PDF to %s: %v", fullPath, err)
+		logger.Errorf("failed to save PDF to %s: %v", fullPath, err)
 		return nil, "", fmt.Errorf("failed to save PDF")
 	}
 
@@ -250,39 +581,135 @@ func (s *InspectionActService) GeneratePDFForAct(ctx context.Context, taskID int
 		SetDocumentPath(fullPath).
 		Save(ctx)
 	if err != nil {
-		log.Printf("failed to update inspection act with document_path: %v", err)
+		logger.Errorf("failed to update inspection act with document_path: %v", err)
 	}
 
 	return pdfData, filename, nil
 }
 
+// loadActForPDF — акт задания со всеми связями, нужными для вёрстки.
+func (s *InspectionActService) loadActForPDF(ctx context.Context, taskID int) (*ent.InspectionAct, error) {
+	act, err := s.Client.InspectionAct.Query().
+		Where(inspectionact.TaskIDEQ(taskID)).
+		WithTask(func(tq *ent.TaskQuery) {
+			tq.
+				WithBuilding(func(bq *ent.BuildingQuery) {
+					bq.WithDistrict().WithJkhUnit()
+				}).
+				WithChecklist(func(cq *ent.ChecklistQuery) {
+					cq.WithElements(func(ceq *ent.ChecklistElementQuery) {
+						ceq.WithElementCatalog()
+					})
+				}).
+				WithExtraChecklists(withExtraChecklistElements).
+				WithInspector()
+		}).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrActNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return act, nil
+}
+
+// withExtraChecklistElements — дополнительные чек-листы задания вместе с элементами
+// (нужны для строк "Не осмотрено" в акте с IncludeUnfilled).
+func withExtraChecklistElements(q *ent.TaskChecklistQuery) {
+	q.WithChecklist(func(cq *ent.ChecklistQuery) {
+		cq.WithElements(func(ceq *ent.ChecklistElementQuery) {
+			ceq.WithElementCatalog()
+		})
+	}).Order(ent.Asc(taskchecklist.FieldID))
+}
+
+// loadActResults — результаты осмотра задания с элементами каталога (для таблицы акта).
+func (s *InspectionActService) loadActResults(ctx context.Context, taskID int) ([]*ent.InspectionResult, error) {
+	results, err := s.Client.InspectionResult.Query().
+		Where(inspectionresult.TaskIDEQ(taskID)).
+		WithChecklistElement(func(ceq *ent.ChecklistElementQuery) {
+			ceq.WithElementCatalog()
+		}).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch inspection results: %w", err)
+	}
+	return results, nil
+}
+
 // ============================================================================
 // ВНУТРЕННЯЯ ГЕНЕРАЦИЯ PDF
 // ============================================================================
 
-func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*ent.InspectionResult) ([]byte, string, error) {
+func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*ent.InspectionResult, variant string) ([]byte, string, error) {
+    pdf, err := s.renderActPDF(act, results, variant)
+    if err != nil {
+        return nil, "", err
+    }
+
+    buf := new(bytes.Buffer)
+    if err := pdf.Output(buf); err != nil {
+        return nil, "", fmt.Errorf("failed to generate PDF: %w", err)
+    }
+
+    filename := fmt.Sprintf("act_%d_%s.pdf", act.TaskID, time.Now().Format("20060102_150405"))
+    if variant == ActVariantInternal {
+        filename = fmt.Sprintf("act_%d_internal_%s.pdf", act.TaskID, time.Now().Format("20060102_150405"))
+    }
+    return buf.Bytes(), filename, nil
+}
+
+// drawResultTableHeader — шапка таблицы результатов (№, элемент, статус, примечание).
+// Общая для акта и бланка чек-листа.
+func drawResultTableHeader(pdf *gofpdf.Fpdf) {
+    pdf.SetFillColor(220, 220, 220)
+    pdf.CellFormat(10, 7, "№", "1", 0, "C", true, 0, "")
+    pdf.CellFormat(45, 7, "Элемент", "1", 0, "L", true, 0, "")
+    pdf.CellFormat(40, 7, "Статус", "1", 0, "L", true, 0, "")
+    pdf.CellFormat(95, 7, "Примечание", "1", 1, "L", true, 0, "")
+    pdf.SetFillColor(255, 255, 255)
+}
+
+// drawResultTableRow — строка таблицы результатов высотой h.
+func drawResultTableRow(pdf *gofpdf.Fpdf, h float64, n int, row actResultRow, fill bool) {
+    pdf.CellFormat(10, h, fmt.Sprintf("%d", n), "1", 0, "C", fill, 0, "")
+    pdf.CellFormat(45, h, row.ElementName, "1", 0, "L", fill, 0, "")
+    pdf.CellFormat(40, h, row.Status, "1", 0, "L", fill, 0, "")
+    pdf.CellFormat(95, h, row.Comment, "1", 1, "L", fill, 0, "")
+}
+
+// renderActPDF — вёрстка акта (без сохранения), колонтитул содержит номер акта и нумерацию страниц.
+// Во внутреннем варианте (ActVariantInternal) к примечаниям добавляются внутренние заметки инспектора.
+func (s *InspectionActService) renderActPDF(act *ent.InspectionAct, results []*ent.InspectionResult, variant string) (*gofpdf.Fpdf, error) {
     t := act.Edges.Task
     if t == nil {
-        return nil, "", fmt.Errorf("task edge not loaded for inspection act")
+        return nil, fmt.Errorf("task edge not loaded for inspection act")
     }
+    internal := variant == ActVariantInternal
 
-    pdf := gofpdf.New("P", "mm", "A4", "")
-    // Подключаем шрифт с кириллицей (предполагаем, что файл .ttf лежит по этому пути)
-    // Загружаем шрифты Times New Roman и проверяем ошибки
-    pdf.AddUTF8Font("Times", "", "storage/fonts/timesnewromanpsmt.ttf")  // Путь к обычному шрифту
-    if err := pdf.Error(); err != nil {
-        return nil, "", fmt.Errorf("failed to load regular font: %w", err)
+    label := "Акт осмотра № " + actNumber(act)
+    if internal {
+        label += " — внутренний экземпляр"
     }
-    pdf.AddUTF8Font("Times", "B", "storage/fonts/ofont.ru_Times New Roman.ttf")  // Путь к жирному шрифту
-    if err := pdf.Error(); err != nil {
-        return nil, "", fmt.Errorf("failed to load bold font: %w", err)
+    pdf, err := newPDFDocument(s.Layout, label)
+    if err != nil {
+        return nil, err
     }
     pdf.AddPage()
 
     // Заголовок
     pdf.SetFont("Times", "B", 16)  // Теперь используем "Times" вместо "DejaVu"
     pdf.CellFormat(0, 10, "АКТ ОСМОТРА ЖИЛОГО ПОМЕЩЕНИЯ", "", 0, "C", false, 0, "")
-    pdf.Ln(13)
+    pdf.Ln(10)
+    if internal {
+        pdf.SetFont("Times", "", 10)
+        pdf.CellFormat(0, 6, "Внутренний экземпляр — не для передачи жильцам", "", 0, "C", false, 0, "")
+        pdf.Ln(6)
+    }
+    pdf.Ln(3)
 
     // Основная информация
     pdf.SetFont("Times", "B", 12)
@@ -291,7 +718,7 @@ func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*en
     pdf.SetFont("Times", "", 11)
 
     pdf.CellFormat(55, 6, "Номер акта:", "", 0, "L", false, 0, "")
-    pdf.CellFormat(0, 6, fmt.Sprintf("%d", act.ID), "", 0, "L", false, 0, "")
+    pdf.CellFormat(0, 6, actNumber(act), "", 0, "L", false, 0, "")
     pdf.Ln(6)
 
     pdf.CellFormat(55, 6, "Дата создания акта:", "", 0, "L", false, 0, "")
@@ -352,6 +779,31 @@ func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*en
             pdf.CellFormat(0, 6, b.Edges.JkhUnit.Name, "", 0, "L", false, 0, "")
             pdf.Ln(6)
         }
+        for _, size := range []struct {
+            label string
+            value *int
+        }{
+            {"Этажей:", b.Floors},
+            {"Подъездов:", b.Entrances},
+            {"Квартир:", b.Apartments},
+        } {
+            if size.value == nil {
+                continue
+            }
+            pdf.CellFormat(55, 6, size.label, "", 0, "L", false, 0, "")
+            pdf.CellFormat(0, 6, fmt.Sprintf("%d", *size.value), "", 0, "L", false, 0, "")
+            pdf.Ln(6)
+        }
+        if b.ContactName != "" {
+            pdf.CellFormat(55, 6, "Контактное лицо:", "", 0, "L", false, 0, "")
+            pdf.CellFormat(0, 6, b.ContactName, "", 0, "L", false, 0, "")
+            pdf.Ln(6)
+        }
+        if b.ContactPhone != "" {
+            pdf.CellFormat(55, 6, "Телефон контакта:", "", 0, "L", false, 0, "")
+            pdf.CellFormat(0, 6, b.ContactPhone, "", 0, "L", false, 0, "")
+            pdf.Ln(6)
+        }
     }
 
     pdf.Ln(3)
@@ -373,6 +825,16 @@ func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*en
         pdf.Ln(6)
     }
 
+    // Дополнительные чек-листы (результаты по ним входят в общую таблицу)
+    for _, link := range t.Edges.ExtraChecklists {
+        if link.Edges.Checklist == nil {
+            continue
+        }
+        pdf.CellFormat(55, 6, "Дополнительный чек-лист:", "", 0, "L", false, 0, "")
+        pdf.CellFormat(0, 6, link.Edges.Checklist.Title, "", 0, "L", false, 0, "")
+        pdf.Ln(6)
+    }
+
     pdf.Ln(3)
 
     // Таблица результатов
@@ -382,23 +844,22 @@ func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*en
     pdf.SetFont("Times", "", 9)
 
     // Заголовки таблицы
-    pdf.SetFillColor(220, 220, 220)
-    pdf.CellFormat(10, 7, "№", "1", 0, "C", true, 0, "")
-    pdf.CellFormat(45, 7, "Элемент", "1", 0, "L", true, 0, "")
-    pdf.CellFormat(40, 7, "Статус", "1", 0, "L", true, 0, "")
-    pdf.CellFormat(95, 7, "Примечание", "1", 1, "L", true, 0, "")
-    pdf.SetFillColor(255, 255, 255)
+    drawResultTableHeader(pdf)
 
-    for i, r := range results {
-        elemName := ""
-        if r.Edges.ChecklistElement != nil && r.Edges.ChecklistElement.Edges.ElementCatalog != nil {
-            elemName = r.Edges.ChecklistElement.Edges.ElementCatalog.Name
+    for i, row := range actTableRows(act, results) {
+        if internal && row.InternalNote != "" {
+            row.Comment = strings.TrimSpace(row.Comment + " Внутр. заметка: " + row.InternalNote)
+        }
+        // Неприменимые элементы — серым на светлом фоне
+        if row.NotApplicable {
+            pdf.SetFillColor(240, 240, 240)
+            pdf.SetTextColor(120, 120, 120)
+        }
+        drawResultTableRow(pdf, 6, i+1, row, row.NotApplicable)
+        if row.NotApplicable {
+            pdf.SetFillColor(255, 255, 255)
+            pdf.SetTextColor(0, 0, 0)
         }
-
-        pdf.CellFormat(10, 6, fmt.Sprintf("%d", i+1), "1", 0, "C", false, 0, "")
-        pdf.CellFormat(45, 6, elemName, "1", 0, "L", false, 0, "")
-        pdf.CellFormat(40, 6, string(r.ConditionStatus), "1", 0, "L", false, 0, "")
-        pdf.CellFormat(95, 6, r.Comment, "1", 1, "L", false, 0, "")
     }
 
     pdf.Ln(4)
@@ -409,11 +870,7 @@ func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*en
     pdf.Ln(8)
     pdf.SetFont("Times", "", 10)
 
-    conclusion := act.Conclusion
-    if conclusion == "" {
-        conclusion = "Осмотр выполнен. Результаты представлены в таблице выше."
-    }
-    pdf.MultiCell(0, 5, conclusion, "", "L", false)
+    pdf.MultiCell(0, 5, actConclusion(act), "", "L", false)
     pdf.Ln(8)
 
     // Подпись
@@ -421,13 +878,7 @@ func (s *InspectionActService) generatePDF(act *ent.InspectionAct, results []*en
     pdf.CellFormat(90, 6, "Подпись инспектора: ____________________", "", 0, "L", false, 0, "")
     pdf.CellFormat(0, 6, "Дата: "+time.Now().Format("02.01.2006"), "", 1, "L", false, 0, "")
 
-    buf := new(bytes.Buffer)
-    if err := pdf.Output(buf); err != nil {
-        return nil, "", fmt.Errorf("failed to generate PDF: %w", err)
-    }
-
-    filename := fmt.Sprintf("act_%d_%s.pdf", act.TaskID, time.Now().Format("20060102_150405"))
-    return buf.Bytes(), filename, nil
+    return pdf, nil
 }
 
 
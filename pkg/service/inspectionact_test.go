@@ -0,0 +1,480 @@
+// pkg/service/inspectionact_test.go
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"jkh/ent"
+	"jkh/ent/inspectionact"
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/testutil"
+)
+
+func TestInspectionActService_GetActAudit_ApprovalChain(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	tk := f.createTask(t, client, "Осмотр кровли")
+
+	taskSvc := NewTaskService(client)
+	steps := []struct {
+		status  task.Status
+		actorID int
+	}{
+		{task.StatusPending, f.Coordinator.ID},
+		{task.StatusInProgress, f.Inspector.ID},
+		{task.StatusOnReview, f.Inspector.ID},
+		{task.StatusApproved, f.Coordinator.ID},
+	}
+	for _, step := range steps {
+		if err := taskSvc.UpdateTaskStatus(ctx, tk.ID, step.status, step.actorID); err != nil {
+			t.Fatalf("UpdateTaskStatus(%s) failed: %v", step.status, err)
+		}
+	}
+
+	svc := NewInspectionActService(client, t.TempDir())
+	audit, err := svc.GetActAudit(ctx, tk.ID)
+	if err != nil {
+		t.Fatalf("GetActAudit failed: %v", err)
+	}
+
+	if audit.TaskID != tk.ID {
+		t.Errorf("Expected task_id %d, got %d", tk.ID, audit.TaskID)
+	}
+	if audit.ActNumber == "" {
+		t.Error("Expected act number to be set")
+	}
+	if audit.Status != "утверждён" {
+		t.Errorf("Expected act status 'утверждён', got %s", audit.Status)
+	}
+	if audit.SubmittedBy == nil || audit.SubmittedBy.ID != f.Inspector.ID {
+		t.Errorf("Expected submitted_by to be inspector %d, got %+v", f.Inspector.ID, audit.SubmittedBy)
+	}
+	if audit.ApprovedBy == nil || audit.ApprovedBy.ID != f.Coordinator.ID {
+		t.Errorf("Expected approved_by to be coordinator %d, got %+v", f.Coordinator.ID, audit.ApprovedBy)
+	}
+	if audit.SubmittedAt == nil || audit.ApprovedAt == nil {
+		t.Error("Expected submitted_at and approved_at to be set")
+	}
+	if len(audit.History) != len(steps) {
+		t.Fatalf("Expected %d history entries, got %d", len(steps), len(audit.History))
+	}
+	if audit.History[0].FromStatus != "New" || audit.History[0].ToStatus != "Pending" {
+		t.Errorf("Unexpected first history entry: %+v", audit.History[0])
+	}
+}
+
+func TestInspectionActService_GetActAudit_NotFound(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	tk := f.createTask(t, client, "Без акта")
+
+	svc := NewInspectionActService(client, t.TempDir())
+	_, err := svc.GetActAudit(ctx, tk.ID)
+	if err != ErrActNotFound {
+		t.Errorf("Expected ErrActNotFound, got %v", err)
+	}
+}
+
+func TestActResultRows_EnglishActUsesNameEn(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	roof := f.addElement(t, client, "Кровля", 1)
+	walls := f.addElement(t, client, "Стены", 2)
+	client.ElementCatalog.UpdateOneID(roof.ElementID).SetNameEn("Roof").ExecX(ctx)
+
+	tk := f.createTask(t, client, "Осмотр")
+	for _, ce := range []*ent.ChecklistElement{roof, walls} {
+		client.InspectionResult.Create().
+			SetTaskID(tk.ID).
+			SetChecklistElementID(ce.ID).
+			SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+			SaveX(ctx)
+	}
+	act := client.InspectionAct.Create().
+		SetTaskID(tk.ID).
+		SetLanguage(inspectionact.LanguageEn).
+		SaveX(ctx)
+
+	results := client.InspectionResult.Query().
+		Where(inspectionresult.TaskIDEQ(tk.ID)).
+		WithChecklistElement(func(q *ent.ChecklistElementQuery) { q.WithElementCatalog() }).
+		Order(ent.Asc(inspectionresult.FieldID)).
+		AllX(ctx)
+
+	rows := actResultRows(act, results)
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].ElementName != "Roof" {
+		t.Errorf("Expected English name 'Roof', got %s", rows[0].ElementName)
+	}
+	// Перевод не задан — используется основное название
+	if rows[1].ElementName != "Стены" {
+		t.Errorf("Expected fallback name 'Стены', got %s", rows[1].ElementName)
+	}
+
+	// Русскоязычный акт печатает основные названия
+	act.Language = inspectionact.LanguageRu
+	if rows := actResultRows(act, results); rows[0].ElementName != "Кровля" {
+		t.Errorf("Expected primary name 'Кровля', got %s", rows[0].ElementName)
+	}
+}
+
+func TestInspectionActService_SetActLanguage(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	tk := f.createTask(t, client, "Осмотр")
+	client.InspectionAct.Create().SetTaskID(tk.ID).SetDocumentPath("missing.pdf").SaveX(ctx)
+
+	svc := NewInspectionActService(client, t.TempDir())
+	if err := svc.SetActLanguage(ctx, tk.ID, "de"); err != ErrInvalidActLanguage {
+		t.Errorf("Expected ErrInvalidActLanguage, got %v", err)
+	}
+	if err := svc.SetActLanguage(ctx, tk.ID, "en"); err != nil {
+		t.Fatalf("SetActLanguage failed: %v", err)
+	}
+
+	act := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(tk.ID)).OnlyX(ctx)
+	if act.Language != inspectionact.LanguageEn {
+		t.Errorf("Expected language 'en', got %s", act.Language)
+	}
+	if act.DocumentPath != "" {
+		t.Errorf("Expected document_path to be reset, got %s", act.DocumentPath)
+	}
+}
+
+func TestActTableRows_IncludeUnfilled(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	// Элементы добавлены не по порядку проверки
+	f.addElement(t, client, "Стены", 2)
+	roof := f.addElement(t, client, "Кровля", 1)
+	f.addElement(t, client, "Фундамент", 3)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(roof.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+		SaveX(ctx)
+	client.InspectionAct.Create().SetTaskID(tk.ID).SetDocumentPath("missing.pdf").SaveX(ctx)
+
+	svc := NewInspectionActService(client, t.TempDir())
+	load := func() ([]actResultRow, *ent.InspectionAct) {
+		act, err := svc.loadActForPDF(ctx, tk.ID)
+		if err != nil {
+			t.Fatalf("loadActForPDF failed: %v", err)
+		}
+		results, err := svc.loadActResults(ctx, tk.ID)
+		if err != nil {
+			t.Fatalf("loadActResults failed: %v", err)
+		}
+		return actTableRows(act, results), act
+	}
+
+	// По умолчанию — только внесённые результаты
+	rows, act := load()
+	if act.IncludeUnfilled {
+		t.Fatal("Expected include_unfilled to be off by default")
+	}
+	if len(rows) != 1 {
+		t.Fatalf("Expected 1 row with flag off, got %d", len(rows))
+	}
+
+	if err := svc.SetActIncludeUnfilled(ctx, tk.ID, true); err != nil {
+		t.Fatalf("SetActIncludeUnfilled failed: %v", err)
+	}
+	rows, act = load()
+	if act.DocumentPath != "" {
+		t.Errorf("Expected document_path to be reset, got %s", act.DocumentPath)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("Expected 3 rows with flag on, got %d", len(rows))
+	}
+	want := []struct {
+		name   string
+		status string
+	}{
+		{"Кровля", string(inspectionresult.ConditionStatusИсправное)},
+		{"Стены", unfilledStatusLabel},
+		{"Фундамент", unfilledStatusLabel},
+	}
+	for i, w := range want {
+		if rows[i].ElementName != w.name || rows[i].Status != w.status {
+			t.Errorf("Row %d: expected %s/%s, got %s/%s", i, w.name, w.status, rows[i].ElementName, rows[i].Status)
+		}
+	}
+
+	if err := svc.SetActIncludeUnfilled(ctx, tk.ID+100, true); err != ErrActNotFound {
+		t.Errorf("Expected ErrActNotFound, got %v", err)
+	}
+}
+
+func TestTaskService_ApproveTask_CommentInActConclusion(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	tk := f.createTask(t, client, "Осмотр кровли")
+
+	taskSvc := NewTaskService(client)
+	for _, status := range []task.Status{task.StatusPending, task.StatusInProgress, task.StatusOnReview} {
+		if err := taskSvc.UpdateTaskStatus(ctx, tk.ID, status, f.Coordinator.ID); err != nil {
+			t.Fatalf("UpdateTaskStatus(%s) failed: %v", status, err)
+		}
+	}
+
+	comment := "Устранить протечку до 1 декабря"
+	if err := taskSvc.ApproveTask(ctx, tk.ID, f.Coordinator.ID, &comment); err != nil {
+		t.Fatalf("ApproveTask failed: %v", err)
+	}
+
+	act := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(tk.ID)).OnlyX(ctx)
+	if act.ApprovalComment == nil || *act.ApprovalComment != comment {
+		t.Fatalf("Expected approval comment %q, got %v", comment, act.ApprovalComment)
+	}
+
+	// Комментарий печатается в заключении утверждённого акта
+	actSvc := NewInspectionActService(client, t.TempDir())
+	actSvc.Layout.FontDir = "../../storage/fonts"
+	if out := renderApprovedAct(t, actSvc, tk.ID); !bytes.Contains(out, utf16be(comment)) {
+		t.Errorf("Expected approved act PDF to contain the comment %q", comment)
+	}
+
+	// Без комментария поле остаётся пустым (NULL), заключение — стандартное
+	other := f.createTask(t, client, "Осмотр фасада")
+	for _, status := range []task.Status{task.StatusPending, task.StatusInProgress, task.StatusOnReview, task.StatusApproved} {
+		if err := taskSvc.UpdateTaskStatus(ctx, other.ID, status, f.Coordinator.ID); err != nil {
+			t.Fatalf("UpdateTaskStatus(%s) failed: %v", status, err)
+		}
+	}
+	plain := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(other.ID)).OnlyX(ctx)
+	if plain.ApprovalComment != nil {
+		t.Errorf("Expected nil approval comment, got %q", *plain.ApprovalComment)
+	}
+	if out := renderApprovedAct(t, actSvc, other.ID); bytes.Contains(out, utf16be("Комментарий координатора")) {
+		t.Error("Expected no coordinator comment in approved act PDF")
+	}
+}
+
+// renderApprovedAct — несжатый PDF акта задания (для поиска текста в выводе).
+func renderApprovedAct(t *testing.T, s *InspectionActService, taskID int) []byte {
+	t.Helper()
+	ctx := context.Background()
+
+	act, err := s.loadActForPDF(ctx, taskID)
+	if err != nil {
+		t.Fatalf("loadActForPDF failed: %v", err)
+	}
+	results, err := s.loadActResults(ctx, taskID)
+	if err != nil {
+		t.Fatalf("loadActResults failed: %v", err)
+	}
+	pdf, err := s.renderActPDF(act, results, ActVariantOfficial)
+	if err != nil {
+		t.Fatalf("renderActPDF failed: %v", err)
+	}
+	pdf.SetCompression(false)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatalf("pdf output failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestInspectionActService_RenderActPDF_PageFooter(t *testing.T) {
+	s := NewInspectionActService(nil, t.TempDir())
+	s.Layout.FontDir = "../../storage/fonts" // шрифты лежат в корне репозитория
+
+	act := &ent.InspectionAct{ID: 7, TaskID: 1, Status: "создан"}
+	act.Edges.Task = &ent.Task{ID: 1, Title: "Осмотр"}
+
+	// Результатов заведомо больше, чем помещается на одну страницу
+	var results []*ent.InspectionResult
+	for i := 0; i < 80; i++ {
+		r := &ent.InspectionResult{ConditionStatus: inspectionresult.ConditionStatusИсправное}
+		r.Edges.ChecklistElement = &ent.ChecklistElement{}
+		r.Edges.ChecklistElement.Edges.ElementCatalog = &ent.ElementCatalog{Name: fmt.Sprintf("Элемент %d", i+1)}
+		results = append(results, r)
+	}
+
+	pdf, err := s.renderActPDF(act, results, ActVariantOfficial)
+	if err != nil {
+		t.Fatalf("renderActPDF failed: %v", err)
+	}
+	pages := pdf.PageCount()
+	if pages < 2 {
+		t.Fatalf("Expected a multi-page act, got %d page(s)", pages)
+	}
+
+	// Без сжатия текст страниц виден в выходном файле (UTF-16BE)
+	pdf.SetCompression(false)
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+	out := buf.Bytes()
+
+	if bytes.Contains(out, utf16be(pdfPageCountAlias)) {
+		t.Error("Expected page count alias to be replaced in output")
+	}
+	for page := 1; page <= pages; page++ {
+		footer := strings.Replace(pdfFooterText(page), pdfPageCountAlias, fmt.Sprintf("%d", pages), 1)
+		if !bytes.Contains(out, utf16be(footer)) {
+			t.Errorf("Expected footer %q on page %d", footer, page)
+		}
+	}
+	if !bytes.Contains(out, utf16be("Акт осмотра № 7")) {
+		t.Error("Expected act number in footer")
+	}
+}
+
+// utf16be — кодировка строки, в которой gofpdf пишет текст UTF-8 шрифтов.
+func utf16be(s string) []byte {
+	var b []byte
+	for _, r := range utf16.Encode([]rune(s)) {
+		b = append(b, byte(r>>8), byte(r))
+	}
+	return b
+}
+
+func TestInspectionActService_RenderActPDF_BuildingContact(t *testing.T) {
+	s := NewInspectionActService(nil, t.TempDir())
+	s.Layout.FontDir = "../../storage/fonts"
+
+	act := &ent.InspectionAct{ID: 3, TaskID: 1, Status: "создан"}
+	act.Edges.Task = &ent.Task{ID: 1, Title: "Осмотр"}
+	act.Edges.Task.Edges.Building = &ent.Building{
+		Address:      "ул. Мира, 2",
+		ContactName:  "Иванова Мария",
+		ContactPhone: "+7 342 200-00-00",
+	}
+
+	pdf, err := s.renderActPDF(act, nil, ActVariantOfficial)
+	if err != nil {
+		t.Fatalf("renderActPDF failed: %v", err)
+	}
+	pdf.SetCompression(false)
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+
+	// Телефон без скобок: в PDF-строках скобки экранируются
+	for _, text := range []string{"Контактное лицо:", "Иванова Мария", "+7 342 200-00-00"} {
+		if !bytes.Contains(buf.Bytes(), utf16be(text)) {
+			t.Errorf("Expected %q in building section of the act", text)
+		}
+	}
+}
+
+func TestInspectionActService_RenderActPDF_InternalNotes(t *testing.T) {
+	s := NewInspectionActService(nil, t.TempDir())
+	s.Layout.FontDir = "../../storage/fonts"
+
+	act := &ent.InspectionAct{ID: 5, TaskID: 1, Status: "создан"}
+	act.Edges.Task = &ent.Task{ID: 1, Title: "Осмотр"}
+
+	r := &ent.InspectionResult{
+		ConditionStatus: inspectionresult.ConditionStatusНеудовлетворительное,
+		Comment:         "Течь на стояке",
+		InternalNote:    "Жилец конфликтный, ключи у консьержа",
+	}
+	r.Edges.ChecklistElement = &ent.ChecklistElement{}
+	r.Edges.ChecklistElement.Edges.ElementCatalog = &ent.ElementCatalog{Name: "Стояк ХВС"}
+	results := []*ent.InspectionResult{r}
+
+	render := func(variant string) []byte {
+		t.Helper()
+		pdf, err := s.renderActPDF(act, results, variant)
+		if err != nil {
+			t.Fatalf("renderActPDF(%s) failed: %v", variant, err)
+		}
+		pdf.SetCompression(false)
+		var buf bytes.Buffer
+		if err := pdf.Output(&buf); err != nil {
+			t.Fatalf("Output failed: %v", err)
+		}
+		return buf.Bytes()
+	}
+
+	official := render(ActVariantOfficial)
+	internal := render(ActVariantInternal)
+
+	note := utf16be(r.InternalNote)
+	if bytes.Contains(official, note) {
+		t.Error("Expected internal note to be omitted from the official act")
+	}
+	if !bytes.Contains(internal, note) {
+		t.Error("Expected internal note in the internal act")
+	}
+	// Обычный комментарий инспектора есть в обоих вариантах
+	for name, out := range map[string][]byte{"official": official, "internal": internal} {
+		if !bytes.Contains(out, utf16be(r.Comment)) {
+			t.Errorf("Expected comment in %s act", name)
+		}
+	}
+
+	if _, _, err := s.GenerateActVariantPDF(context.Background(), 1, "bogus"); err != ErrInvalidActVariant {
+		t.Errorf("Expected ErrInvalidActVariant, got %v", err)
+	}
+}
+
+func TestInspectionActService_RenderActPDF_BuildingSize(t *testing.T) {
+	s := NewInspectionActService(nil, t.TempDir())
+	s.Layout.FontDir = "../../storage/fonts"
+
+	floors, apartments := 9, 144
+	act := &ent.InspectionAct{ID: 4, TaskID: 1, Status: "создан"}
+	act.Edges.Task = &ent.Task{ID: 1, Title: "Осмотр"}
+	act.Edges.Task.Edges.Building = &ent.Building{
+		Address:    "ул. Мира, 2",
+		Floors:     &floors,
+		Apartments: &apartments,
+	}
+
+	pdf, err := s.renderActPDF(act, nil, ActVariantOfficial)
+	if err != nil {
+		t.Fatalf("renderActPDF failed: %v", err)
+	}
+	pdf.SetCompression(false)
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+	out := buf.Bytes()
+
+	for _, text := range []string{"Этажей:", "9", "Квартир:", "144"} {
+		if !bytes.Contains(out, utf16be(text)) {
+			t.Errorf("Expected %q in building section of the act", text)
+		}
+	}
+	// Число подъездов не указано — строка не печатается
+	if bytes.Contains(out, utf16be("Подъездов:")) {
+		t.Error("Expected no entrances line when the value is unknown")
+	}
+}
@@ -0,0 +1,97 @@
+// pkg/service/inspectionexport.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"jkh/pkg/models"
+)
+
+// ============================================================================
+// ЭКСПОРТ ОСМОТРА
+// ============================================================================
+
+// ExportInspection — полный осмотр задания одним документом: задание, здание, чек-листы
+// с результатами по элементам, метаданные акта и история статусов.
+// Разделы собираются существующими методами сервисов, поэтому формат совпадает с API.
+func (s *TaskService) ExportInspection(ctx context.Context, id int) (*models.InspectionExport, error) {
+	t, err := s.RetrieveTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := NewBuildingService(s.Client).RetrieveBuilding(ctx, t.Building.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := NewInspectionResultService(s.Client).GetTaskResults(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	byElement := make(map[int]*models.InspectionResultResponse, len(summary.Results))
+	for i := range summary.Results {
+		byElement[summary.Results[i].ChecklistElementID] = &summary.Results[i]
+	}
+
+	checklistIDs := []int{t.Checklist.ID}
+	for _, c := range t.AdditionalChecklists {
+		checklistIDs = append(checklistIDs, c.ID)
+	}
+
+	checklistSvc := NewChecklistService(s.Client)
+	checklists := make([]models.ExportedChecklist, 0, len(checklistIDs))
+	for _, checklistID := range checklistIDs {
+		c, err := checklistSvc.RetrieveChecklist(ctx, checklistID)
+		if err != nil {
+			return nil, err
+		}
+
+		exported := models.ExportedChecklist{
+			ID:             c.ID,
+			Title:          c.Title,
+			InspectionType: c.InspectionType,
+			Description:    c.Description,
+			Elements:       make([]models.ExportedElement, 0, len(c.Elements)),
+		}
+		for _, e := range c.Elements {
+			exported.Elements = append(exported.Elements, models.ExportedElement{
+				ChecklistElementDetail: e,
+				Result:                 byElement[e.ChecklistElementID],
+			})
+		}
+		checklists = append(checklists, exported)
+	}
+
+	// Акт появляется только после отправки на проверку (или при автоматическом черновике)
+	act, err := NewInspectionActService(s.Client, "storage/acts").GetActAudit(ctx, id)
+	if err != nil && !errors.Is(err, ErrActNotFound) {
+		return nil, err
+	}
+
+	history, err := queryStatusHistory(ctx, s.Client, id)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	entries := make([]models.StatusHistoryEntry, 0, len(history))
+	for _, h := range history {
+		entries = append(entries, toStatusHistoryEntry(h))
+	}
+
+	return &models.InspectionExport{
+		ExportedAt:        time.Now().Format(time.RFC3339),
+		Task:              *t,
+		Building:          *b,
+		Checklists:        checklists,
+		TotalElements:     summary.TotalElements,
+		CompletedElements: summary.CompletedElements,
+		NotApplicable:     summary.NotApplicable,
+		ProblemElements:   summary.ProblemElements,
+		Act:               act,
+		History:           entries,
+	}, nil
+}
@@ -0,0 +1,94 @@
+// pkg/service/inspectionexport_test.go
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/testutil"
+)
+
+func TestTaskService_ExportInspection_FullyInspected(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	walls := f.addElement(t, client, "Стены", 2)
+	roof := f.addElement(t, client, "Кровля", 1)
+	tk := f.createTask(t, client, "Осмотр")
+
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(roof.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+		SaveX(ctx)
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(walls.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusНеудовлетворительное).
+		SetComment("Трещины").
+		SaveX(ctx)
+
+	taskSvc := NewTaskService(client)
+	for _, status := range []task.Status{task.StatusPending, task.StatusInProgress, task.StatusOnReview, task.StatusApproved} {
+		if err := taskSvc.UpdateTaskStatus(ctx, tk.ID, status, f.Coordinator.ID); err != nil {
+			t.Fatalf("UpdateTaskStatus(%s) failed: %v", status, err)
+		}
+	}
+
+	export, err := taskSvc.ExportInspection(ctx, tk.ID)
+	if err != nil {
+		t.Fatalf("ExportInspection failed: %v", err)
+	}
+
+	if export.Task.ID != tk.ID || export.Task.Status != string(task.StatusApproved) {
+		t.Errorf("Unexpected task section: %+v", export.Task)
+	}
+	if export.Building.ID != f.Building.ID {
+		t.Errorf("Expected building %d, got %d", f.Building.ID, export.Building.ID)
+	}
+
+	if len(export.Checklists) != 1 || export.Checklists[0].ID != f.Checklist.ID {
+		t.Fatalf("Expected the task checklist, got %+v", export.Checklists)
+	}
+	elements := export.Checklists[0].Elements
+	if len(elements) != 2 {
+		t.Fatalf("Expected 2 elements, got %d", len(elements))
+	}
+	// Элементы — в порядке проверки, у каждого свой результат
+	if elements[0].ElementName != "Кровля" || elements[1].ElementName != "Стены" {
+		t.Errorf("Expected elements ordered by order_index, got %s, %s", elements[0].ElementName, elements[1].ElementName)
+	}
+	for _, e := range elements {
+		if e.Result == nil {
+			t.Fatalf("Expected result for element %s", e.ElementName)
+		}
+		if e.Result.ChecklistElementID != e.ChecklistElementID {
+			t.Errorf("Result of %s belongs to element %d", e.ElementName, e.Result.ChecklistElementID)
+		}
+	}
+	if elements[1].Result.Comment != "Трещины" {
+		t.Errorf("Expected walls comment, got %q", elements[1].Result.Comment)
+	}
+	if export.CompletedElements != 2 || export.ProblemElements != 1 {
+		t.Errorf("Expected 2 completed / 1 problem, got %d / %d", export.CompletedElements, export.ProblemElements)
+	}
+
+	if export.Act == nil {
+		t.Fatal("Expected act section")
+	}
+	if export.Act.Status != "утверждён" || export.Act.ApprovedAt == nil {
+		t.Errorf("Expected approved act, got status %s", export.Act.Status)
+	}
+	if len(export.History) != 4 {
+		t.Errorf("Expected 4 history entries, got %d", len(export.History))
+	}
+
+	if _, err := taskSvc.ExportInspection(ctx, tk.ID+100); err != ErrTaskNotFound {
+		t.Errorf("Expected ErrTaskNotFound, got %v", err)
+	}
+}
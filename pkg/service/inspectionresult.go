@@ -6,12 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"sort"
+	"strings"
 
 	"jkh/ent"
 	"jkh/ent/checklistelement"
 	"jkh/ent/inspectionresult"
 	"jkh/ent/task"
+	"jkh/pkg/logger"
 	"jkh/pkg/models"
 )
 
@@ -24,8 +26,25 @@ var (
 	ErrResultAlreadyExists     = errors.New("result for this element already exists")
 	ErrTaskNotInProgress       = errors.New("task is not in progress (cannot add results)")
 	ErrChecklistElementInvalid = errors.New("checklist element does not belong to task's checklist")
+	ErrChecklistIncomplete     = errors.New("not all checklist elements have inspection results")
+	ErrConditionStatusRequired = errors.New("condition status is required to create a result")
+	ErrInvalidConditionStatus  = errors.New("invalid condition status")
+	ErrPhotoRequired           = errors.New("photo is required for problem results")
 )
 
+// PhotoRequiredError — ErrPhotoRequired с перечнем элементов, у которых нет обязательного фото.
+type PhotoRequiredError struct {
+	Elements []string
+}
+
+func (e *PhotoRequiredError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPhotoRequired, strings.Join(e.Elements, ", "))
+}
+
+func (e *PhotoRequiredError) Unwrap() error {
+	return ErrPhotoRequired
+}
+
 // ============================================================================
 // СЕРВИС
 // ============================================================================
@@ -42,6 +61,23 @@ func NewInspectionResultService(client *ent.Client) *InspectionResultService {
 // ВСПОМОГАТЕЛЬНЫЕ ФУНКЦИИ
 // ============================================================================
 
+// problemConditionStatuses — статусы, считающиеся проблемными (в сводках и аналитике).
+// "Неприменимо" сюда не входит: элемент отсутствует в здании, но считается заполненным.
+var problemConditionStatuses = []inspectionresult.ConditionStatus{
+	inspectionresult.ConditionStatusНеудовлетворительное,
+	inspectionresult.ConditionStatusАварийное,
+}
+
+// isProblemCondition — является ли статус состояния проблемным.
+func isProblemCondition(status inspectionresult.ConditionStatus) bool {
+	for _, p := range problemConditionStatuses {
+		if status == p {
+			return true
+		}
+	}
+	return false
+}
+
 // toInspectionResultResponse — преобразование Ent → DTO.
 func (s *InspectionResultService) toInspectionResultResponse(ir *ent.InspectionResult) *models.InspectionResultResponse {
 	resp := &models.InspectionResultResponse{
@@ -49,6 +85,8 @@ func (s *InspectionResultService) toInspectionResultResponse(ir *ent.InspectionR
 		ChecklistElementID: ir.ChecklistElementID,
 		ConditionStatus:    string(ir.ConditionStatus),
 		Comment:            ir.Comment,
+		InternalNote:       ir.InternalNote,
+		PhotoPaths:         ir.PhotoPaths,
 		CreatedAt:          ir.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:          ir.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -67,12 +105,12 @@ func (s *InspectionResultService) toInspectionResultResponse(ir *ent.InspectionR
 	return resp
 }
 
-// validateTaskAndElement — проверка, что задание в статусе InProgress и элемент принадлежит чек-листу.
+// validateTaskAndElement — проверка, что задание в статусе InProgress и элемент принадлежит
+// одному из чек-листов задания (основному или дополнительному).
 func (s *InspectionResultService) validateTaskAndElement(ctx context.Context, taskID, checklistElementID int) error {
-	// 1. Получаем задание с чек-листом
+	// 1. Получаем задание
 	t, err := s.Client.Task.Query().
 		Where(task.IDEQ(taskID)).
-		WithChecklist().
 		Only(ctx)
 
 	if err != nil {
@@ -87,11 +125,16 @@ func (s *InspectionResultService) validateTaskAndElement(ctx context.Context, ta
 		return ErrTaskNotInProgress
 	}
 
-	// 3. Проверяем, что ChecklistElement принадлежит чек-листу задания
+	// 3. Проверяем, что ChecklistElement принадлежит одному из чек-листов задания
+	checklistIDs, err := taskChecklistIDs(ctx, s.Client, t)
+	if err != nil {
+		return err
+	}
+
 	exists, err := s.Client.ChecklistElement.Query().
 		Where(
 			checklistelement.IDEQ(checklistElementID),
-			checklistelement.ChecklistIDEQ(t.ChecklistID),
+			checklistelement.ChecklistIDIn(checklistIDs...),
 		).
 		Exist(ctx)
 
@@ -102,6 +145,16 @@ func (s *InspectionResultService) validateTaskAndElement(ctx context.Context, ta
 	return nil
 }
 
+// parseConditionStatus — проверка, что статус входит в перечисление схемы
+// (не полагаемся только на binding DTO).
+func parseConditionStatus(status string) (inspectionresult.ConditionStatus, error) {
+	cs := inspectionresult.ConditionStatus(status)
+	if err := inspectionresult.ConditionStatusValidator(cs); err != nil {
+		return "", ErrInvalidConditionStatus
+	}
+	return cs, nil
+}
+
 // ============================================================================
 // CRUD-ОПЕРАЦИИ
 // ============================================================================
@@ -110,6 +163,10 @@ func (s *InspectionResultService) validateTaskAndElement(ctx context.Context, ta
 // Если результат уже существует (task_id + checklist_element_id), обновляем его.
 func (s *InspectionResultService) CreateOrUpdateResult(ctx context.Context, taskID int, req models.CreateInspectionResultRequest) (*models.InspectionResultResponse, error) {
 	// 1. Валидация
+	status, err := parseConditionStatus(req.ConditionStatus)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.validateTaskAndElement(ctx, taskID, req.ChecklistElementID); err != nil {
 		return nil, err
 	}
@@ -131,17 +188,30 @@ func (s *InspectionResultService) CreateOrUpdateResult(ctx context.Context, task
 	if existing != nil {
 		// Обновление существующего результата
 		update := s.Client.InspectionResult.UpdateOne(existing).
-			SetConditionStatus(inspectionresult.ConditionStatus(req.ConditionStatus))
+			SetConditionStatus(status)
 
 		if req.Comment != nil {
 			update.SetComment(*req.Comment)
 		} else {
 			update.ClearComment()
 		}
+		if req.InternalNote != nil {
+			update.SetInternalNote(*req.InternalNote)
+		} else {
+			update.ClearInternalNote()
+		}
+		// Фотографии могли быть прикреплены через PATCH — без photo_paths в запросе не трогаем
+		if req.PhotoPaths != nil {
+			if len(*req.PhotoPaths) > 0 {
+				update.SetPhotoPaths(*req.PhotoPaths)
+			} else {
+				update.ClearPhotoPaths()
+			}
+		}
 
 		_, err = update.Save(ctx)
 		if err != nil {
-			log.Printf("DB error updating inspection result: %v", err)
+			logger.Errorf("DB error updating inspection result: %v", err)
 			return nil, fmt.Errorf("database error")
 		}
 	} else {
@@ -149,15 +219,21 @@ func (s *InspectionResultService) CreateOrUpdateResult(ctx context.Context, task
 		create := s.Client.InspectionResult.Create().
 			SetTaskID(taskID).
 			SetChecklistElementID(req.ChecklistElementID).
-			SetConditionStatus(inspectionresult.ConditionStatus(req.ConditionStatus))
+			SetConditionStatus(status)
 
 		if req.Comment != nil {
 			create.SetComment(*req.Comment)
 		}
+		if req.InternalNote != nil {
+			create.SetInternalNote(*req.InternalNote)
+		}
+		if req.PhotoPaths != nil && len(*req.PhotoPaths) > 0 {
+			create.SetPhotoPaths(*req.PhotoPaths)
+		}
 
 		_, err = create.Save(ctx)
 		if err != nil {
-			log.Printf("DB error creating inspection result: %v", err)
+			logger.Errorf("DB error creating inspection result: %v", err)
 			return nil, fmt.Errorf("database error")
 		}
 	}
@@ -180,16 +256,82 @@ func (s *InspectionResultService) CreateOrUpdateResult(ctx context.Context, task
 	return s.toInspectionResultResponse(result), nil
 }
 
+// PatchResult — частичное обновление результата (автосохранение во время заполнения):
+// меняются только переданные поля. Если результата ещё нет, он создаётся через
+// CreateOrUpdateResult, и тогда статус обязателен.
+func (s *InspectionResultService) PatchResult(ctx context.Context, taskID, checklistElementID int, req models.PatchInspectionResultRequest) (*models.InspectionResultResponse, error) {
+	// 1. Валидация
+	var status inspectionresult.ConditionStatus
+	if req.ConditionStatus != nil {
+		cs, err := parseConditionStatus(*req.ConditionStatus)
+		if err != nil {
+			return nil, err
+		}
+		status = cs
+	}
+	if err := s.validateTaskAndElement(ctx, taskID, checklistElementID); err != nil {
+		return nil, err
+	}
+
+	// 2. Ищем существующий результат
+	existing, err := s.Client.InspectionResult.Query().
+		Where(
+			inspectionresult.TaskIDEQ(taskID),
+			inspectionresult.ChecklistElementIDEQ(checklistElementID),
+		).
+		Only(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	if existing == nil {
+		if req.ConditionStatus == nil {
+			return nil, ErrConditionStatusRequired
+		}
+		create := models.CreateInspectionResultRequest{
+			ChecklistElementID: checklistElementID,
+			ConditionStatus:    *req.ConditionStatus,
+			Comment:            req.Comment,
+			InternalNote:       req.InternalNote,
+			PhotoPaths:         req.PhotoPaths,
+		}
+		return s.CreateOrUpdateResult(ctx, taskID, create)
+	}
+
+	// 3. Обновляем только переданные поля
+	update := s.Client.InspectionResult.UpdateOne(existing)
+	if req.ConditionStatus != nil {
+		update.SetConditionStatus(status)
+	}
+	if req.Comment != nil {
+		update.SetComment(*req.Comment)
+	}
+	if req.InternalNote != nil {
+		update.SetInternalNote(*req.InternalNote)
+	}
+	if req.PhotoPaths != nil {
+		if len(*req.PhotoPaths) > 0 {
+			update.SetPhotoPaths(*req.PhotoPaths)
+		} else {
+			update.ClearPhotoPaths()
+		}
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		logger.Errorf("DB error patching inspection result: %v", err)
+		return nil, fmt.Errorf("database error")
+	}
+
+	// 4. Догружаем связи для ответа
+	return s.GetResult(ctx, taskID, checklistElementID)
+}
+
 // GetTaskResults — получение всех результатов для задания (сводка).
 func (s *InspectionResultService) GetTaskResults(ctx context.Context, taskID int) (*models.TaskResultsSummary, error) {
-	// 1. Получаем задание с чек-листом и элементами
+	// 1. Получаем задание и число элементов во всех его чек-листах
 	t, err := s.Client.Task.Query().
 		Where(task.IDEQ(taskID)).
-		WithChecklist(func(q *ent.ChecklistQuery) {
-			q.WithElements(func(ceq *ent.ChecklistElementQuery) {
-				ceq.WithElementCatalog()
-			})
-		}).
 		Only(ctx)
 
 	if err != nil {
@@ -199,6 +341,18 @@ func (s *InspectionResultService) GetTaskResults(ctx context.Context, taskID int
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
+	checklistIDs, err := taskChecklistIDs(ctx, s.Client, t)
+	if err != nil {
+		return nil, err
+	}
+
+	totalElements, err := s.Client.ChecklistElement.Query().
+		Where(checklistelement.ChecklistIDIn(checklistIDs...)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
 	// 2. Получаем все результаты для задания
 	results, err := s.Client.InspectionResult.Query().
 		Where(inspectionresult.TaskIDEQ(taskID)).
@@ -215,18 +369,47 @@ func (s *InspectionResultService) GetTaskResults(ctx context.Context, taskID int
 	summary := &models.TaskResultsSummary{
 		TaskID:            t.ID,
 		TaskTitle:         t.Title,
-		TotalElements:     len(t.Edges.Checklist.Edges.Elements),
+		TotalElements:     totalElements,
 		CompletedElements: len(results),
 		Results:           []models.InspectionResultResponse{},
 	}
 
 	for _, r := range results {
 		summary.Results = append(summary.Results, *s.toInspectionResultResponse(r))
+
+		switch {
+		case r.ConditionStatus == inspectionresult.ConditionStatusНеприменимо:
+			summary.NotApplicable++
+		case isProblemCondition(r.ConditionStatus):
+			summary.ProblemElements++
+		}
 	}
 
 	return summary, nil
 }
 
+// GetResult — получение результата проверки одного элемента (например, для заполнения формы редактирования).
+func (s *InspectionResultService) GetResult(ctx context.Context, taskID, checklistElementID int) (*models.InspectionResultResponse, error) {
+	result, err := s.Client.InspectionResult.Query().
+		Where(
+			inspectionresult.TaskIDEQ(taskID),
+			inspectionresult.ChecklistElementIDEQ(checklistElementID),
+		).
+		WithChecklistElement(func(q *ent.ChecklistElementQuery) {
+			q.WithElementCatalog()
+		}).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrResultNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return s.toInspectionResultResponse(result), nil
+}
+
 // DeleteResult — удаление результата проверки элемента.
 func (s *InspectionResultService) DeleteResult(ctx context.Context, taskID, checklistElementID int) error {
 	deleted, err := s.Client.InspectionResult.Delete().
@@ -246,3 +429,98 @@ func (s *InspectionResultService) DeleteResult(ctx context.Context, taskID, chec
 
 	return nil
 }
+
+// ============================================================================
+// ПОЛНОТА ЗАПОЛНЕНИЯ
+// ============================================================================
+
+// missingChecklistElements — элементы всех чек-листов задания, для которых ещё нет результата
+// (основной чек-лист первым, внутри чек-листа — в порядке проверки).
+// Используется и для отображения, и для проверки при отправке задания.
+func missingChecklistElements(ctx context.Context, client *ent.Client, taskID int) ([]*ent.ChecklistElement, error) {
+	t, err := client.Task.Query().Where(task.IDEQ(taskID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	checklistIDs, err := taskChecklistIDs(ctx, client, t)
+	if err != nil {
+		return nil, err
+	}
+
+	elements, err := client.ChecklistElement.Query().
+		Where(
+			checklistelement.ChecklistIDIn(checklistIDs...),
+			checklistelement.Not(checklistelement.HasInspectionResultsWith(inspectionresult.TaskIDEQ(taskID))),
+		).
+		WithElementCatalog().
+		Order(ent.Asc(checklistelement.FieldOrderIndex), ent.Asc(checklistelement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	// Группируем по чек-листам в порядке их привязки к заданию
+	position := make(map[int]int, len(checklistIDs))
+	for i, id := range checklistIDs {
+		position[id] = i
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		return position[elements[i].ChecklistID] < position[elements[j].ChecklistID]
+	})
+
+	return elements, nil
+}
+
+// photoMissingElements — названия элементов с обязательным фото, у которых результат
+// в проблемном состоянии, но фотографий нет (в порядке чек-листа).
+func photoMissingElements(ctx context.Context, client *ent.Client, taskID int) ([]string, error) {
+	results, err := client.InspectionResult.Query().
+		Where(
+			inspectionresult.TaskIDEQ(taskID),
+			inspectionresult.ConditionStatusIn(problemConditionStatuses...),
+			inspectionresult.HasChecklistElementWith(checklistelement.PhotoRequired(true)),
+		).
+		WithChecklistElement(func(q *ent.ChecklistElementQuery) {
+			q.WithElementCatalog()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Edges.ChecklistElement.OrderIndex < results[j].Edges.ChecklistElement.OrderIndex
+	})
+
+	var names []string
+	for _, r := range results {
+		if len(r.PhotoPaths) > 0 {
+			continue
+		}
+		name := fmt.Sprintf("#%d", r.ChecklistElementID)
+		if ec := r.Edges.ChecklistElement.Edges.ElementCatalog; ec != nil {
+			name = ec.Name
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// MissingElements — элементы чек-листа, которые инспектору ещё предстоит заполнить.
+func (s *InspectionResultService) MissingElements(ctx context.Context, taskID int) ([]models.ChecklistElementDetail, error) {
+	elements, err := missingChecklistElements(ctx, s.Client, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]models.ChecklistElementDetail, len(elements))
+	for i, ce := range elements {
+		resp[i] = toChecklistElementDetail(ce)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,341 @@
+// pkg/service/inspectionresult_test.go
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/models"
+	"jkh/pkg/testutil"
+)
+
+func TestInspectionResultService_MissingElements_OneOfThree(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	first := f.addElement(t, client, "Фундамент", 1)
+	second := f.addElement(t, client, "Стены", 2)
+	third := f.addElement(t, client, "Кровля", 3)
+
+	tk := f.createTask(t, client, "Осмотр")
+	for _, id := range []int{first.ID, third.ID} {
+		client.InspectionResult.Create().
+			SetTaskID(tk.ID).
+			SetChecklistElementID(id).
+			SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+			SaveX(ctx)
+	}
+
+	svc := NewInspectionResultService(client)
+	missing, err := svc.MissingElements(ctx, tk.ID)
+	if err != nil {
+		t.Fatalf("MissingElements failed: %v", err)
+	}
+
+	if len(missing) != 1 {
+		t.Fatalf("Expected 1 missing element, got %d", len(missing))
+	}
+	if missing[0].ChecklistElementID != second.ID || missing[0].ElementName != "Стены" {
+		t.Errorf("Expected missing element 'Стены' (%d), got %+v", second.ID, missing[0])
+	}
+}
+
+func TestInspectionResultService_MissingElements_TaskNotFound(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	svc := NewInspectionResultService(client)
+	if _, err := svc.MissingElements(context.Background(), 99999); err != ErrTaskNotFound {
+		t.Errorf("Expected ErrTaskNotFound, got %v", err)
+	}
+}
+
+func TestTaskService_UpdateTaskStatus_SubmitRequiresAllResults(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	el := f.addElement(t, client, "Фундамент", 1)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+
+	svc := NewTaskService(client)
+	if err := svc.UpdateTaskStatus(ctx, tk.ID, task.StatusOnReview, f.Inspector.ID); err != ErrChecklistIncomplete {
+		t.Fatalf("Expected ErrChecklistIncomplete, got %v", err)
+	}
+
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(el.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+		SaveX(ctx)
+
+	if err := svc.UpdateTaskStatus(ctx, tk.ID, task.StatusOnReview, f.Inspector.ID); err != nil {
+		t.Errorf("Expected submit to succeed once all elements are filled, got %v", err)
+	}
+}
+
+func TestInspectionResultService_GetResult(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	el := f.addElement(t, client, "Фундамент", 1)
+	empty := f.addElement(t, client, "Стены", 2)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(el.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusУдовлетворительное).
+		SetComment("Мелкие трещины").
+		SaveX(ctx)
+
+	svc := NewInspectionResultService(client)
+
+	resp, err := svc.GetResult(ctx, tk.ID, el.ID)
+	if err != nil {
+		t.Fatalf("GetResult failed: %v", err)
+	}
+	if resp.ChecklistElementID != el.ID || resp.ConditionStatus != "Удовлетворительное" {
+		t.Errorf("Unexpected result: %+v", resp)
+	}
+	if resp.ElementName != "Фундамент" || resp.OrderIndex != 1 {
+		t.Errorf("Expected element edges to be loaded, got %+v", resp)
+	}
+	if resp.Comment != "Мелкие трещины" {
+		t.Errorf("Expected comment to be returned, got %q", resp.Comment)
+	}
+
+	if _, err := svc.GetResult(ctx, tk.ID, empty.ID); err != ErrResultNotFound {
+		t.Errorf("Expected ErrResultNotFound, got %v", err)
+	}
+}
+
+func TestInspectionResultService_GetTaskResults_NotApplicable(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	roof := f.addElement(t, client, "Кровля", 1)
+	lift := f.addElement(t, client, "Лифт", 2)
+	walls := f.addElement(t, client, "Стены", 3)
+
+	tk := f.createTask(t, client, "Осмотр")
+	statuses := map[int]inspectionresult.ConditionStatus{
+		roof.ID:  inspectionresult.ConditionStatusАварийное,
+		lift.ID:  inspectionresult.ConditionStatusНеприменимо,
+		walls.ID: inspectionresult.ConditionStatusИсправное,
+	}
+	for id, status := range statuses {
+		client.InspectionResult.Create().
+			SetTaskID(tk.ID).
+			SetChecklistElementID(id).
+			SetConditionStatus(status).
+			SaveX(ctx)
+	}
+
+	summary, err := NewInspectionResultService(client).GetTaskResults(ctx, tk.ID)
+	if err != nil {
+		t.Fatalf("GetTaskResults failed: %v", err)
+	}
+
+	if summary.CompletedElements != 3 {
+		t.Errorf("Expected N/A to count as completed (3), got %d", summary.CompletedElements)
+	}
+	if summary.NotApplicable != 1 {
+		t.Errorf("Expected 1 not applicable element, got %d", summary.NotApplicable)
+	}
+	if summary.ProblemElements != 1 {
+		t.Errorf("Expected 1 problem element, got %d", summary.ProblemElements)
+	}
+}
+
+func TestTaskService_UpdateTaskStatus_SubmitAcceptsNotApplicable(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	roof := f.addElement(t, client, "Кровля", 1)
+	lift := f.addElement(t, client, "Лифт", 2)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(roof.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+		SaveX(ctx)
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(lift.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusНеприменимо).
+		SaveX(ctx)
+
+	if err := NewTaskService(client).UpdateTaskStatus(ctx, tk.ID, task.StatusOnReview, f.Inspector.ID); err != nil {
+		t.Errorf("Expected N/A element to count as addressed, got %v", err)
+	}
+}
+
+func TestInspectionResultService_PatchResult_CommentOnly(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	el := f.addElement(t, client, "Кровля", 1)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+	client.InspectionResult.Create().
+		SetTaskID(tk.ID).
+		SetChecklistElementID(el.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusАварийное).
+		SetComment("Протеч").
+		SaveX(ctx)
+
+	svc := NewInspectionResultService(client)
+
+	// Только комментарий — статус остаётся прежним
+	comment := "Протечка над 3-м подъездом"
+	resp, err := svc.PatchResult(ctx, tk.ID, el.ID, models.PatchInspectionResultRequest{Comment: &comment})
+	if err != nil {
+		t.Fatalf("PatchResult failed: %v", err)
+	}
+	if resp.Comment != comment {
+		t.Errorf("Expected comment %q, got %q", comment, resp.Comment)
+	}
+	if resp.ConditionStatus != "Аварийное" {
+		t.Errorf("Expected status to stay 'Аварийное', got %s", resp.ConditionStatus)
+	}
+
+	// Только статус — комментарий сохраняется
+	status := "Неудовлетворительное"
+	resp, err = svc.PatchResult(ctx, tk.ID, el.ID, models.PatchInspectionResultRequest{ConditionStatus: &status})
+	if err != nil {
+		t.Fatalf("PatchResult failed: %v", err)
+	}
+	if resp.ConditionStatus != status || resp.Comment != comment {
+		t.Errorf("Expected status %s with comment kept, got %+v", status, resp)
+	}
+}
+
+func TestInspectionResultService_PatchResult_CreateRequiresStatus(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	el := f.addElement(t, client, "Кровля", 1)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+
+	svc := NewInspectionResultService(client)
+
+	comment := "Черновик"
+	if _, err := svc.PatchResult(ctx, tk.ID, el.ID, models.PatchInspectionResultRequest{Comment: &comment}); err != ErrConditionStatusRequired {
+		t.Fatalf("Expected ErrConditionStatusRequired, got %v", err)
+	}
+
+	status := "Исправное"
+	resp, err := svc.PatchResult(ctx, tk.ID, el.ID, models.PatchInspectionResultRequest{ConditionStatus: &status, Comment: &comment})
+	if err != nil {
+		t.Fatalf("PatchResult failed: %v", err)
+	}
+	if resp.ConditionStatus != status || resp.Comment != comment {
+		t.Errorf("Expected created result with status and comment, got %+v", resp)
+	}
+}
+
+func TestInspectionResultService_CreateOrUpdateResult_InvalidStatus(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	el := f.addElement(t, client, "Кровля", 1)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+
+	svc := NewInspectionResultService(client)
+
+	_, err := svc.CreateOrUpdateResult(ctx, tk.ID, models.CreateInspectionResultRequest{
+		ChecklistElementID: el.ID,
+		ConditionStatus:    "Отличное",
+	})
+	if err != ErrInvalidConditionStatus {
+		t.Fatalf("Expected ErrInvalidConditionStatus, got %v", err)
+	}
+
+	bogus := "broken"
+	if _, err := svc.PatchResult(ctx, tk.ID, el.ID, models.PatchInspectionResultRequest{ConditionStatus: &bogus}); err != ErrInvalidConditionStatus {
+		t.Errorf("Expected ErrInvalidConditionStatus from PatchResult, got %v", err)
+	}
+
+	if n := client.InspectionResult.Query().CountX(ctx); n != 0 {
+		t.Errorf("Expected no results stored, got %d", n)
+	}
+}
+
+func TestInspectionResultService_CreateOrUpdateResult_KeepsPhotos(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	el := f.addElement(t, client, "Кровля", 1)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+
+	svc := NewInspectionResultService(client)
+
+	photos := []string{"storage/photos/roof.jpg"}
+	if _, err := svc.PatchResult(ctx, tk.ID, el.ID, models.PatchInspectionResultRequest{
+		ConditionStatus: ptr("Аварийное"),
+		PhotoPaths:      &photos,
+	}); err != nil {
+		t.Fatalf("PatchResult failed: %v", err)
+	}
+
+	// Повторное сохранение без photo_paths не удаляет прикреплённые фото
+	comment := "Протечка"
+	resp, err := svc.CreateOrUpdateResult(ctx, tk.ID, models.CreateInspectionResultRequest{
+		ChecklistElementID: el.ID,
+		ConditionStatus:    "Аварийное",
+		Comment:            &comment,
+	})
+	if err != nil {
+		t.Fatalf("CreateOrUpdateResult failed: %v", err)
+	}
+	if len(resp.PhotoPaths) != 1 || resp.PhotoPaths[0] != photos[0] {
+		t.Errorf("Expected photos to be kept, got %v", resp.PhotoPaths)
+	}
+
+	// Явный [] очищает список
+	empty := []string{}
+	resp, err = svc.CreateOrUpdateResult(ctx, tk.ID, models.CreateInspectionResultRequest{
+		ChecklistElementID: el.ID,
+		ConditionStatus:    "Аварийное",
+		PhotoPaths:         &empty,
+	})
+	if err != nil {
+		t.Fatalf("CreateOrUpdateResult failed: %v", err)
+	}
+	if len(resp.PhotoPaths) != 0 {
+		t.Errorf("Expected photos to be cleared, got %v", resp.PhotoPaths)
+	}
+}
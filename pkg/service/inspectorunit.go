@@ -17,6 +17,7 @@ import (
 var (
 	ErrInspectorAssignmentExists   = errors.New("inspector already assigned to this jkh unit")
 	ErrInspectorAssignmentNotFound = errors.New("inspector assignment not found")
+	ErrNoInspectorsForUnit         = errors.New("no inspectors assigned to this jkh unit")
 )
 
 type InspectorUnitService struct {
@@ -68,6 +69,86 @@ func (s *InspectorUnitService) AssignInspector(ctx context.Context, jkhUnitID, i
 	return nil
 }
 
+// Статусы результата массового назначения
+const (
+	BulkAssignAssigned        = "assigned"
+	BulkAssignAlreadyAssigned = "already_assigned"
+	BulkAssignNotFound        = "not_found"
+	BulkAssignNotInspector    = "not_inspector"
+)
+
+// AssignMany — назначить несколько инспекторов на ЖЭУ в одной транзакции.
+// Уже назначенные пропускаются, пользователи без роли Inspector не назначаются;
+// по каждому ID возвращается свой статус.
+func (s *InspectorUnitService) AssignMany(ctx context.Context, jkhUnitID int, inspectorIDs []int) (*models.BulkAssignInspectorsResponse, error) {
+	exists, err := s.Client.JkhUnit.Query().Where(jkhunit.IDEQ(jkhUnitID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if !exists {
+		return nil, ErrJkhUnitNotFound
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	resp := &models.BulkAssignInspectorsResponse{
+		JkhUnitID: jkhUnitID,
+		Results:   make([]models.BulkAssignResult, 0, len(inspectorIDs)),
+	}
+	for _, id := range inspectorIDs {
+		status, err := assignInTx(ctx, tx, jkhUnitID, id)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if status == BulkAssignAssigned {
+			resp.Assigned++
+		}
+		resp.Results = append(resp.Results, models.BulkAssignResult{InspectorID: id, Status: status})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return resp, nil
+}
+
+// assignInTx — назначение одного инспектора внутри транзакции массового назначения.
+func assignInTx(ctx context.Context, tx *ent.Tx, jkhUnitID, inspectorID int) (string, error) {
+	u, err := tx.User.Query().Where(user.IDEQ(inspectorID)).WithRole().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return BulkAssignNotFound, nil
+		}
+		return "", fmt.Errorf("database error: %w", err)
+	}
+	if u.Edges.Role == nil || u.Edges.Role.Name != "Inspector" {
+		return BulkAssignNotInspector, nil
+	}
+
+	dup, err := tx.InspectorUnit.Query().Where(
+		inspectorunit.UserIDEQ(inspectorID),
+		inspectorunit.JkhUnitIDEQ(jkhUnitID),
+	).Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("database error: %w", err)
+	}
+	if dup {
+		return BulkAssignAlreadyAssigned, nil
+	}
+
+	if _, err := tx.InspectorUnit.Create().
+		SetUserID(inspectorID).
+		SetJkhUnitID(jkhUnitID).
+		Save(ctx); err != nil {
+		return "", fmt.Errorf("failed to create inspector assignment: %w", err)
+	}
+	return BulkAssignAssigned, nil
+}
+
 // UnassignInspector — удалить назначение по паре (jkhUnitID, inspectorID)
 func (s *InspectorUnitService) UnassignInspector(ctx context.Context, jkhUnitID, inspectorID int) error {
 	// Попытка удаления по условиям
@@ -142,3 +223,53 @@ func (s *InspectorUnitService) ListUnitsForInspector(ctx context.Context, inspec
 	}
 	return resp, nil
 }
+
+// SuggestInspectorForUnit — предложить инспектора ЖЭУ с наименьшим числом активных заданий
+// (при равной загрузке — с меньшим ID)
+func (s *InspectorUnitService) SuggestInspectorForUnit(ctx context.Context, jkhUnitID int) (*models.SuggestedInspectorResponse, error) {
+	exists, err := s.Client.JkhUnit.Query().Where(jkhunit.IDEQ(jkhUnitID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if !exists {
+		return nil, ErrJkhUnitNotFound
+	}
+
+	// Инспекторы, закреплённые за ЖЭУ
+	inspectors, err := s.Client.User.Query().
+		Where(user.HasAssignedUnitsWith(inspectorunit.JkhUnitIDEQ(jkhUnitID))).
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if len(inspectors) == 0 {
+		return nil, ErrNoInspectorsForUnit
+	}
+
+	ids := make([]int, len(inspectors))
+	for i, u := range inspectors {
+		ids[i] = u.ID
+	}
+
+	// Загрузка по активным заданиям
+	counts, err := activeTaskCounts(ctx, s.Client, ids)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	best := inspectors[0]
+	for _, u := range inspectors[1:] {
+		if counts[u.ID] < counts[best.ID] {
+			best = u
+		}
+	}
+
+	return &models.SuggestedInspectorResponse{
+		InspectorID: best.ID,
+		FirstName:   best.FirstName,
+		LastName:    best.LastName,
+		Email:       best.Email,
+		ActiveTasks: counts[best.ID],
+	}, nil
+}
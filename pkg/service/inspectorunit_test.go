@@ -0,0 +1,113 @@
+// pkg/service/inspectorunit_test.go
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"jkh/ent/task"
+	"jkh/pkg/testutil"
+)
+
+func TestInspectorUnitService_SuggestInspectorForUnit_LighterLoad(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	// Второй инспектор ЖЭУ без активных заданий
+	light := createTestUser(t, client, "Inspector", "inspector2")
+	client.InspectorUnit.Create().SetUserID(light.ID).SetJkhUnitID(f.JkhUnit.ID).SaveX(ctx)
+
+	// Первый инспектор загружен двумя активными заданиями
+	f.createTask(t, client, "Активное 1")
+	f.createTask(t, client, "Активное 2")
+
+	// Завершённые задания второго инспектора не учитываются в загрузке
+	done := f.createTask(t, client, "Завершённое")
+	client.Task.UpdateOneID(done.ID).
+		SetInspectorID(light.ID).
+		SetStatus(task.StatusApproved).
+		ExecX(ctx)
+
+	svc := NewInspectorUnitService(client)
+	resp, err := svc.SuggestInspectorForUnit(ctx, f.JkhUnit.ID)
+	if err != nil {
+		t.Fatalf("SuggestInspectorForUnit failed: %v", err)
+	}
+
+	if resp.InspectorID != light.ID {
+		t.Errorf("Expected lighter inspector %d, got %d", light.ID, resp.InspectorID)
+	}
+	if resp.ActiveTasks != 0 {
+		t.Errorf("Expected 0 active tasks, got %d", resp.ActiveTasks)
+	}
+}
+
+func TestInspectorUnitService_SuggestInspectorForUnit_NoInspectors(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	district := client.District.Create().SetName("Район").SaveX(ctx)
+	unit := client.JkhUnit.Create().SetName("ЖЭУ").SetDistrictID(district.ID).SaveX(ctx)
+
+	svc := NewInspectorUnitService(client)
+	if _, err := svc.SuggestInspectorForUnit(ctx, unit.ID); err != ErrNoInspectorsForUnit {
+		t.Errorf("Expected ErrNoInspectorsForUnit, got %v", err)
+	}
+	if _, err := svc.SuggestInspectorForUnit(ctx, 99999); err != ErrJkhUnitNotFound {
+		t.Errorf("Expected ErrJkhUnitNotFound, got %v", err)
+	}
+}
+
+func TestInspectorUnitService_AssignMany_SkipsAlreadyAssigned(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client) // f.Inspector уже закреплён за f.JkhUnit
+
+	second := createTestUser(t, client, "Inspector", "inspector2")
+	third := createTestUser(t, client, "Inspector", "inspector3")
+	coordinator := createTestUser(t, client, "Coordinator", "coordinator2")
+
+	svc := NewInspectorUnitService(client)
+	resp, err := svc.AssignMany(ctx, f.JkhUnit.ID, []int{f.Inspector.ID, second.ID, third.ID, coordinator.ID, 99999})
+	if err != nil {
+		t.Fatalf("AssignMany failed: %v", err)
+	}
+
+	expected := map[int]string{
+		f.Inspector.ID: BulkAssignAlreadyAssigned,
+		second.ID:      BulkAssignAssigned,
+		third.ID:       BulkAssignAssigned,
+		coordinator.ID: BulkAssignNotInspector,
+		99999:          BulkAssignNotFound,
+	}
+	if len(resp.Results) != len(expected) {
+		t.Fatalf("Expected %d results, got %d", len(expected), len(resp.Results))
+	}
+	for _, r := range resp.Results {
+		if r.Status != expected[r.InspectorID] {
+			t.Errorf("Inspector %d: expected %s, got %s", r.InspectorID, expected[r.InspectorID], r.Status)
+		}
+	}
+	if resp.Assigned != 2 {
+		t.Errorf("Expected 2 new assignments, got %d", resp.Assigned)
+	}
+
+	inspectors, err := svc.ListInspectorsForUnit(ctx, f.JkhUnit.ID)
+	if err != nil {
+		t.Fatalf("ListInspectorsForUnit failed: %v", err)
+	}
+	if len(inspectors) != 3 {
+		t.Errorf("Expected 3 inspectors assigned, got %d", len(inspectors))
+	}
+
+	if _, err := svc.AssignMany(ctx, 99999, []int{second.ID}); err != ErrJkhUnitNotFound {
+		t.Errorf("Expected ErrJkhUnitNotFound, got %v", err)
+	}
+}
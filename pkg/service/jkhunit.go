@@ -42,6 +42,7 @@ func (s *JkhUnitService) toJkhUnitResponse(j *ent.JkhUnit) *models.JkhUnitRespon
 		Name:         j.Name,
 		DistrictID:   j.DistrictID,
 		DistrictName: districtName,
+		Description:  j.Description,
 	}
 }
 
@@ -61,6 +62,7 @@ func (s *JkhUnitService) CreateJkhUnit(ctx context.Context, req models.CreateJkh
 	j, err := s.Client.JkhUnit.Create().
 		SetName(req.Name).
 		SetDistrictID(req.DistrictID).
+		SetNillableDescription(req.Description).
 		Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
@@ -125,10 +127,17 @@ func (s *JkhUnitService) UpdateJkhUnit(ctx context.Context, id int, req models.C
 		return nil, ErrDistrictFKNotFound
 	}
 
-	j, err := s.Client.JkhUnit.UpdateOneID(id).
+	update := s.Client.JkhUnit.UpdateOneID(id).
 		SetName(req.Name).
-		SetDistrictID(req.DistrictID).
-		Save(ctx)
+		SetDistrictID(req.DistrictID)
+
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	} else {
+		update.ClearDescription()
+	}
+
+	j, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, ErrJkhUnitNotFound
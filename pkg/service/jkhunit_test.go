@@ -164,3 +164,41 @@ func TestJkhUnitService_DeleteJkhUnit_Success(t *testing.T) {
 	}
 }
 
+
+func TestJkhUnitService_Description_SetAndClear(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	district, _ := NewDistrictService(client).CreateDistrict(ctx, models.CreateDistrictRequest{Name: "Центральный"})
+
+	svc := NewJkhUnitService(client)
+
+	desc := "Тел. диспетчерской: 8 (342) 200-00-00"
+	created, err := svc.CreateJkhUnit(ctx, models.CreateJkhUnitRequest{Name: "ЖЭУ-1", DistrictID: district.ID, Description: &desc})
+	if err != nil {
+		t.Fatalf("CreateJkhUnit failed: %v", err)
+	}
+	if created.Description != desc {
+		t.Errorf("Expected description %q, got %q", desc, created.Description)
+	}
+
+	// Уникально только название — одинаковое описание допустимо
+	if _, err := svc.CreateJkhUnit(ctx, models.CreateJkhUnitRequest{Name: "ЖЭУ-2", DistrictID: district.ID, Description: &desc}); err != nil {
+		t.Fatalf("Expected duplicate description to be allowed, got %v", err)
+	}
+
+	// nil при обновлении очищает описание
+	updated, err := svc.UpdateJkhUnit(ctx, created.ID, models.CreateJkhUnitRequest{Name: "ЖЭУ-1", DistrictID: district.ID})
+	if err != nil {
+		t.Fatalf("UpdateJkhUnit failed: %v", err)
+	}
+	if updated.Description != "" {
+		t.Errorf("Expected description to be cleared, got %q", updated.Description)
+	}
+
+	stored, _ := svc.RetrieveJkhUnit(ctx, created.ID)
+	if stored.Description != "" {
+		t.Errorf("Expected stored description to be cleared, got %q", stored.Description)
+	}
+}
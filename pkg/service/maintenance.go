@@ -0,0 +1,231 @@
+// pkg/service/maintenance.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"jkh/ent"
+	"jkh/ent/inspectionact"
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/logger"
+	"jkh/pkg/models"
+)
+
+// ============================================================================
+// ОШИБКИ БИЗНЕС-ЛОГИКИ
+// ============================================================================
+
+var (
+	// Срок хранения должен быть положительным (400 Bad Request).
+	ErrInvalidRetention = errors.New("retention period must be positive")
+)
+
+// ============================================================================
+// СЕРВИС
+// ============================================================================
+
+// MaintenanceService — служебные операции над данными (очистка устаревших записей,
+// нумерация старых актов).
+type MaintenanceService struct {
+	Client *ent.Client
+
+	// ActNumberPrefix — префикс номеров, присваиваемых старым актам (как при утверждении).
+	ActNumberPrefix string
+}
+
+func NewMaintenanceService(client *ent.Client) *MaintenanceService {
+	return &MaintenanceService{Client: client}
+}
+
+// ============================================================================
+// ОЧИСТКА
+// ============================================================================
+
+// PurgeOldData — безвозвратно удаляет отменённые задания, не изменявшиеся дольше olderThan,
+// вместе с их результатами осмотра, историей статусов и черновыми актами.
+// Задания с утверждённым актом не удаляются никогда.
+func (s *MaintenanceService) PurgeOldData(ctx context.Context, olderThan time.Duration) (*models.PurgeResult, error) {
+	if olderThan <= 0 {
+		return nil, ErrInvalidRetention
+	}
+	cutoff := time.Now().Add(-olderThan)
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	// 1. Отменённые задания за пределами срока хранения (без утверждённого акта)
+	taskIDs, err := tx.Task.Query().
+		Where(
+			task.StatusEQ(task.StatusCanceled),
+			task.UpdatedAtLT(cutoff),
+			task.Not(task.HasActWith(inspectionact.StatusEQ("утверждён"))),
+		).
+		IDs(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	result := &models.PurgeResult{TaskIDs: taskIDs}
+	if len(taskIDs) == 0 {
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("committing transaction: %w", err)
+		}
+		return result, nil
+	}
+
+	// 2. Черновые акты этих заданий (пути запоминаем, чтобы удалить файлы после коммита)
+	acts, err := tx.InspectionAct.Query().
+		Where(inspectionact.TaskIDIn(taskIDs...)).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	if result.ActsDeleted, err = tx.InspectionAct.Delete().
+		Where(inspectionact.TaskIDIn(taskIDs...)).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete draft acts: %w", err)
+	}
+
+	// 3. Результаты осмотра
+	if result.ResultsDeleted, err = tx.InspectionResult.Delete().
+		Where(inspectionresult.TaskIDIn(taskIDs...)).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete inspection results: %w", err)
+	}
+
+	// 4. Сами задания (история статусов удаляется каскадно)
+	if result.TasksDeleted, err = tx.Task.Delete().
+		Where(task.IDIn(taskIDs...)).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete tasks: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	for _, act := range acts {
+		if act.DocumentPath == "" {
+			continue
+		}
+		if err := os.Remove(act.DocumentPath); err != nil && !os.IsNotExist(err) {
+			logger.Warnf("failed to delete PDF of purged act %d: %v", act.ID, err)
+		}
+	}
+
+	logger.Infof("Purged %d canceled tasks older than %s (ids: %v), %d draft acts, %d results",
+		result.TasksDeleted, cutoff.Format(time.RFC3339), taskIDs, result.ActsDeleted, result.ResultsDeleted)
+
+	return result, nil
+}
+
+// ============================================================================
+// НУМЕРАЦИЯ СТАРЫХ АКТОВ
+// ============================================================================
+
+// BackfillActNumbers — присваивает номера утверждённым актам без номера (утверждённым
+// до введения нумерации): по каждому году в порядке даты утверждения, продолжая
+// счётчик года. Выполняется в одной транзакции; акты с номером не затрагиваются,
+// поэтому повторный запуск ничего не меняет. Сохранённые PDF перенумерованных актов
+// удаляются, чтобы при следующем скачивании акт был сформирован с новым номером.
+func (s *MaintenanceService) BackfillActNumbers(ctx context.Context) (*models.BackfillActNumbersResult, error) {
+	acts, err := s.Client.InspectionAct.Query().
+		Where(
+			inspectionact.StatusEQ("утверждён"),
+			inspectionact.ActNumberIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	result := &models.BackfillActNumbersResult{Acts: []models.BackfilledAct{}}
+	if len(acts) == 0 {
+		return result, nil
+	}
+
+	// Дата утверждения; у самых старых актов её может не быть — берём дату создания
+	approvedAt := func(act *ent.InspectionAct) time.Time {
+		if act.ApprovedAt.IsZero() {
+			return act.CreatedAt
+		}
+		return act.ApprovedAt
+	}
+	sort.SliceStable(acts, func(i, j int) bool {
+		ai, aj := approvedAt(acts[i]), approvedAt(acts[j])
+		if !ai.Equal(aj) {
+			return ai.Before(aj)
+		}
+		return acts[i].ID < acts[j].ID
+	})
+
+	for _, act := range acts {
+		if err := ensureActSequence(ctx, s.Client, approvedAt(act).Year()); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	for _, act := range acts {
+		year := approvedAt(act).Year()
+		seq, err := nextActSequence(ctx, tx, year)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		number := formatActNumber(s.ActNumberPrefix, year, seq)
+
+		// Условие на отсутствие номера — защита от параллельного запуска
+		n, err := tx.InspectionAct.Update().
+			Where(inspectionact.IDEQ(act.ID), inspectionact.ActNumberIsNil()).
+			SetActNumber(number).
+			ClearDocumentPath().
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to number act %d: %w", act.ID, err)
+		}
+		if n == 0 {
+			tx.Rollback()
+			return nil, fmt.Errorf("act %d was numbered concurrently", act.ID)
+		}
+
+		result.Acts = append(result.Acts, models.BackfilledAct{ActID: act.ID, TaskID: act.TaskID, ActNumber: number})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	result.ActsNumbered = len(result.Acts)
+
+	for _, act := range acts {
+		if act.DocumentPath == "" {
+			continue
+		}
+		if err := os.Remove(act.DocumentPath); err != nil && !os.IsNotExist(err) {
+			logger.Warnf("failed to delete PDF of renumbered act %d: %v", act.ID, err)
+		}
+	}
+
+	logger.Infof("Backfilled numbers for %d approved acts", result.ActsNumbered)
+	return result, nil
+}
@@ -0,0 +1,137 @@
+// pkg/service/maintenance_test.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/testutil"
+)
+
+func TestMaintenanceService_PurgeOldData(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	el := f.addElement(t, client, "Кровля", 1)
+	old := time.Now().AddDate(0, 0, -100)
+
+	// Старое отменённое задание с черновым актом и результатом — удаляется
+	oldCanceled := f.createTask(t, client, "Старое отменённое")
+	client.Task.UpdateOneID(oldCanceled.ID).SetStatus(task.StatusCanceled).SetUpdatedAt(old).ExecX(ctx)
+	client.InspectionResult.Create().
+		SetTaskID(oldCanceled.ID).
+		SetChecklistElementID(el.ID).
+		SetConditionStatus(inspectionresult.ConditionStatusИсправное).
+		SaveX(ctx)
+	client.InspectionAct.Create().SetTaskID(oldCanceled.ID).SaveX(ctx)
+
+	// Недавно отменённое — остаётся
+	recentCanceled := f.createTask(t, client, "Недавно отменённое")
+	client.Task.UpdateOneID(recentCanceled.ID).SetStatus(task.StatusCanceled).ExecX(ctx)
+
+	// Старое отменённое, но с утверждённым актом — остаётся
+	withApproved := f.createTask(t, client, "С утверждённым актом")
+	client.Task.UpdateOneID(withApproved.ID).SetStatus(task.StatusCanceled).SetUpdatedAt(old).ExecX(ctx)
+	client.InspectionAct.Create().SetTaskID(withApproved.ID).SetStatus("утверждён").SaveX(ctx)
+
+	// Старое, но не отменённое — остаётся
+	oldActive := f.createTask(t, client, "Старое активное")
+	client.Task.UpdateOneID(oldActive.ID).SetUpdatedAt(old).ExecX(ctx)
+
+	svc := NewMaintenanceService(client)
+	res, err := svc.PurgeOldData(ctx, 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("PurgeOldData failed: %v", err)
+	}
+
+	if res.TasksDeleted != 1 || res.ActsDeleted != 1 || res.ResultsDeleted != 1 {
+		t.Errorf("Expected 1 task, 1 act and 1 result deleted, got %+v", res)
+	}
+	if len(res.TaskIDs) != 1 || res.TaskIDs[0] != oldCanceled.ID {
+		t.Errorf("Expected purged ids [%d], got %v", oldCanceled.ID, res.TaskIDs)
+	}
+
+	if client.Task.Query().Where(task.IDEQ(oldCanceled.ID)).ExistX(ctx) {
+		t.Error("Expected old canceled task to be purged")
+	}
+	for _, id := range []int{recentCanceled.ID, withApproved.ID, oldActive.ID} {
+		if !client.Task.Query().Where(task.IDEQ(id)).ExistX(ctx) {
+			t.Errorf("Expected task %d to be kept", id)
+		}
+	}
+}
+
+func TestMaintenanceService_PurgeOldData_InvalidRetention(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	svc := NewMaintenanceService(client)
+	if _, err := svc.PurgeOldData(context.Background(), 0); err != ErrInvalidRetention {
+		t.Errorf("Expected ErrInvalidRetention, got %v", err)
+	}
+}
+
+func TestMaintenanceService_BackfillActNumbers(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	// Два старых утверждённых акта без номера: второй по ID утверждён раньше
+	approved := []time.Time{
+		time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+	}
+	var actIDs []int
+	for i, at := range approved {
+		tk := f.createTask(t, client, fmt.Sprintf("Осмотр %d", i+1))
+		act := client.InspectionAct.Create().
+			SetTaskID(tk.ID).
+			SetStatus("утверждён").
+			SetApprovedAt(at).
+			SaveX(ctx)
+		actIDs = append(actIDs, act.ID)
+	}
+	// Черновик номер не получает
+	draft := client.InspectionAct.Create().SetTaskID(f.createTask(t, client, "Черновик").ID).SaveX(ctx)
+
+	svc := NewMaintenanceService(client)
+	res, err := svc.BackfillActNumbers(ctx)
+	if err != nil {
+		t.Fatalf("BackfillActNumbers failed: %v", err)
+	}
+	if res.ActsNumbered != 2 {
+		t.Fatalf("Expected 2 numbered acts, got %d", res.ActsNumbered)
+	}
+
+	want := map[int]string{actIDs[1]: "2024-0001", actIDs[0]: "2024-0002"}
+	for id, number := range want {
+		act := client.InspectionAct.GetX(ctx, id)
+		if act.ActNumber == nil || *act.ActNumber != number {
+			t.Errorf("Act %d: expected number %s, got %v", id, number, act.ActNumber)
+		}
+	}
+	if client.InspectionAct.GetX(ctx, draft.ID).ActNumber != nil {
+		t.Error("Expected draft act to stay unnumbered")
+	}
+
+	// Повторный запуск ничего не меняет
+	res, err = svc.BackfillActNumbers(ctx)
+	if err != nil {
+		t.Fatalf("second BackfillActNumbers failed: %v", err)
+	}
+	if res.ActsNumbered != 0 || len(res.Acts) != 0 {
+		t.Errorf("Expected no changes on re-run, got %+v", res)
+	}
+	if got := *client.InspectionAct.GetX(ctx, actIDs[0]).ActNumber; got != "2024-0002" {
+		t.Errorf("Expected number to stay 2024-0002, got %s", got)
+	}
+}
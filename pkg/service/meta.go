@@ -0,0 +1,98 @@
+// pkg/service/meta.go
+
+package service
+
+import (
+	"unicode"
+
+	"jkh/ent/checklist"
+	"jkh/ent/inspectionact"
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/models"
+)
+
+// ============================================================================
+// ПОДПИСИ ПЕРЕЧИСЛЕНИЙ
+// ============================================================================
+
+var taskStatusLabels = []struct {
+	Value task.Status
+	Label string
+}{
+	{task.StatusNew, "Новое"},
+	{task.StatusPending, "Ожидает принятия"},
+	{task.StatusInProgress, "В работе"},
+	{task.StatusOnReview, "На проверке"},
+	{task.StatusForRevision, "На доработке"},
+	{task.StatusApproved, "Утверждено"},
+	{task.StatusCanceled, "Отменено"},
+}
+
+var conditionStatuses = []inspectionresult.ConditionStatus{
+	inspectionresult.ConditionStatusИсправное,
+	inspectionresult.ConditionStatusУдовлетворительное,
+	inspectionresult.ConditionStatusНеудовлетворительное,
+	inspectionresult.ConditionStatusАварийное,
+	inspectionresult.ConditionStatusНеприменимо,
+}
+
+var inspectionTypeLabels = []struct {
+	Value checklist.InspectionType
+	Label string
+}{
+	{checklist.InspectionTypeSpring, "Весенний осмотр"},
+	{checklist.InspectionTypeWinter, "Зимний осмотр"},
+	{checklist.InspectionTypePartial, "Частичный осмотр"},
+}
+
+var actLanguageLabels = []struct {
+	Value inspectionact.Language
+	Label string
+}{
+	{inspectionact.LanguageRu, "Русский"},
+	{inspectionact.LanguageEn, "Английский"},
+}
+
+// ============================================================================
+// СЕРВИС
+// ============================================================================
+
+// MetaService — справочные данные приложения (перечисления и их подписи).
+type MetaService struct{}
+
+func NewMetaService() *MetaService {
+	return &MetaService{}
+}
+
+// GetMeta — все перечисления с подписями. Значения берутся из сгенерированных пакетов Ent,
+// поэтому фронтенду не нужно дублировать их у себя.
+func (s *MetaService) GetMeta() *models.MetaResponse {
+	resp := &models.MetaResponse{}
+
+	for _, st := range taskStatusLabels {
+		resp.TaskStatuses = append(resp.TaskStatuses, models.EnumOption{Value: string(st.Value), Label: st.Label})
+	}
+	for _, p := range TaskPriorities {
+		resp.TaskPriorities = append(resp.TaskPriorities, models.EnumOption{Value: p, Label: capitalize(p)})
+	}
+	for _, cs := range conditionStatuses {
+		resp.ConditionStatuses = append(resp.ConditionStatuses, models.EnumOption{Value: string(cs), Label: conditionStatusLabel(cs)})
+	}
+	for _, it := range inspectionTypeLabels {
+		resp.InspectionTypes = append(resp.InspectionTypes, models.EnumOption{Value: string(it.Value), Label: it.Label})
+	}
+	for _, l := range actLanguageLabels {
+		resp.ActLanguages = append(resp.ActLanguages, models.EnumOption{Value: string(l.Value), Label: l.Label})
+	}
+
+	return resp
+}
+
+// capitalize — первая буква строки заглавная ("срочный" → "Срочный").
+func capitalize(s string) string {
+	for i, r := range s {
+		return string(unicode.ToUpper(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
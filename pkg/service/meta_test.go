@@ -0,0 +1,62 @@
+// pkg/service/meta_test.go
+
+package service
+
+import (
+	"testing"
+
+	"jkh/ent/checklist"
+	"jkh/ent/inspectionact"
+	"jkh/ent/inspectionresult"
+	"jkh/ent/schema"
+	"jkh/ent/task"
+	"jkh/pkg/models"
+)
+
+func TestMetaService_GetMeta_AllEnumsFilled(t *testing.T) {
+	meta := NewMetaService().GetMeta()
+
+	lists := map[string]struct {
+		options  []models.EnumOption
+		expected int
+		validate func(string) error
+	}{
+		"task_statuses": {meta.TaskStatuses, len(schema.Statuses), func(v string) error {
+			return task.StatusValidator(task.Status(v))
+		}},
+		"task_priorities": {meta.TaskPriorities, len(TaskPriorities), func(v string) error {
+			if !isValidPriority(v) {
+				return ErrInvalidPriority
+			}
+			return nil
+		}},
+		"condition_statuses": {meta.ConditionStatuses, len(schema.ConditionStatuses), func(v string) error {
+			return inspectionresult.ConditionStatusValidator(inspectionresult.ConditionStatus(v))
+		}},
+		"inspection_types": {meta.InspectionTypes, len(schema.InspectionTypes), func(v string) error {
+			return checklist.InspectionTypeValidator(checklist.InspectionType(v))
+		}},
+		"act_languages": {meta.ActLanguages, 2, func(v string) error {
+			return inspectionact.LanguageValidator(inspectionact.Language(v))
+		}},
+	}
+
+	for name, l := range lists {
+		if len(l.options) == 0 {
+			t.Errorf("%s: expected non-empty list", name)
+			continue
+		}
+		// Список должен покрывать все значения перечисления из схемы
+		if len(l.options) != l.expected {
+			t.Errorf("%s: expected %d values, got %d", name, l.expected, len(l.options))
+		}
+		for _, o := range l.options {
+			if err := l.validate(o.Value); err != nil {
+				t.Errorf("%s: invalid value %q: %v", name, o.Value, err)
+			}
+			if o.Label == "" {
+				t.Errorf("%s: empty label for %q", name, o.Value)
+			}
+		}
+	}
+}
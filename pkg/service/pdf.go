@@ -0,0 +1,104 @@
+// pkg/service/pdf.go
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"jkh/pkg/logger"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// ============================================================================
+// РАЗМЕТКА PDF
+// ============================================================================
+
+const (
+	// Поля страницы по умолчанию (мм) — совпадают со значениями gofpdf.
+	DefaultPDFTopMargin    = 10.0
+	DefaultPDFBottomMargin = 20.0
+
+	// minPDFBottomMargin — нижнее поле не может быть меньше высоты колонтитула.
+	minPDFBottomMargin = 12.0
+
+	// pdfPageCountAlias — подстановка общего числа страниц (заменяется при сохранении документа).
+	pdfPageCountAlias = "{nb}"
+)
+
+// PDFLayout — параметры страницы для генерируемых PDF (акты и аналитические отчёты).
+type PDFLayout struct {
+	TopMargin    float64 // Верхнее поле, мм
+	BottomMargin float64 // Нижнее поле, мм (в нём печатается колонтитул)
+	FontDir      string  // Каталог со шрифтами Times New Roman
+}
+
+// DefaultPDFLayout — разметка по умолчанию.
+func DefaultPDFLayout() PDFLayout {
+	return PDFLayout{
+		TopMargin:    DefaultPDFTopMargin,
+		BottomMargin: DefaultPDFBottomMargin,
+		FontDir:      "storage/fonts",
+	}
+}
+
+// PDFLayoutFromEnv — поля страницы из переменных JKH_PDF_MARGIN_TOP_MM и JKH_PDF_MARGIN_BOTTOM_MM.
+// При отсутствии или некорректном значении используется значение по умолчанию.
+func PDFLayoutFromEnv() PDFLayout {
+	layout := DefaultPDFLayout()
+	layout.TopMargin = pdfMarginFromEnv("JKH_PDF_MARGIN_TOP_MM", DefaultPDFTopMargin, 0)
+	layout.BottomMargin = pdfMarginFromEnv("JKH_PDF_MARGIN_BOTTOM_MM", DefaultPDFBottomMargin, minPDFBottomMargin)
+	return layout
+}
+
+func pdfMarginFromEnv(name string, def, min float64) float64 {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+	mm, err := strconv.ParseFloat(raw, 64)
+	if err != nil || mm < min || mm <= 0 {
+		logger.Warnf("invalid %s %q, using default %.0f", name, raw, def)
+		return def
+	}
+	return mm
+}
+
+// pdfFooterText — текст нумерации страниц в колонтитуле.
+func pdfFooterText(page int) string {
+	return fmt.Sprintf("Стр. %d из %s", page, pdfPageCountAlias)
+}
+
+// newPDFDocument — документ A4 с кириллическими шрифтами, заданными полями
+// и колонтитулом "Стр. X из N" (label печатается слева, например номер акта).
+func newPDFDocument(layout PDFLayout, label string) (*gofpdf.Fpdf, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+
+	pdf.AddUTF8Font("Times", "", filepath.Join(layout.FontDir, "timesnewromanpsmt.ttf"))
+	if err := pdf.Error(); err != nil {
+		return nil, fmt.Errorf("failed to load regular font: %w", err)
+	}
+	pdf.AddUTF8Font("Times", "B", filepath.Join(layout.FontDir, "ofont.ru_Times New Roman.ttf"))
+	if err := pdf.Error(); err != nil {
+		return nil, fmt.Errorf("failed to load bold font: %w", err)
+	}
+
+	pdf.SetTopMargin(layout.TopMargin)
+	pdf.SetAutoPageBreak(true, layout.BottomMargin)
+	pdf.AliasNbPages(pdfPageCountAlias)
+
+	pdf.SetFooterFunc(func() {
+		// Колонтитул — посередине нижнего поля
+		pdf.SetY(-layout.BottomMargin/2 - 3)
+		pdf.SetFont("Times", "", 9)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.CellFormat(0, 6, label, "", 0, "L", false, 0, "")
+		pdf.SetX(pdf.GetX() - 60)
+		pdf.CellFormat(60, 6, pdfFooterText(pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+
+	return pdf, nil
+}
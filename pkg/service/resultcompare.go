@@ -0,0 +1,193 @@
+// pkg/service/resultcompare.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"jkh/ent"
+	"jkh/ent/building"
+	"jkh/ent/inspectionresult"
+	"jkh/ent/task"
+	"jkh/pkg/models"
+)
+
+// ============================================================================
+// СРАВНЕНИЕ ДВУХ ОСМОТРОВ ЗДАНИЯ
+// ============================================================================
+
+var (
+	ErrTaskNotInBuilding = errors.New("task does not belong to building")
+)
+
+// Изменение состояния элемента между осмотрами A и B.
+const (
+	ComparisonImproved      = "improved"       // Состояние стало лучше
+	ComparisonWorsened      = "worsened"       // Состояние стало хуже
+	ComparisonUnchanged     = "unchanged"      // Состояние не изменилось
+	ComparisonNotComparable = "not_comparable" // Нет результата в одном из осмотров или "Неприменимо"
+)
+
+// conditionSeverity — тяжесть состояния (чем больше, тем хуже).
+// "Неприменимо" не сравнивается и в таблицу не входит.
+var conditionSeverity = map[inspectionresult.ConditionStatus]int{
+	inspectionresult.ConditionStatusИсправное:            0,
+	inspectionresult.ConditionStatusУдовлетворительное:   1,
+	inspectionresult.ConditionStatusНеудовлетворительное: 2,
+	inspectionresult.ConditionStatusАварийное:            3,
+}
+
+// compareConditions — направление изменения состояния от a к b (nil — результата нет).
+func compareConditions(a, b *inspectionresult.ConditionStatus) string {
+	if a == nil || b == nil {
+		return ComparisonNotComparable
+	}
+	sa, okA := conditionSeverity[*a]
+	sb, okB := conditionSeverity[*b]
+	switch {
+	case !okA || !okB:
+		if *a == *b {
+			return ComparisonUnchanged
+		}
+		return ComparisonNotComparable
+	case sb < sa:
+		return ComparisonImproved
+	case sb > sa:
+		return ComparisonWorsened
+	default:
+		return ComparisonUnchanged
+	}
+}
+
+// CompareTasks — поэлементное сравнение результатов двух осмотров одного здания.
+// Элементы сопоставляются по справочнику (element_id), поэтому осмотры могут
+// проводиться по разным чек-листам. Оба задания должны относиться к зданию buildingID.
+func (s *InspectionResultService) CompareTasks(ctx context.Context, buildingID, taskAID, taskBID int) (*models.TaskComparisonResponse, error) {
+	exists, err := s.Client.Building.Query().Where(building.IDEQ(buildingID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if !exists {
+		return nil, ErrBuildingNotFound
+	}
+
+	taskA, err := s.comparedTask(ctx, buildingID, taskAID)
+	if err != nil {
+		return nil, err
+	}
+	taskB, err := s.comparedTask(ctx, buildingID, taskBID)
+	if err != nil {
+		return nil, err
+	}
+
+	type side struct {
+		element *ent.ElementCatalog
+		order   int
+		a, b    *inspectionresult.ConditionStatus
+	}
+	byElement := make(map[int]*side)
+
+	collect := func(taskID int, set func(*side, *inspectionresult.ConditionStatus)) error {
+		results, err := s.Client.InspectionResult.Query().
+			Where(inspectionresult.TaskIDEQ(taskID)).
+			WithChecklistElement(func(q *ent.ChecklistElementQuery) {
+				q.WithElementCatalog()
+			}).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("database error: %w", err)
+		}
+		for _, r := range results {
+			ce := r.Edges.ChecklistElement
+			if ce == nil {
+				continue
+			}
+			row, ok := byElement[ce.ElementID]
+			if !ok {
+				row = &side{element: ce.Edges.ElementCatalog, order: ce.OrderIndex}
+				byElement[ce.ElementID] = row
+			}
+			status := r.ConditionStatus
+			set(row, &status)
+		}
+		return nil
+	}
+	if err := collect(taskA.ID, func(r *side, st *inspectionresult.ConditionStatus) { r.a = st }); err != nil {
+		return nil, err
+	}
+	if err := collect(taskB.ID, func(r *side, st *inspectionresult.ConditionStatus) { r.b = st }); err != nil {
+		return nil, err
+	}
+
+	resp := &models.TaskComparisonResponse{
+		BuildingID: buildingID,
+		TaskA:      toComparedTask(taskA),
+		TaskB:      toComparedTask(taskB),
+		Elements:   []models.ElementComparison{},
+	}
+
+	for elementID, row := range byElement {
+		item := models.ElementComparison{
+			ElementID:  elementID,
+			OrderIndex: row.order,
+			Change:     compareConditions(row.a, row.b),
+		}
+		if row.element != nil {
+			item.ElementName = row.element.Name
+			item.ElementCategory = row.element.Category
+		}
+		if row.a != nil {
+			item.StatusA = string(*row.a)
+		}
+		if row.b != nil {
+			item.StatusB = string(*row.b)
+		}
+
+		switch item.Change {
+		case ComparisonImproved:
+			resp.Improved++
+		case ComparisonWorsened:
+			resp.Worsened++
+		case ComparisonUnchanged:
+			resp.Unchanged++
+		}
+		resp.Elements = append(resp.Elements, item)
+	}
+
+	sort.Slice(resp.Elements, func(i, j int) bool {
+		ei, ej := resp.Elements[i], resp.Elements[j]
+		if ei.OrderIndex != ej.OrderIndex {
+			return ei.OrderIndex < ej.OrderIndex
+		}
+		return ei.ElementID < ej.ElementID
+	})
+
+	return resp, nil
+}
+
+// comparedTask — задание для сравнения с проверкой принадлежности зданию.
+func (s *InspectionResultService) comparedTask(ctx context.Context, buildingID, taskID int) (*ent.Task, error) {
+	t, err := s.Client.Task.Query().Where(task.IDEQ(taskID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if t.BuildingID != buildingID {
+		return nil, ErrTaskNotInBuilding
+	}
+	return t, nil
+}
+
+func toComparedTask(t *ent.Task) models.ComparedTask {
+	return models.ComparedTask{
+		ID:            t.ID,
+		Title:         t.Title,
+		Status:        string(t.Status),
+		ScheduledDate: t.ScheduledDate.Format("2006-01-02"),
+	}
+}
@@ -0,0 +1,79 @@
+// pkg/service/resultcompare_test.go
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"jkh/ent/inspectionresult"
+	"jkh/pkg/testutil"
+)
+
+func TestInspectionResultService_CompareTasks_Worsened(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	roof := f.addElement(t, client, "Кровля", 1)
+	facade := f.addElement(t, client, "Фасад", 2)
+	lift := f.addElement(t, client, "Лифт", 3)
+
+	earlier := f.createTask(t, client, "Весна")
+	later := f.createTask(t, client, "Осень")
+
+	set := func(taskID, elementID int, status inspectionresult.ConditionStatus) {
+		client.InspectionResult.Create().
+			SetTaskID(taskID).
+			SetChecklistElementID(elementID).
+			SetConditionStatus(status).
+			SaveX(ctx)
+	}
+	set(earlier.ID, roof.ID, inspectionresult.ConditionStatusУдовлетворительное)
+	set(later.ID, roof.ID, inspectionresult.ConditionStatusАварийное)
+	set(earlier.ID, facade.ID, inspectionresult.ConditionStatusИсправное)
+	set(later.ID, facade.ID, inspectionresult.ConditionStatusИсправное)
+	set(later.ID, lift.ID, inspectionresult.ConditionStatusИсправное)
+
+	svc := NewInspectionResultService(client)
+	resp, err := svc.CompareTasks(ctx, f.Building.ID, earlier.ID, later.ID)
+	if err != nil {
+		t.Fatalf("CompareTasks failed: %v", err)
+	}
+
+	if len(resp.Elements) != 3 {
+		t.Fatalf("Expected 3 compared elements, got %d", len(resp.Elements))
+	}
+	want := []struct{ name, a, b, change string }{
+		{"Кровля", "Удовлетворительное", "Аварийное", ComparisonWorsened},
+		{"Фасад", "Исправное", "Исправное", ComparisonUnchanged},
+		{"Лифт", "", "Исправное", ComparisonNotComparable},
+	}
+	for i, w := range want {
+		got := resp.Elements[i]
+		if got.ElementName != w.name || got.StatusA != w.a || got.StatusB != w.b || got.Change != w.change {
+			t.Errorf("Element %d: expected %+v, got %+v", i, w, got)
+		}
+	}
+	if resp.Worsened != 1 || resp.Unchanged != 1 || resp.Improved != 0 {
+		t.Errorf("Unexpected totals: worsened=%d unchanged=%d improved=%d", resp.Worsened, resp.Unchanged, resp.Improved)
+	}
+
+	// Задание другого здания сравнивать нельзя
+	other := client.Building.Create().
+		SetAddress("ул. Соседняя, д. 2").
+		SetDistrictID(f.District.ID).
+		SetJkhUnitID(f.JkhUnit.ID).
+		SaveX(ctx)
+	foreign := client.Task.Create().
+		SetBuildingID(other.ID).
+		SetChecklistID(f.Checklist.ID).
+		SetInspectorID(f.Inspector.ID).
+		SetTitle("Чужое").
+		SetScheduledDate(earlier.ScheduledDate).
+		SaveX(ctx)
+	if _, err := svc.CompareTasks(ctx, f.Building.ID, earlier.ID, foreign.ID); err != ErrTaskNotInBuilding {
+		t.Errorf("Expected ErrTaskNotInBuilding, got %v", err)
+	}
+}
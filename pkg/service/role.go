@@ -0,0 +1,40 @@
+// pkg/service/role.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"jkh/ent"
+	"jkh/ent/role"
+	"jkh/pkg/logger"
+	"jkh/pkg/models"
+)
+
+// RoleService отвечает за чтение справочника ролей
+type RoleService struct {
+	Client *ent.Client
+}
+
+// Конструктор
+func NewRoleService(client *ent.Client) *RoleService {
+	return &RoleService{Client: client}
+}
+
+// ListRoles — список всех ролей (в порядке создания)
+func (s *RoleService) ListRoles(ctx context.Context) ([]*models.RoleResponse, error) {
+	roles, err := s.Client.Role.Query().
+		Order(ent.Asc(role.FieldID)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("DB error listing roles: %v", err)
+		return nil, fmt.Errorf("database error")
+	}
+
+	resp := make([]*models.RoleResponse, len(roles))
+	for i, r := range roles {
+		resp[i] = &models.RoleResponse{ID: r.ID, Name: r.Name}
+	}
+	return resp, nil
+}
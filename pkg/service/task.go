@@ -4,15 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"os"
+	"sort"
+	"strconv"
 	"time"
 
 	"jkh/ent"
 	"jkh/ent/building"
 	"jkh/ent/checklist"
 	"jkh/ent/inspectorunit"
+	"jkh/ent/jkhunit"
 	"jkh/ent/task"
+	"jkh/ent/taskchecklist"
+	"jkh/ent/taskstatushistory"
 	"jkh/ent/user"
+	"jkh/pkg/logger"
 	"jkh/pkg/models"
 )
 
@@ -26,8 +32,31 @@ var (
 	ErrInspectorNotAssigned    = errors.New("inspector not assigned to building's JKH unit")
 	ErrInvalidStatusTransition = errors.New("invalid status transition")
 	ErrUnauthorizedAction      = errors.New("unauthorized to perform this action")
+	ErrDuplicateChecklist      = errors.New("checklist is attached to the task more than once")
+	ErrInvalidPriority         = errors.New("invalid task priority")
+	ErrInvalidStatusFilter     = errors.New("invalid task status filter")
 )
 
+// ============================================================================
+// ПРИОРИТЕТЫ
+// ============================================================================
+
+// TaskPriorities — допустимые значения приоритета задания.
+var TaskPriorities = []string{"срочный", "высокий", "обычный", "низкий"}
+
+// DefaultTaskPriority — приоритет, назначаемый, если он не указан при создании задания.
+const DefaultTaskPriority = "обычный"
+
+// isValidPriority проверяет, входит ли priority в TaskPriorities.
+func isValidPriority(priority string) bool {
+	for _, p := range TaskPriorities {
+		if p == priority {
+			return true
+		}
+	}
+	return false
+}
+
 // ============================================================================
 // FSM — КОНЕЧНЫЙ АВТОМАТ СОСТОЯНИЙ
 // ============================================================================
@@ -61,12 +90,76 @@ func isTransitionAllowed(currentStatus, newStatus task.Status) bool {
 // СЕРВИС
 // ============================================================================
 
+// DefaultAcceptanceSLA — срок, в течение которого инспектор должен принять задание (Pending → InProgress).
+const DefaultAcceptanceSLA = 48 * time.Hour
+
 type TaskService struct {
 	Client *ent.Client
+
+	// AcceptanceSLA — допустимое время нахождения задания в статусе Pending.
+	AcceptanceSLA time.Duration
+
+	// DefaultPriority — приоритет новых заданий, если он не указан в запросе.
+	DefaultPriority string
+
+	// AutoDraftAct — создавать черновик акта уже при начале работы (Pending → InProgress),
+	// чтобы инспектор мог посмотреть акт до отправки на проверку.
+	AutoDraftAct bool
+
+	// ActNumberPrefix — префикс номера акта, присваиваемого при утверждении задания.
+	ActNumberPrefix string
 }
 
 func NewTaskService(client *ent.Client) *TaskService {
-	return &TaskService{Client: client}
+	return &TaskService{
+		Client:          client,
+		AcceptanceSLA:   DefaultAcceptanceSLA,
+		DefaultPriority: DefaultTaskPriority,
+	}
+}
+
+// AcceptanceSLAFromEnv — срок принятия задания из переменной JKH_ACCEPTANCE_SLA_HOURS
+// (в часах). При отсутствии или некорректном значении используется DefaultAcceptanceSLA.
+func AcceptanceSLAFromEnv() time.Duration {
+	raw := os.Getenv("JKH_ACCEPTANCE_SLA_HOURS")
+	if raw == "" {
+		return DefaultAcceptanceSLA
+	}
+	hours, err := strconv.Atoi(raw)
+	if err != nil || hours < 1 {
+		logger.Warnf("invalid JKH_ACCEPTANCE_SLA_HOURS %q, using default %s", raw, DefaultAcceptanceSLA)
+		return DefaultAcceptanceSLA
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// DefaultPriorityFromEnv — приоритет новых заданий из переменной JKH_DEFAULT_TASK_PRIORITY.
+// При отсутствии или недопустимом значении используется DefaultTaskPriority.
+func DefaultPriorityFromEnv() string {
+	raw := os.Getenv("JKH_DEFAULT_TASK_PRIORITY")
+	if raw == "" {
+		return DefaultTaskPriority
+	}
+	if !isValidPriority(raw) {
+		logger.Warnf("invalid JKH_DEFAULT_TASK_PRIORITY %q, using default %q", raw, DefaultTaskPriority)
+		return DefaultTaskPriority
+	}
+	return raw
+}
+
+// AutoDraftActFromEnv — включение черновика акта при начале работы из переменной
+// JKH_AUTO_DRAFT_ACT (true/false). По умолчанию выключено.
+func AutoDraftActFromEnv() bool {
+	raw := os.Getenv("JKH_AUTO_DRAFT_ACT")
+	if raw == "" {
+		return false
+	}
+	enabled, err := strconv.ParseBool(raw)
+	if err != nil {
+		logger.Warnf("invalid JKH_AUTO_DRAFT_ACT %q, auto draft act disabled", raw)
+		return false
+	}
+	return enabled
 }
 
 // ============================================================================
@@ -111,6 +204,8 @@ func (s *TaskService) toTaskDetailResponse(t *ent.Task) *models.TaskDetailRespon
 		ScheduledDate: t.ScheduledDate.Format(time.RFC3339),
 		CreatedAt:     t.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:     t.UpdatedAt.Format(time.RFC3339),
+
+		AdditionalChecklists: []models.ChecklistInfo{},
 	}
 
 	// Заполняем детальную информацию о связанных сущностях
@@ -127,6 +222,16 @@ func (s *TaskService) toTaskDetailResponse(t *ent.Task) *models.TaskDetailRespon
 			InspectionType: string(t.Edges.Checklist.InspectionType),
 		}
 	}
+	for _, link := range t.Edges.ExtraChecklists {
+		if link.Edges.Checklist == nil {
+			continue
+		}
+		resp.AdditionalChecklists = append(resp.AdditionalChecklists, models.ChecklistInfo{
+			ID:             link.Edges.Checklist.ID,
+			Title:          link.Edges.Checklist.Title,
+			InspectionType: string(link.Edges.Checklist.InspectionType),
+		})
+	}
 	if t.Edges.Inspector != nil {
 		resp.Inspector = models.InspectorInfo{
 			ID:        t.Edges.Inspector.ID,
@@ -139,6 +244,63 @@ func (s *TaskService) toTaskDetailResponse(t *ent.Task) *models.TaskDetailRespon
 	return resp
 }
 
+// queryStatusHistory — история переходов статуса задания (в хронологическом порядке)
+// вместе с пользователями, выполнившими переход.
+func queryStatusHistory(ctx context.Context, client *ent.Client, taskID int) ([]*ent.TaskStatusHistory, error) {
+	return client.TaskStatusHistory.Query().
+		Where(taskstatushistory.TaskIDEQ(taskID)).
+		WithChangedByUser().
+		Order(ent.Asc(taskstatushistory.FieldChangedAt), ent.Asc(taskstatushistory.FieldID)).
+		All(ctx)
+}
+
+// withExtraChecklists — загрузка дополнительных чек-листов задания (в порядке добавления).
+func withExtraChecklists(q *ent.TaskChecklistQuery) {
+	q.WithChecklist().Order(ent.Asc(taskchecklist.FieldID))
+}
+
+// taskChecklistIDs — ID всех чек-листов задания: основной первым, затем дополнительные
+// в порядке добавления.
+func taskChecklistIDs(ctx context.Context, client *ent.Client, t *ent.Task) ([]int, error) {
+	extra, err := client.TaskChecklist.Query().
+		Where(taskchecklist.TaskIDEQ(t.ID)).
+		Order(ent.Asc(taskchecklist.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	ids := make([]int, 0, len(extra)+1)
+	ids = append(ids, t.ChecklistID)
+	for _, link := range extra {
+		ids = append(ids, link.ChecklistID)
+	}
+	return ids, nil
+}
+
+// toActorInfo — преобразование пользователя в краткий DTO (nil, если пользователь неизвестен).
+func toActorInfo(u *ent.User) *models.ActorInfo {
+	if u == nil {
+		return nil
+	}
+	return &models.ActorInfo{
+		ID:        u.ID,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Email:     u.Email,
+	}
+}
+
+// toStatusHistoryEntry — преобразование записи истории статусов в DTO.
+func toStatusHistoryEntry(h *ent.TaskStatusHistory) models.StatusHistoryEntry {
+	return models.StatusHistoryEntry{
+		FromStatus: h.FromStatus,
+		ToStatus:   h.ToStatus,
+		ChangedBy:  toActorInfo(h.Edges.ChangedByUser),
+		ChangedAt:  h.ChangedAt.Format(time.RFC3339),
+	}
+}
+
 // validateForeignKeys — проверка существования связанных сущностей.
 func (s *TaskService) validateForeignKeys(ctx context.Context, buildingID, checklistID, inspectorID int) error {
 	// Проверка Building
@@ -162,18 +324,55 @@ func (s *TaskService) validateForeignKeys(ctx context.Context, buildingID, check
 	return nil
 }
 
+// validateAdditionalChecklists — проверка дополнительных чек-листов: существуют,
+// не совпадают с основным и не повторяются.
+func (s *TaskService) validateAdditionalChecklists(ctx context.Context, mainChecklistID int, ids []int) error {
+	seen := map[int]bool{mainChecklistID: true}
+	for _, id := range ids {
+		if seen[id] {
+			return ErrDuplicateChecklist
+		}
+		seen[id] = true
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	count, err := s.Client.Checklist.Query().Where(checklist.IDIn(ids...)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if count != len(ids) {
+		return ErrInvalidForeignKey
+	}
+	return nil
+}
+
 // ============================================================================
 // CRUD-ОПЕРАЦИИ
 // ============================================================================
 
 // CreateTask — создание нового задания (доступно для Coordinator и Specialist).
 func (s *TaskService) CreateTask(ctx context.Context, req models.CreateTaskRequest) (*models.TaskDetailResponse, error) {
-	// 1. Валидация FK
+	// 1. Валидация приоритета (не полагаемся только на binding DTO)
+	priority := req.Priority
+	if priority == "" {
+		priority = s.DefaultPriority
+	}
+	if !isValidPriority(priority) {
+		return nil, ErrInvalidPriority
+	}
+
+	// 1.1. Валидация FK
 	if err := s.validateForeignKeys(ctx, req.BuildingID, req.ChecklistID, req.InspectorID); err != nil {
 		return nil, err
 	}
 
-	// 1.1. Проверка, что инспектор закреплён за JKH unit здания
+	if err := s.validateAdditionalChecklists(ctx, req.ChecklistID, req.AdditionalChecklistIDs); err != nil {
+		return nil, err
+	}
+
+	// 1.2. Проверка, что инспектор закреплён за JKH unit здания
 	b, err := s.Client.Building.Query().Where(building.IDEQ(req.BuildingID)).Only(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("database error: %w", err)
@@ -200,14 +399,13 @@ func (s *TaskService) CreateTask(ctx context.Context, req models.CreateTaskReque
 		return nil, fmt.Errorf("invalid scheduled_date format (use ISO 8601)")
 	}
 
-	// 3. Установка приоритета по умолчанию
-	priority := req.Priority
-	if priority == "" {
-		priority = "обычный"
+	// 3. Создание задания и привязка дополнительных чек-листов — в одной транзакции
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
 	}
 
-	// 4. Создание задания
-	create := s.Client.Task.Create().
+	create := tx.Task.Create().
 		SetBuildingID(req.BuildingID).
 		SetChecklistID(req.ChecklistID).
 		SetInspectorID(req.InspectorID).
@@ -222,15 +420,33 @@ func (s *TaskService) CreateTask(ctx context.Context, req models.CreateTaskReque
 
 	t, err := create.Save(ctx)
 	if err != nil {
-		log.Printf("DB error creating task: %v", err)
+		tx.Rollback()
+		logger.Errorf("DB error creating task: %v", err)
 		return nil, fmt.Errorf("database error")
 	}
 
-	// 5. Догружаем связи для ответа
+	if len(req.AdditionalChecklistIDs) > 0 {
+		links := make([]*ent.TaskChecklistCreate, len(req.AdditionalChecklistIDs))
+		for i, checklistID := range req.AdditionalChecklistIDs {
+			links[i] = tx.TaskChecklist.Create().SetTaskID(t.ID).SetChecklistID(checklistID)
+		}
+		if err := tx.TaskChecklist.CreateBulk(links...).Exec(ctx); err != nil {
+			tx.Rollback()
+			logger.Errorf("DB error attaching checklists to task: %v", err)
+			return nil, fmt.Errorf("database error")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	// 4. Догружаем связи для ответа
 	t, err = s.Client.Task.Query().
 		Where(task.IDEQ(t.ID)).
 		WithBuilding().
 		WithChecklist().
+		WithExtraChecklists(withExtraChecklists).
 		WithInspector().
 		Only(ctx)
 	if err != nil {
@@ -243,8 +459,12 @@ func (s *TaskService) CreateTask(ctx context.Context, req models.CreateTaskReque
 // ListTasks — получение списка заданий.
 // Параметры:
 //   - inspectorID: если указан, возвращаются только задания этого инспектора
-//   - status: фильтр по статусу (опционально)
+//   - status: фильтр по статусу (опционально); неизвестное значение — ErrInvalidStatusFilter
 func (s *TaskService) ListTasks(ctx context.Context, inspectorID *int, status *string) ([]*models.TaskResponse, error) {
+	if status != nil && task.StatusValidator(task.Status(*status)) != nil {
+		return nil, ErrInvalidStatusFilter
+	}
+
 	query := s.Client.Task.Query().
 		WithBuilding().
 		WithChecklist().
@@ -273,12 +493,266 @@ func (s *TaskService) ListTasks(ctx context.Context, inspectorID *int, status *s
 	return resp, nil
 }
 
+// AssignableInspectors — инспекторы, которых можно назначить на задание по зданию:
+// закреплённые за ЖЭУ здания. Если у здания нет ЖЭУ, возвращается пустой список.
+func (s *TaskService) AssignableInspectors(ctx context.Context, buildingID int) ([]*models.UserResponse, error) {
+	b, err := s.Client.Building.Query().Where(building.IDEQ(buildingID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBuildingNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	if b.JkhUnitID == 0 {
+		return []*models.UserResponse{}, nil
+	}
+
+	return NewInspectorUnitService(s.Client).ListInspectorsForUnit(ctx, b.JkhUnitID)
+}
+
+// ListUnacceptedBeyondSLA — задания, находящиеся в статусе Pending дольше AcceptanceSLA
+// (инспектор не принял задание). Время ожидания отсчитывается от последнего перехода
+// в Pending, а при отсутствии истории — от даты создания. Самые давние — первыми.
+func (s *TaskService) ListUnacceptedBeyondSLA(ctx context.Context) ([]*models.OverdueTaskResponse, error) {
+	tasks, err := s.Client.Task.Query().
+		Where(task.StatusEQ(task.StatusPending)).
+		WithBuilding().
+		WithChecklist().
+		WithInspector().
+		WithStatusHistory(func(q *ent.TaskStatusHistoryQuery) {
+			q.Where(taskstatushistory.ToStatusEQ(string(task.StatusPending)))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	type overdueTask struct {
+		task  *ent.Task
+		since time.Time
+	}
+
+	now := time.Now()
+	var overdue []overdueTask
+	for _, t := range tasks {
+		since := t.CreatedAt
+		for i, h := range t.Edges.StatusHistory {
+			if i == 0 || h.ChangedAt.After(since) {
+				since = h.ChangedAt
+			}
+		}
+		if now.Sub(since) > s.AcceptanceSLA {
+			overdue = append(overdue, overdueTask{task: t, since: since})
+		}
+	}
+
+	sort.Slice(overdue, func(i, j int) bool {
+		return overdue[i].since.Before(overdue[j].since)
+	})
+
+	resp := make([]*models.OverdueTaskResponse, len(overdue))
+	for i, o := range overdue {
+		resp[i] = &models.OverdueTaskResponse{
+			Task:         s.toTaskResponse(o.task),
+			PendingSince: o.since.Format("2006-01-02T15:04:05Z07:00"),
+			OverdueHours: int((now.Sub(o.since) - s.AcceptanceSLA).Hours()),
+		}
+	}
+
+	return resp, nil
+}
+
+// reviewQueueItem — задание на проверке и момент его отправки инспектором.
+type reviewQueueItem struct {
+	task        *ent.Task
+	submittedAt time.Time
+}
+
+// reviewQueue — очередь на утверждение: задания в статусе OnReview, самые давние первыми.
+// Момент отправки — последний переход в OnReview (после доработки задание отправляется
+// повторно), а при отсутствии истории — дата последнего изменения задания.
+func (s *TaskService) reviewQueue(ctx context.Context) ([]reviewQueueItem, error) {
+	tasks, err := s.Client.Task.Query().
+		Where(task.StatusEQ(task.StatusOnReview)).
+		WithBuilding().
+		WithChecklist().
+		WithInspector().
+		WithStatusHistory(func(q *ent.TaskStatusHistoryQuery) {
+			q.Where(taskstatushistory.ToStatusEQ(string(task.StatusOnReview)))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	queue := make([]reviewQueueItem, len(tasks))
+	for i, t := range tasks {
+		submitted := t.UpdatedAt
+		for j, h := range t.Edges.StatusHistory {
+			if j == 0 || h.ChangedAt.After(submitted) {
+				submitted = h.ChangedAt
+			}
+		}
+		queue[i] = reviewQueueItem{task: t, submittedAt: submitted}
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		if !queue[i].submittedAt.Equal(queue[j].submittedAt) {
+			return queue[i].submittedAt.Before(queue[j].submittedAt)
+		}
+		return queue[i].task.ID < queue[j].task.ID
+	})
+
+	return queue, nil
+}
+
+// ReviewQueueByInspector — очередь на утверждение, сгруппированная по инспекторам:
+// число заданий и возраст самой давней отправки в каждой группе. Первыми идут группы
+// с самой давней отправкой, задания внутри группы — от старых к новым.
+func (s *TaskService) ReviewQueueByInspector(ctx context.Context) ([]*models.ReviewQueueGroup, error) {
+	queue, err := s.reviewQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	groups := []*models.ReviewQueueGroup{}
+	byInspector := make(map[int]*models.ReviewQueueGroup)
+	for _, item := range queue {
+		t := item.task
+		g, ok := byInspector[t.InspectorID]
+		if !ok {
+			// Очередь отсортирована, поэтому первое задание группы — самое давнее
+			g = &models.ReviewQueueGroup{
+				InspectorID:       t.InspectorID,
+				OldestSubmittedAt: item.submittedAt.Format(time.RFC3339),
+				OldestAgeHours:    int(now.Sub(item.submittedAt).Hours()),
+				Tasks:             []*models.TaskResponse{},
+			}
+			if t.Edges.Inspector != nil {
+				g.InspectorName = fmt.Sprintf("%s %s", t.Edges.Inspector.FirstName, t.Edges.Inspector.LastName)
+			}
+			byInspector[t.InspectorID] = g
+			groups = append(groups, g)
+		}
+		g.Count++
+		g.Tasks = append(g.Tasks, s.toTaskResponse(t))
+	}
+
+	return groups, nil
+}
+
+// Параметры пагинации по умолчанию
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// activeTaskStatuses — статусы незавершённых заданий (учитываются в загрузке инспектора).
+var activeTaskStatuses = []task.Status{
+	task.StatusNew,
+	task.StatusPending,
+	task.StatusInProgress,
+	task.StatusOnReview,
+	task.StatusForRevision,
+}
+
+// activeTaskCounts — количество активных заданий по инспекторам (агрегация GROUP BY inspector_id).
+// Инспекторы без активных заданий в результат не попадают.
+func activeTaskCounts(ctx context.Context, client *ent.Client, inspectorIDs []int) (map[int]int, error) {
+	var rows []struct {
+		InspectorID int `json:"inspector_id"`
+		Count       int `json:"count"`
+	}
+	err := client.Task.Query().
+		Where(
+			task.InspectorIDIn(inspectorIDs...),
+			task.StatusIn(activeTaskStatuses...),
+		).
+		GroupBy(task.FieldInspectorID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(rows))
+	for _, r := range rows {
+		counts[r.InspectorID] = r.Count
+	}
+	return counts, nil
+}
+
+// ListTasksByUnit — постраничный список заданий по зданиям указанного ЖЭУ
+// (задание → здание → ЖЭУ) с опциональным фильтром по статусу.
+func (s *TaskService) ListTasksByUnit(ctx context.Context, jkhUnitID int, filter models.TaskListFilter) (*models.TaskListResponse, error) {
+	// 1. Проверка существования ЖЭУ
+	exists, err := s.Client.JkhUnit.Query().Where(jkhunit.IDEQ(jkhUnitID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if !exists {
+		return nil, ErrJkhUnitNotFound
+	}
+
+	// 2. Нормализация пагинации
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = defaultPageSize
+	}
+	if filter.PageSize > maxPageSize {
+		filter.PageSize = maxPageSize
+	}
+
+	// 3. Базовый запрос с фильтрами
+	query := s.Client.Task.Query().
+		Where(task.HasBuildingWith(building.JkhUnitIDEQ(jkhUnitID)))
+
+	if filter.Status != nil {
+		query = query.Where(task.StatusEQ(task.Status(*filter.Status)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	// 4. Страница заданий (сначала ближайшие по дате)
+	tasks, err := query.
+		WithBuilding().
+		WithChecklist().
+		WithInspector().
+		Order(ent.Desc(task.FieldScheduledDate), ent.Desc(task.FieldID)).
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	resp := &models.TaskListResponse{
+		Items:    make([]*models.TaskResponse, len(tasks)),
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}
+	for i, t := range tasks {
+		resp.Items[i] = s.toTaskResponse(t)
+	}
+
+	return resp, nil
+}
+
 // RetrieveTask — получение детальной информации о задании.
 func (s *TaskService) RetrieveTask(ctx context.Context, id int) (*models.TaskDetailResponse, error) {
 	t, err := s.Client.Task.Query().
 		Where(task.IDEQ(id)).
 		WithBuilding().
 		WithChecklist().
+		WithExtraChecklists(withExtraChecklists).
 		WithInspector().
 		Only(ctx)
 
@@ -293,7 +767,18 @@ func (s *TaskService) RetrieveTask(ctx context.Context, id int) (*models.TaskDet
 }
 
 // UpdateTaskStatus — изменение статуса задания (с проверкой FSM).
-func (s *TaskService) UpdateTaskStatus(ctx context.Context, id int, newStatus task.Status) error {
+// actorID — пользователь, выполняющий переход (0, если неизвестен); сохраняется в истории статусов.
+func (s *TaskService) UpdateTaskStatus(ctx context.Context, id int, newStatus task.Status, actorID int) error {
+	return s.updateTaskStatus(ctx, id, newStatus, actorID, nil)
+}
+
+// ApproveTask — утверждение задания (OnReview → Approved) с необязательным
+// комментарием координатора, который сохраняется на акте осмотра.
+func (s *TaskService) ApproveTask(ctx context.Context, id, actorID int, approvalComment *string) error {
+	return s.updateTaskStatus(ctx, id, task.StatusApproved, actorID, approvalComment)
+}
+
+func (s *TaskService) updateTaskStatus(ctx context.Context, id int, newStatus task.Status, actorID int, approvalComment *string) error {
 	// 1. Получение текущего задания
 	t, err := s.Client.Task.Query().Where(task.IDEQ(id)).Only(ctx)
 	if err != nil {
@@ -308,41 +793,94 @@ func (s *TaskService) UpdateTaskStatus(ctx context.Context, id int, newStatus ta
 		return ErrInvalidStatusTransition
 	}
 
-	// 3. Обновление статуса
-	err = s.Client.Task.UpdateOneID(id).
-		SetStatus(newStatus).
-		Exec(ctx)
+	// 2.1. На проверку можно отправить только полностью заполненный чек-лист
+	// с фотографиями проблемных элементов, для которых фото обязательно
+	if newStatus == task.StatusOnReview {
+		missing, err := missingChecklistElements(ctx, s.Client, id)
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			return ErrChecklistIncomplete
+		}
 
+		// Проблемные элементы с обязательным фото должны быть сфотографированы
+		noPhoto, err := photoMissingElements(ctx, s.Client, id)
+		if err != nil {
+			return err
+		}
+		if len(noPhoto) > 0 {
+			return &PhotoRequiredError{Elements: noPhoto}
+		}
+	}
+
+	// 3. Обновление статуса и запись в историю — в одной транзакции
+	tx, err := s.Client.Tx(ctx)
 	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if err := tx.Task.UpdateOneID(id).SetStatus(newStatus).Exec(ctx); err != nil {
+		tx.Rollback()
 		return fmt.Errorf("database error: %w", err)
 	}
 
+	history := tx.TaskStatusHistory.Create().
+		SetTaskID(id).
+		SetFromStatus(string(t.Status)).
+		SetToStatus(string(newStatus))
+	if actorID > 0 {
+		history.SetChangedBy(actorID)
+	}
+	if err := history.Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to record status history: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
 	// ============================================================================
 	// ИНТЕГРАЦИЯ С INSPECTION ACT
 	// ============================================================================
 
-	// 4. Если переход в OnReview — создаём акт осмотра
+	// 4. Если включён AutoDraftAct и инспектор начал работу — создаём черновик акта
+	if newStatus == task.StatusInProgress && s.AutoDraftAct {
+		actService := NewInspectionActService(s.Client, "storage/acts")
+		conclusion := "Осмотр выполняется."
+		_, err := actService.CreateOrUpdateAct(ctx, id, conclusion, "черновик")
+		if err != nil {
+			logger.Errorf("Failed to create draft inspection act for task %d: %v", id, err)
+			// Не критично — акт будет создан при отправке на проверку
+		} else {
+			logger.Infof("Draft inspection act created for task %d", id)
+		}
+	}
+
+	// 5. Если переход в OnReview — создаём акт осмотра
 	if newStatus == task.StatusOnReview {
 		actService := NewInspectionActService(s.Client, "storage/acts")
 		conclusion := "Осмотр выполнен. Ожидает проверки координатором."
-		_, err := actService.CreateOrUpdateAct(ctx, id, conclusion)
+		_, err := actService.CreateOrUpdateAct(ctx, id, conclusion, "создан")
 		if err != nil {
-			log.Printf("Failed to create inspection act for task %d: %v", id, err)
+			logger.Errorf("Failed to create inspection act for task %d: %v", id, err)
 			// Не прерываем выполнение — акт можно создать позже вручную
 		} else {
-			log.Printf("Inspection act created for task %d", id)
+			logger.Infof("Inspection act created for task %d", id)
 		}
 	}
 
-	// 5. Если переход в Approved — утверждаем акт
+	// 6. Если переход в Approved — утверждаем акт (PDF формируется заново)
 	if newStatus == task.StatusApproved {
 		actService := NewInspectionActService(s.Client, "storage/acts")
-		err := actService.ApproveAct(ctx, id)
+		actService.ActNumberPrefix = s.ActNumberPrefix
+		err := actService.ApproveAct(ctx, id, approvalComment)
 		if err != nil {
-			log.Printf("Failed to approve inspection act for task %d: %v", id, err)
+			logger.Errorf("Failed to approve inspection act for task %d: %v", id, err)
 			// Не критично, продолжаем
 		} else {
-			log.Printf("Inspection act approved for task %d", id)
+			logger.Infof("Inspection act approved for task %d", id)
 		}
 	}
 
@@ -357,6 +895,14 @@ func (s *TaskService) AssignInspector(ctx context.Context, taskID, inspectorID i
 		return ErrInvalidForeignKey
 	}
 
+	t, err := s.Client.Task.Query().Where(task.IDEQ(taskID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTaskNotFound
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
 	// Обновление задания
 	err = s.Client.Task.UpdateOneID(taskID).
 		SetInspectorID(inspectorID).
@@ -369,6 +915,14 @@ func (s *TaskService) AssignInspector(ctx context.Context, taskID, inspectorID i
 		return fmt.Errorf("database error: %w", err)
 	}
 
+	// Черновик акта содержит данные прежнего инспектора — сбрасываем сохранённый PDF
+	if t.InspectorID != inspectorID {
+		actService := NewInspectionActService(s.Client, "storage/acts")
+		if err := actService.InvalidateDraftPDF(ctx, taskID); err != nil {
+			logger.Errorf("Failed to invalidate draft act PDF for task %d: %v", taskID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,592 @@
+// pkg/service/task_test.go
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"jkh/ent/inspectionact"
+	"jkh/ent/task"
+	"jkh/pkg/models"
+	"jkh/pkg/testutil"
+)
+
+func TestTaskService_ListTasksByUnit_FilterAndPagination(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	// Три задания в ЖЭУ фикстуры, одно из них — в статусе Pending
+	f.createTask(t, client, "Задание 1")
+	f.createTask(t, client, "Задание 2")
+	pending := f.createTask(t, client, "Задание 3")
+	client.Task.UpdateOneID(pending.ID).SetStatus(task.StatusPending).ExecX(ctx)
+
+	// Задание в другом ЖЭУ не должно попадать в выборку
+	otherUnit := client.JkhUnit.Create().SetName("ЖЭУ-2").SetDistrictID(f.District.ID).SaveX(ctx)
+	otherBuilding := client.Building.Create().
+		SetAddress("ул. Другая, д. 2").
+		SetDistrictID(f.District.ID).
+		SetJkhUnitID(otherUnit.ID).
+		SaveX(ctx)
+	client.Task.Create().
+		SetBuildingID(otherBuilding.ID).
+		SetChecklistID(f.Checklist.ID).
+		SetInspectorID(f.Inspector.ID).
+		SetTitle("Чужое задание").
+		SetScheduledDate(pending.ScheduledDate).
+		SaveX(ctx)
+
+	svc := NewTaskService(client)
+
+	all, err := svc.ListTasksByUnit(ctx, f.JkhUnit.ID, models.TaskListFilter{})
+	if err != nil {
+		t.Fatalf("ListTasksByUnit failed: %v", err)
+	}
+	if all.Total != 3 || len(all.Items) != 3 {
+		t.Errorf("Expected 3 tasks, got total=%d items=%d", all.Total, len(all.Items))
+	}
+
+	page, err := svc.ListTasksByUnit(ctx, f.JkhUnit.ID, models.TaskListFilter{Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListTasksByUnit failed: %v", err)
+	}
+	if page.Total != 3 || len(page.Items) != 1 {
+		t.Errorf("Expected total=3 and 1 item on page 2, got total=%d items=%d", page.Total, len(page.Items))
+	}
+
+	status := string(task.StatusPending)
+	filtered, err := svc.ListTasksByUnit(ctx, f.JkhUnit.ID, models.TaskListFilter{Status: &status})
+	if err != nil {
+		t.Fatalf("ListTasksByUnit failed: %v", err)
+	}
+	if filtered.Total != 1 || filtered.Items[0].ID != pending.ID {
+		t.Errorf("Expected only pending task %d, got %+v", pending.ID, filtered.Items)
+	}
+}
+
+func TestTaskService_ListTasksByUnit_UnitNotFound(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	svc := NewTaskService(client)
+	_, err := svc.ListTasksByUnit(context.Background(), 99999, models.TaskListFilter{})
+	if err != ErrJkhUnitNotFound {
+		t.Errorf("Expected ErrJkhUnitNotFound, got %v", err)
+	}
+}
+
+func TestTaskService_ListTasks_InvalidStatusFilter(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	f.createTask(t, client, "Осмотр")
+
+	svc := NewTaskService(client)
+	bad := "done"
+	if _, err := svc.ListTasks(ctx, nil, &bad); err != ErrInvalidStatusFilter {
+		t.Errorf("Expected ErrInvalidStatusFilter, got %v", err)
+	}
+
+	// Пустой фильтр допустим
+	tasks, err := svc.ListTasks(ctx, nil, nil)
+	if err != nil {
+		t.Fatalf("ListTasks failed: %v", err)
+	}
+	if len(tasks) != 1 {
+		t.Errorf("Expected 1 task without filter, got %d", len(tasks))
+	}
+}
+
+func TestTaskService_AssignInspector_InvalidatesDraftActPDF(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	other := createTestUser(t, client, "Inspector", "inspector2")
+
+	draftTask := f.createTask(t, client, "Черновик")
+	approvedTask := f.createTask(t, client, "Утверждённый")
+
+	dir := t.TempDir()
+	draftPath := filepath.Join(dir, "draft.pdf")
+	approvedPath := filepath.Join(dir, "approved.pdf")
+	for _, p := range []string{draftPath, approvedPath} {
+		if err := os.WriteFile(p, []byte("%PDF"), 0644); err != nil {
+			t.Fatalf("failed to write test PDF: %v", err)
+		}
+	}
+
+	client.InspectionAct.Create().SetTaskID(draftTask.ID).SetDocumentPath(draftPath).SaveX(ctx)
+	client.InspectionAct.Create().
+		SetTaskID(approvedTask.ID).
+		SetStatus("утверждён").
+		SetDocumentPath(approvedPath).
+		SaveX(ctx)
+
+	svc := NewTaskService(client)
+	for _, id := range []int{draftTask.ID, approvedTask.ID} {
+		if err := svc.AssignInspector(ctx, id, other.ID); err != nil {
+			t.Fatalf("AssignInspector failed: %v", err)
+		}
+	}
+
+	draft := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(draftTask.ID)).OnlyX(ctx)
+	if draft.DocumentPath != "" {
+		t.Errorf("Expected draft document_path to be cleared, got %s", draft.DocumentPath)
+	}
+	if _, err := os.Stat(draftPath); !os.IsNotExist(err) {
+		t.Errorf("Expected draft PDF to be deleted, stat err: %v", err)
+	}
+
+	// Утверждённый акт не изменяется
+	approved := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(approvedTask.ID)).OnlyX(ctx)
+	if approved.DocumentPath != approvedPath {
+		t.Errorf("Expected approved act path to be kept, got %s", approved.DocumentPath)
+	}
+	if _, err := os.Stat(approvedPath); err != nil {
+		t.Errorf("Expected approved PDF to remain: %v", err)
+	}
+}
+
+func TestTaskService_ListUnacceptedBeyondSLA(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	svc := NewTaskService(client)
+	svc.AcceptanceSLA = 24 * time.Hour
+
+	// Назначено 3 дня назад — срок принятия истёк
+	overdue := f.createTask(t, client, "Просроченное")
+	client.Task.UpdateOneID(overdue.ID).SetStatus(task.StatusPending).ExecX(ctx)
+	client.TaskStatusHistory.Create().
+		SetTaskID(overdue.ID).
+		SetFromStatus(string(task.StatusNew)).
+		SetToStatus(string(task.StatusPending)).
+		SetChangedAt(time.Now().Add(-72 * time.Hour)).
+		SaveX(ctx)
+
+	// Назначено только что — в пределах срока
+	fresh := f.createTask(t, client, "Свежее")
+	if err := svc.UpdateTaskStatus(ctx, fresh.ID, task.StatusPending, f.Coordinator.ID); err != nil {
+		t.Fatalf("UpdateTaskStatus failed: %v", err)
+	}
+
+	resp, err := svc.ListUnacceptedBeyondSLA(ctx)
+	if err != nil {
+		t.Fatalf("ListUnacceptedBeyondSLA failed: %v", err)
+	}
+
+	if len(resp) != 1 {
+		t.Fatalf("Expected 1 overdue task, got %d", len(resp))
+	}
+	if resp[0].Task.ID != overdue.ID {
+		t.Errorf("Expected task %d to be overdue, got %d", overdue.ID, resp[0].Task.ID)
+	}
+	if resp[0].OverdueHours < 47 {
+		t.Errorf("Expected ~48 overdue hours, got %d", resp[0].OverdueHours)
+	}
+}
+
+func TestTaskService_MultipleChecklists(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	roof := f.addElement(t, client, "Кровля", 1)
+
+	fire := client.Checklist.Create().SetTitle("Пожарная безопасность").SaveX(ctx)
+	extinguisher := client.ElementCatalog.Create().SetName("Огнетушители").SaveX(ctx)
+	fireElem := client.ChecklistElement.Create().
+		SetChecklistID(fire.ID).
+		SetElementID(extinguisher.ID).
+		SetOrderIndex(1).
+		SaveX(ctx)
+
+	other := client.Checklist.Create().SetTitle("Посторонний").SaveX(ctx)
+	otherElem := client.ChecklistElement.Create().
+		SetChecklistID(other.ID).
+		SetElementID(extinguisher.ID).
+		SetOrderIndex(1).
+		SaveX(ctx)
+
+	svc := NewTaskService(client)
+	created, err := svc.CreateTask(ctx, models.CreateTaskRequest{
+		BuildingID:             f.Building.ID,
+		ChecklistID:            f.Checklist.ID,
+		AdditionalChecklistIDs: []int{fire.ID},
+		InspectorID:            f.Inspector.ID,
+		Title:                  "Комплексный осмотр",
+		ScheduledDate:          time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	if len(created.AdditionalChecklists) != 1 || created.AdditionalChecklists[0].ID != fire.ID {
+		t.Fatalf("Expected additional checklist %d, got %+v", fire.ID, created.AdditionalChecklists)
+	}
+
+	client.Task.UpdateOneID(created.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+	results := NewInspectionResultService(client)
+
+	// Элементы обоих чек-листов считаются незаполненными, основной — первым
+	missing, err := results.MissingElements(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("MissingElements failed: %v", err)
+	}
+	if len(missing) != 2 || missing[0].ChecklistElementID != roof.ID || missing[1].ChecklistElementID != fireElem.ID {
+		t.Fatalf("Expected missing elements [%d %d], got %+v", roof.ID, fireElem.ID, missing)
+	}
+
+	// Элемент постороннего чек-листа не принимается
+	_, err = results.CreateOrUpdateResult(ctx, created.ID, models.CreateInspectionResultRequest{
+		ChecklistElementID: otherElem.ID,
+		ConditionStatus:    "Исправное",
+	})
+	if err != ErrChecklistElementInvalid {
+		t.Errorf("Expected ErrChecklistElementInvalid, got %v", err)
+	}
+
+	for _, id := range []int{roof.ID, fireElem.ID} {
+		if _, err := results.CreateOrUpdateResult(ctx, created.ID, models.CreateInspectionResultRequest{
+			ChecklistElementID: id,
+			ConditionStatus:    "Исправное",
+		}); err != nil {
+			t.Fatalf("CreateOrUpdateResult for element %d failed: %v", id, err)
+		}
+	}
+
+	summary, err := results.GetTaskResults(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetTaskResults failed: %v", err)
+	}
+	if summary.TotalElements != 2 || summary.CompletedElements != 2 {
+		t.Errorf("Expected 2/2 elements, got %d/%d", summary.CompletedElements, summary.TotalElements)
+	}
+
+	if err := svc.UpdateTaskStatus(ctx, created.ID, task.StatusOnReview, f.Inspector.ID); err != nil {
+		t.Errorf("Expected submit to succeed, got %v", err)
+	}
+}
+
+func TestTaskService_CreateTask_DuplicateChecklist(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	f := newTaskFixture(t, client)
+
+	_, err := NewTaskService(client).CreateTask(context.Background(), models.CreateTaskRequest{
+		BuildingID:             f.Building.ID,
+		ChecklistID:            f.Checklist.ID,
+		AdditionalChecklistIDs: []int{f.Checklist.ID},
+		InspectorID:            f.Inspector.ID,
+		Title:                  "Повтор",
+		ScheduledDate:          time.Now().Format(time.RFC3339),
+	})
+	if err != ErrDuplicateChecklist {
+		t.Errorf("Expected ErrDuplicateChecklist, got %v", err)
+	}
+}
+
+func TestTaskService_AssignableInspectors(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	second := createTestUser(t, client, "Inspector", "inspector2")
+	client.InspectorUnit.Create().SetUserID(second.ID).SetJkhUnitID(f.JkhUnit.ID).SaveX(ctx)
+
+	// Инспектор другого ЖЭУ в список не попадает
+	otherUnit := client.JkhUnit.Create().SetName("ЖЭУ-2").SetDistrictID(f.District.ID).SaveX(ctx)
+	outsider := createTestUser(t, client, "Inspector", "inspector3")
+	client.InspectorUnit.Create().SetUserID(outsider.ID).SetJkhUnitID(otherUnit.ID).SaveX(ctx)
+
+	svc := NewTaskService(client)
+	inspectors, err := svc.AssignableInspectors(ctx, f.Building.ID)
+	if err != nil {
+		t.Fatalf("AssignableInspectors failed: %v", err)
+	}
+
+	if len(inspectors) != 2 {
+		t.Fatalf("Expected 2 inspectors, got %d", len(inspectors))
+	}
+	ids := map[int]bool{}
+	for _, u := range inspectors {
+		ids[u.ID] = true
+	}
+	if !ids[f.Inspector.ID] || !ids[second.ID] {
+		t.Errorf("Expected inspectors %d and %d, got %v", f.Inspector.ID, second.ID, ids)
+	}
+
+	if _, err := svc.AssignableInspectors(ctx, 99999); err != ErrBuildingNotFound {
+		t.Errorf("Expected ErrBuildingNotFound, got %v", err)
+	}
+}
+
+func TestTaskService_CreateTask_Priority(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	svc := NewTaskService(client)
+
+	req := models.CreateTaskRequest{
+		BuildingID:    f.Building.ID,
+		ChecklistID:   f.Checklist.ID,
+		InspectorID:   f.Inspector.ID,
+		Title:         "Осмотр",
+		Priority:      "сверхсрочный", // в обход binding DTO
+		ScheduledDate: time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+	}
+	if _, err := svc.CreateTask(ctx, req); err != ErrInvalidPriority {
+		t.Fatalf("Expected ErrInvalidPriority, got %v", err)
+	}
+	if count := client.Task.Query().CountX(ctx); count != 0 {
+		t.Errorf("Expected no task to be created, got %d", count)
+	}
+
+	// Пустой приоритет заменяется настроенным значением по умолчанию
+	svc.DefaultPriority = "высокий"
+	req.Priority = ""
+	resp, err := svc.CreateTask(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	if resp.Priority != "высокий" {
+		t.Errorf("Expected default priority 'высокий', got %s", resp.Priority)
+	}
+}
+
+func TestTaskService_UpdateTaskStatus_AutoDraftAct(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+
+	start := func(svc *TaskService, title string) int {
+		t.Helper()
+		tk := f.createTask(t, client, title)
+		client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusPending).ExecX(ctx)
+		if err := svc.UpdateTaskStatus(ctx, tk.ID, task.StatusInProgress, f.Inspector.ID); err != nil {
+			t.Fatalf("UpdateTaskStatus failed: %v", err)
+		}
+		return tk.ID
+	}
+
+	// Флаг выключен — акт появляется только при отправке на проверку
+	off := start(NewTaskService(client), "Без черновика")
+	if client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(off)).ExistX(ctx) {
+		t.Error("Expected no act on InProgress when AutoDraftAct is off")
+	}
+
+	svc := NewTaskService(client)
+	svc.AutoDraftAct = true
+	on := start(svc, "С черновиком")
+
+	act, err := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(on)).Only(ctx)
+	if err != nil {
+		t.Fatalf("Expected draft act on InProgress, got %v", err)
+	}
+	if act.Status != "черновик" {
+		t.Errorf("Expected act status черновик, got %q", act.Status)
+	}
+
+	// Отправка на проверку переводит тот же акт в статус "создан", утверждение — в "утверждён"
+	if err := svc.UpdateTaskStatus(ctx, on, task.StatusOnReview, f.Inspector.ID); err != nil {
+		t.Fatalf("submit failed: %v", err)
+	}
+
+	// Неутверждённый PDF сохранён на диске. Имя файла содержит время с точностью до секунды,
+	// поэтому черновик и утверждённый акт пишутся в разные каталоги.
+	draftSvc := NewInspectionActService(client, t.TempDir())
+	draftSvc.Layout.FontDir = "../../storage/fonts"
+	if _, _, err := draftSvc.GeneratePDFForAct(ctx, on); err != nil {
+		t.Fatalf("GeneratePDFForAct failed: %v", err)
+	}
+	draftPath := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(on)).OnlyX(ctx).DocumentPath
+	if _, err := os.Stat(draftPath); err != nil {
+		t.Fatalf("Expected draft PDF on disk: %v", err)
+	}
+
+	approveDir := t.TempDir()
+	actSvc := NewInspectionActService(client, approveDir)
+	actSvc.Layout = draftSvc.Layout
+	if err := actSvc.ApproveAct(ctx, on, nil); err != nil {
+		t.Fatalf("ApproveAct failed: %v", err)
+	}
+
+	approved := client.InspectionAct.Query().Where(inspectionact.TaskIDEQ(on)).OnlyX(ctx)
+	if approved.ID != act.ID {
+		t.Errorf("Expected draft act %d to be reused, got %d", act.ID, approved.ID)
+	}
+	if approved.Status != "утверждён" || approved.ApprovedAt.IsZero() {
+		t.Errorf("Expected approved act, got status %q approved_at %v", approved.Status, approved.ApprovedAt)
+	}
+
+	// Черновой PDF удалён, document_path указывает на заново сформированный утверждённый акт
+	if _, err := os.Stat(draftPath); !os.IsNotExist(err) {
+		t.Errorf("Expected draft PDF %s to be removed, got %v", draftPath, err)
+	}
+	if filepath.Dir(approved.DocumentPath) != approveDir {
+		t.Fatalf("Expected approved PDF in %s, got %q", approveDir, approved.DocumentPath)
+	}
+	data, err := os.ReadFile(approved.DocumentPath)
+	if err != nil {
+		t.Fatalf("Expected approved PDF on disk: %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF")) {
+		t.Errorf("Expected PDF data in %s", approved.DocumentPath)
+	}
+}
+
+func TestTaskService_Submit_PhotoRequired(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	roof := f.addElement(t, client, "Кровля", 1)
+	facade := f.addElement(t, client, "Фасад", 2)
+	client.ChecklistElement.UpdateOneID(roof.ID).SetPhotoRequired(true).ExecX(ctx)
+	client.ChecklistElement.UpdateOneID(facade.ID).SetPhotoRequired(true).ExecX(ctx)
+
+	svc := NewTaskService(client)
+	results := NewInspectionResultService(client)
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+
+	// Кровля в аварийном состоянии без фото, фасад исправен — фото для него не нужно
+	for _, req := range []models.CreateInspectionResultRequest{
+		{ChecklistElementID: roof.ID, ConditionStatus: "Аварийное"},
+		{ChecklistElementID: facade.ID, ConditionStatus: "Исправное"},
+	} {
+		if _, err := results.CreateOrUpdateResult(ctx, tk.ID, req); err != nil {
+			t.Fatalf("CreateOrUpdateResult failed: %v", err)
+		}
+	}
+
+	err := svc.UpdateTaskStatus(ctx, tk.ID, task.StatusOnReview, f.Inspector.ID)
+	if !errors.Is(err, ErrPhotoRequired) {
+		t.Fatalf("Expected ErrPhotoRequired, got %v", err)
+	}
+	var photoErr *PhotoRequiredError
+	if !errors.As(err, &photoErr) || len(photoErr.Elements) != 1 || photoErr.Elements[0] != "Кровля" {
+		t.Errorf("Expected error listing Кровля, got %v", err)
+	}
+
+	// С фотографией кровли задание уходит на проверку
+	photos := []string{"storage/photos/roof.jpg"}
+	if _, err := results.PatchResult(ctx, tk.ID, roof.ID, models.PatchInspectionResultRequest{PhotoPaths: &photos}); err != nil {
+		t.Fatalf("PatchResult failed: %v", err)
+	}
+	if err := svc.UpdateTaskStatus(ctx, tk.ID, task.StatusOnReview, f.Inspector.ID); err != nil {
+		t.Errorf("Expected submit with photo to succeed, got %v", err)
+	}
+}
+
+func TestTaskService_Submit_PhotoRequiredSetOnExistingElement(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	roof := f.addElement(t, client, "Кровля", 1)
+
+	// Флаг включается у элемента, который уже есть в чек-листе
+	required := true
+	err := NewChecklistService(client).UpdateElementOrder(ctx, f.Checklist.ID, roof.ElementID, models.UpdateElementOrderRequest{
+		OrderIndex:    roof.OrderIndex,
+		PhotoRequired: &required,
+	})
+	if err != nil {
+		t.Fatalf("UpdateElementOrder failed: %v", err)
+	}
+
+	tk := f.createTask(t, client, "Осмотр")
+	client.Task.UpdateOneID(tk.ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+	req := models.CreateInspectionResultRequest{ChecklistElementID: roof.ID, ConditionStatus: "Аварийное"}
+	if _, err := NewInspectionResultService(client).CreateOrUpdateResult(ctx, tk.ID, req); err != nil {
+		t.Fatalf("CreateOrUpdateResult failed: %v", err)
+	}
+
+	err = NewTaskService(client).UpdateTaskStatus(ctx, tk.ID, task.StatusOnReview, f.Inspector.ID)
+	var photoErr *PhotoRequiredError
+	if !errors.As(err, &photoErr) || len(photoErr.Elements) != 1 || photoErr.Elements[0] != "Кровля" {
+		t.Errorf("Expected PhotoRequiredError listing Кровля, got %v", err)
+	}
+}
+
+func TestTaskService_ReviewQueueByInspector(t *testing.T) {
+	client := testutil.SetupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	f := newTaskFixture(t, client)
+	second := createTestUser(t, client, "Inspector", "inspector2")
+
+	submit := func(title string, inspectorID int, age time.Duration) int {
+		t.Helper()
+		tk := f.createTask(t, client, title)
+		client.Task.UpdateOneID(tk.ID).SetInspectorID(inspectorID).SetStatus(task.StatusOnReview).ExecX(ctx)
+		client.TaskStatusHistory.Create().
+			SetTaskID(tk.ID).
+			SetFromStatus(string(task.StatusInProgress)).
+			SetToStatus(string(task.StatusOnReview)).
+			SetChangedAt(time.Now().Add(-age)).
+			SaveX(ctx)
+		return tk.ID
+	}
+
+	// У второго инспектора самое давнее задание — его группа первая
+	firstNew := submit("Первый, свежее", f.Inspector.ID, 2*time.Hour)
+	firstOld := submit("Первый, давнее", f.Inspector.ID, 10*time.Hour)
+	secondOnly := submit("Второй", second.ID, 30*time.Hour)
+
+	// Задание не на проверке в очередь не попадает
+	client.Task.UpdateOneID(f.createTask(t, client, "В работе").ID).SetStatus(task.StatusInProgress).ExecX(ctx)
+
+	groups, err := NewTaskService(client).ReviewQueueByInspector(ctx)
+	if err != nil {
+		t.Fatalf("ReviewQueueByInspector failed: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 inspector groups, got %d", len(groups))
+	}
+
+	if g := groups[0]; g.InspectorID != second.ID || g.Count != 1 || g.Tasks[0].ID != secondOnly {
+		t.Errorf("Expected first group for inspector %d with task %d, got %+v", second.ID, secondOnly, g)
+	}
+	if g := groups[0]; g.OldestAgeHours < 29 || g.OldestAgeHours > 30 {
+		t.Errorf("Expected oldest age ~30h, got %d", g.OldestAgeHours)
+	}
+
+	g := groups[1]
+	if g.InspectorID != f.Inspector.ID || g.Count != 2 {
+		t.Fatalf("Expected 2 tasks for inspector %d, got %+v", f.Inspector.ID, g)
+	}
+	if g.Tasks[0].ID != firstOld || g.Tasks[1].ID != firstNew {
+		t.Errorf("Expected tasks oldest first [%d %d], got [%d %d]", firstOld, firstNew, g.Tasks[0].ID, g.Tasks[1].ID)
+	}
+	if g.OldestAgeHours < 9 || g.OldestAgeHours > 10 {
+		t.Errorf("Expected oldest age ~10h, got %d", g.OldestAgeHours)
+	}
+}
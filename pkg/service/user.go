@@ -6,12 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 	"jkh/ent"
 	"jkh/ent/role"
+	"jkh/ent/task"
 	"jkh/ent/user"
+	"jkh/pkg/logger"
 	"jkh/pkg/models"
 )
 
@@ -83,7 +85,7 @@ func (s *UserService) CreateUser(ctx context.Context, req models.CreateUserReque
 		if ent.IsConstraintError(err) {
 			return nil, ErrUserConflict
 		}
-		log.Printf("DB error creating user: %v", err)
+		logger.Errorf("DB error creating user: %v", err)
 		return nil, fmt.Errorf("database error")
 	}
 
@@ -94,7 +96,7 @@ func (s *UserService) CreateUser(ctx context.Context, req models.CreateUserReque
 		Only(ctx)
 	if err != nil {
 		// Не фатально — вернём минимальный ответ, но логируем
-		log.Printf("Warning: created user but failed to load role edge: %v", err)
+		logger.Warnf("created user but failed to load role edge: %v", err)
 		return s.toUserResponse(u), nil
 	}
 
@@ -126,7 +128,7 @@ func (s *UserService) ListUsers(ctx context.Context) ([]*models.UserResponse, er
 		WithRole().
 		All(ctx)
 	if err != nil {
-		log.Printf("DB error listing users: %v", err)
+		logger.Errorf("DB error listing users: %v", err)
 		return nil, fmt.Errorf("database error")
 	}
 
@@ -149,7 +151,7 @@ func (s *UserService) findUserAndRoleByUserID(ctx context.Context, id int) (*ent
 			return nil, ErrUserNotFound
 		}
 		// Обработка общей ошибки БД
-		log.Printf("DB error finding user %d: %v", id, err)
+		logger.Errorf("DB error finding user %d: %v", id, err)
 		return nil, fmt.Errorf("database error")
 	}
 	return u, nil
@@ -166,6 +168,55 @@ func (s *UserService) RetrieveUser(ctx context.Context, id int) (*models.UserRes
 	return s.toUserResponse(u), nil
 }
 
+// GetUserSummary - загрузка и закрепления пользователя одним запросом: незавершённые задания
+// по статусам, ЖЭУ и время последнего входа (собирается из RetrieveUser и InspectorUnitService)
+func (s *UserService) GetUserSummary(ctx context.Context, id int) (*models.UserSummaryResponse, error) {
+	u, err := s.findUserAndRoleByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := NewInspectorUnitService(s.Client).ListUnitsForInspector(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Незавершённые задания по статусам (статусы без заданий не возвращаются)
+	var rows []struct {
+		Status task.Status `json:"status"`
+		Count  int         `json:"count"`
+	}
+	err = s.Client.Task.Query().
+		Where(task.InspectorIDEQ(id), task.StatusIn(activeTaskStatuses...)).
+		GroupBy(task.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	resp := &models.UserSummaryResponse{
+		User:        *s.toUserResponse(u),
+		ActiveTasks: make([]models.TaskStatusStat, 0, len(rows)),
+		JkhUnits:    units,
+	}
+	// Порядок статусов — как в жизненном цикле задания
+	for _, st := range activeTaskStatuses {
+		for _, r := range rows {
+			if r.Status == st {
+				resp.ActiveTasks = append(resp.ActiveTasks, models.TaskStatusStat{Status: string(st), Count: r.Count})
+				resp.ActiveTasksTotal += r.Count
+			}
+		}
+	}
+	if u.LastLoginAt != nil {
+		ts := u.LastLoginAt.Format(time.RFC3339)
+		resp.LastLoginAt = &ts
+	}
+
+	return resp, nil
+}
+
 // UpdateUser - обновляет существующего пользователя
 func (s *UserService) UpdateUser(ctx context.Context, targetUserID int, authenticatedUserID int, req models.UpdateUserRequest) (*models.UserResponse, error) {
     if targetUserID == authenticatedUserID {
@@ -225,7 +276,7 @@ func (s *UserService) UpdateUser(ctx context.Context, targetUserID int, authenti
         if ent.IsConstraintError(err) {
             return nil, ErrUserConflict
         }
-        log.Printf("DB error updating user %d: %v", targetUserID, err)
+        logger.Errorf("DB error updating user %d: %v", targetUserID, err)
         return nil, fmt.Errorf("database error")
     }
 
@@ -253,7 +304,7 @@ func (s *UserService) DeleteUser(ctx context.Context, targetUserID int, authenti
         if ent.IsConstraintError(err) {
             return errors.New("user has active dependencies (tasks, buildings, etc.)")
         }
-        log.Printf("DB error deleting user %d: %v", targetUserID, err)
+        logger.Errorf("DB error deleting user %d: %v", targetUserID, err)
         return fmt.Errorf("database error")
     }
 
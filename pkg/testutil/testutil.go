@@ -5,6 +5,7 @@ package testutil
 import (
 	"context"
 	"database/sql"
+	"path/filepath"
 	"testing"
 
 	"jkh/ent"
@@ -50,6 +51,40 @@ func SetupTestDB(t *testing.T) *ent.Client {
 	return client
 }
 
+// SetupConcurrentTestDB создаёт тестовую БД SQLite в файле (с базовыми ролями).
+// В отличие от :memory:, все соединения пула видят одну и ту же БД, поэтому
+// её можно использовать для проверки параллельных транзакций.
+func SetupConcurrentTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	// Транзакции берут блокировку записи сразу (BEGIN IMMEDIATE) и ждут её до 5 секунд
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db") +
+		"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	client := ent.NewClient(ent.Driver(drv))
+
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	for _, roleName := range []string{"Specialist", "Coordinator", "Inspector"} {
+		client.Role.Create().SetName(roleName).SaveX(ctx)
+	}
+
+	t.Cleanup(func() {
+		client.Close()
+		db.Close()
+	})
+
+	return client
+}
+
 // SetupTestDBWithoutRoles создаёт тестовую БД без предустановленных ролей
 func SetupTestDBWithoutRoles(t *testing.T) *ent.Client {
 	t.Helper()